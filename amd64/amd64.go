@@ -0,0 +1,191 @@
+// Package amd64 implements an x86-64 assembly generator for LLVM IR modules
+// produced by irgen.
+//
+// The generated assembly uses the AT&T syntax of the GNU assembler, and follows
+// the System V AMD64 calling convention; the output may thus be linked against
+// a runtime library compiled by a C compiler (e.g. testdata/uc.c).
+//
+// Code generation
+//
+// Functions are generated in three stages. First, instruction selection lowers
+// the LLVM IR instructions of a function to x86-64 instructions operating on an
+// unbounded number of virtual registers. Secondly, liveness analysis computes
+// the live interval of each virtual register, and a linear scan register
+// allocator assigns physical registers to live intervals, spilling virtual
+// registers to the stack frame when running out of physical registers. Lastly,
+// the instructions are emitted with virtual registers replaced by their
+// physical register or stack slot, surrounded by the prologue and epilogue of
+// the function.
+//
+// Registers
+//
+//    %rbx, %r12 - %r15       allocatable, callee-saved
+//    %rsi, %rdi, %r8, %r9    allocatable, caller-saved
+//    %rax, %rcx, %rdx        return value, division and shift operands
+//    %r10, %r11              scratch registers of spilled virtual registers
+//    %rsp, %rbp              stack and frame pointer
+//
+// Virtual registers which are live across a call are only assigned callee-saved
+// registers.
+//
+// Stack frame layout
+//
+//    16+8*i(%rbp)   argument 6+i
+//         8(%rbp)   return address
+//         0(%rbp)   saved frame pointer of the caller
+//        -n(%rbp)   register arguments, local variables, spill slots and
+//                   saved callee-saved registers
+//        n(%rsp)    outgoing arguments
+//
+// Arguments passed in registers are stored to the stack frame by the prologue.
+// Outgoing arguments are first stored to the outgoing argument area, and then
+// loaded into their argument registers right before the call, thus preventing
+// argument registers from being overwritten before being read.
+package amd64
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/term"
+)
+
+// TODO: Remove debug output.
+
+// dbg is a logger which prefixes debug messages with "amd64:".
+var dbg = log.New(ioutil.Discard, term.WhiteBold("amd64:"), log.Lshortfile)
+
+// Gen generates x86-64 assembly for the given LLVM IR module.
+func Gen(module *ir.Module) (string, error) {
+	g := newGenerator()
+
+	// Emit global variables.
+	if len(module.Globals) > 0 {
+		g.emit(".data")
+		for _, global := range module.Globals {
+			if err := g.global(global); err != nil {
+				return "", errutil.Err(err)
+			}
+		}
+		g.buf.WriteString("\n")
+	}
+
+	// Emit function definitions.
+	g.emit(".text")
+	for _, f := range module.Funcs {
+		if len(f.Blocks) == 0 {
+			// Function declarations are resolved by the linker.
+			continue
+		}
+		g.buf.WriteString("\n")
+		g.function(f)
+	}
+
+	// Mark the stack as non-executable.
+	g.buf.WriteString("\n")
+	g.emit(`.section .note.GNU-stack,"",@progbits`)
+	return g.buf.String(), nil
+}
+
+// A generator keeps track of the state required to generate x86-64 assembly.
+type generator struct {
+	// Output buffer of the generated assembly.
+	buf *bytes.Buffer
+}
+
+// newGenerator returns a new x86-64 assembly generator.
+func newGenerator() *generator {
+	return &generator{buf: &bytes.Buffer{}}
+}
+
+// emit emits the given instruction or directive.
+func (g *generator) emit(format string, a ...interface{}) {
+	g.buf.WriteString("\t")
+	fmt.Fprintf(g.buf, format, a...)
+	g.buf.WriteString("\n")
+}
+
+// label emits the given label.
+func (g *generator) label(name string) {
+	fmt.Fprintf(g.buf, "%s:\n", name)
+}
+
+// global emits the given global variable definition.
+func (g *generator) global(global *ir.Global) error {
+	if global.Init == nil {
+		return errutil.Newf("support for external global variable %q not yet implemented", global.Name)
+	}
+	g.emit(".p2align 3")
+	g.label(mangle(global.Name))
+	switch init := global.Init.(type) {
+	case *constant.Int:
+		g.emit("%s %d", dataDirective(init.Typ), init.X.Int64())
+	case *constant.ZeroInitializer:
+		g.emit(".zero %d", sizeof(init.Typ))
+	case *constant.Array:
+		for _, elem := range init.Elems {
+			c, ok := elem.(*constant.Int)
+			if !ok {
+				return errutil.Newf("support for array element constant %T not yet implemented", elem)
+			}
+			g.emit("%s %d", dataDirective(c.Typ), c.X.Int64())
+		}
+	default:
+		return errutil.Newf("support for global variable initializer %T not yet implemented", init)
+	}
+	return nil
+}
+
+// dataDirective returns the data directive used to store integer constants of
+// the given type.
+func dataDirective(typ *types.IntType) string {
+	switch sizeof(typ) {
+	case 1:
+		return ".byte"
+	case 4:
+		return ".long"
+	default:
+		return ".quad"
+	}
+}
+
+// mangle returns the assembly symbol of the given LLVM IR global identifier.
+//
+// Only the entry function main is exported from the object file, thus
+// preventing clashes between µC identifiers and the symbols of the C library
+// (e.g. "div").
+func mangle(name string) string {
+	return name
+}
+
+// sizeof returns the size in bytes of the given type.
+func sizeof(typ types.Type) int64 {
+	switch typ := typ.(type) {
+	case *types.IntType:
+		switch {
+		case typ.Size <= 8:
+			return 1
+		case typ.Size <= 32:
+			return 4
+		default:
+			return 8
+		}
+	case *types.PointerType:
+		return 8
+	case *types.ArrayType:
+		return typ.Len * sizeof(typ.Elem)
+	default:
+		panic(fmt.Sprintf("support for type %T not yet implemented", typ))
+	}
+}
+
+// align rounds x up to the nearest multiple of n.
+func align(x, n int64) int64 {
+	return (x + n - 1) / n * n
+}
@@ -0,0 +1,386 @@
+package amd64_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/amd64"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/sem"
+)
+
+func TestGen(t *testing.T) {
+	paths, err := filepath.Glob("../testdata/noisy/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths, "../testdata/quiet/mips/m01.c", "../testdata/quiet/mips/m02.c", "../testdata/quiet/mips/m03.c", "../testdata/extra/amd64/pressure.c")
+	for _, ssa := range []bool{false, true} {
+		for _, path := range paths {
+			asm, err := gen(path, ssa)
+			if err != nil {
+				t.Errorf("%q: %v", path, err)
+				continue
+			}
+
+			// Validate the structure of the generated assembly.
+			if err := check(asm); err != nil {
+				t.Errorf("%q (SSA=%v): invalid x86-64 assembly; %v", path, ssa, err)
+			}
+		}
+	}
+}
+
+func TestSpill(t *testing.T) {
+	// Mapping the scalar local variables of pressure.c to SSA values requires
+	// more registers than available.
+	path := "../testdata/extra/amd64/pressure.c"
+	asm, err := gen(path, true)
+	if err != nil {
+		t.Fatalf("%q: %v", path, err)
+	}
+	reload := regexp.MustCompile(`movq -[0-9]+\(%rbp\), %r1[01]`)
+	if !reload.MatchString(asm) {
+		t.Errorf("%q: expected reload of spilled virtual register to scratch register", path)
+	}
+	spill := regexp.MustCompile(`movq %r1[01], -[0-9]+\(%rbp\)`)
+	if !spill.MatchString(asm) {
+		t.Errorf("%q: expected store of scratch register to spill slot", path)
+	}
+}
+
+// gen generates x86-64 assembly for the given µC source file, optionally mapping
+// scalar local variables to SSA values.
+func gen(path string, ssa bool) (string, error) {
+	// Lex input.
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	s := scanner.NewFromBytes(buf)
+
+	// Parse input.
+	p := parser.NewParser()
+	f, err := p.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("parse error: %v", err)
+	}
+	file := f.(*ast.File)
+
+	// Verify input.
+	info, err := sem.Check(file)
+	if err != nil {
+		return "", fmt.Errorf("semantic analysis error: %v", err)
+	}
+
+	// Generate x86-64 assembly.
+	module := irgen.GenWith(file, info, irgen.Config{SSA: ssa})
+	asm, err := amd64.Gen(module)
+	if err != nil {
+		return "", fmt.Errorf("x86-64 assembly generation error: %v", err)
+	}
+	return asm, nil
+}
+
+// regSizes maps from physical register names to their size in bytes.
+var regSizes = make(map[string]int)
+
+// regFull maps from physical register names to the name of their 64-bit
+// register.
+var regFull = make(map[string]string)
+
+func init() {
+	for _, names := range [][3]string{
+		{"al", "eax", "rax"}, {"cl", "ecx", "rcx"}, {"dl", "edx", "rdx"},
+		{"bl", "ebx", "rbx"}, {"spl", "esp", "rsp"}, {"bpl", "ebp", "rbp"},
+		{"sil", "esi", "rsi"}, {"dil", "edi", "rdi"},
+	} {
+		for i, size := range []int{1, 4, 8} {
+			regSizes[names[i]] = size
+			regFull[names[i]] = names[2]
+		}
+	}
+	for i := 8; i <= 15; i++ {
+		r := fmt.Sprintf("r%d", i)
+		regSizes[r+"b"], regSizes[r+"d"], regSizes[r] = 1, 4, 8
+		regFull[r+"b"], regFull[r+"d"], regFull[r] = r, r, r
+	}
+}
+
+// calleeSaved specifies the callee-saved registers, excluding %rsp and %rbp.
+var calleeSaved = map[string]bool{"rbx": true, "r12": true, "r13": true, "r14": true, "r15": true}
+
+// opSizes maps from suffixed mnemonics to the size in bytes of their register
+// operands. The sizes of movs and movz instructions are given by source and
+// destination.
+var opSizes = map[string][]int{
+	"movb": {1}, "movl": {4}, "movq": {8},
+	"addl": {4}, "addq": {8}, "subl": {4}, "subq": {8},
+	"imull": {4}, "imulq": {8}, "idivl": {4}, "idivq": {8},
+	"andl": {4}, "andq": {8}, "orl": {4}, "orq": {8}, "xorl": {4}, "xorq": {8},
+	"negl": {4}, "negq": {8}, "shll": {4}, "shlq": {8}, "sarl": {4}, "sarq": {8},
+	"cmpb": {1}, "cmpl": {4}, "cmpq": {8}, "testb": {1},
+	"cmovnel": {4}, "cmovneq": {8}, "leaq": {8},
+	"movsbl": {1, 4}, "movsbq": {1, 8}, "movslq": {4, 8},
+	"movzbl": {1, 4}, "movzbq": {1, 8},
+	"sete": {1}, "setne": {1}, "seta": {1}, "setae": {1}, "setb": {1},
+	"setbe": {1}, "setg": {1}, "setge": {1}, "setl": {1}, "setle": {1},
+}
+
+// nargs maps from mnemonics to their number of operands.
+var nargs = map[string]int{
+	"pushq": 1, "cltd": 0, "cqto": 0, "leave": 0, "ret": 0, "ud2": 0,
+	"jmp": 1, "jne": 1, "call": 1, "idivl": 1, "idivq": 1, "negl": 1,
+	"negq": 1,
+}
+
+// memRegexp matches memory operands.
+var memRegexp = regexp.MustCompile(`^(-?[0-9]+)?\(%([a-z0-9]+)\)$|^[A-Za-z_.][A-Za-z0-9_.]*([+-][0-9]+)?\(%rip\)$`)
+
+// check validates the structure of the given x86-64 assembly; that operands
+// are well-formed and match the operand size of their instructions, that
+// branch targets are defined, and that functions set up and tear down their
+// stack frame and preserve callee-saved registers.
+func check(asm string) error {
+	lines := strings.Split(asm, "\n")
+	labels := make(map[string]bool)
+	funcs := make(map[string]bool)
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasSuffix(line, ":"):
+			name := strings.TrimSuffix(line, ":")
+			if labels[name] {
+				return fmt.Errorf("redefinition of label %s", name)
+			}
+			labels[name] = true
+		case strings.HasPrefix(line, ".type "):
+			fields := strings.Split(strings.TrimPrefix(line, ".type "), ",")
+			funcs[fields[0]] = true
+		}
+	}
+	if !funcs["main"] || !strings.Contains(asm, "\t.globl main\n") {
+		return fmt.Errorf("missing definition of global function main")
+	}
+
+	// Validate function bodies.
+	var fn *function
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasSuffix(line, ":"):
+			name := strings.TrimSuffix(line, ":")
+			if funcs[name] {
+				fn = &function{name: name, saved: make(map[string]bool), restored: make(map[string]bool)}
+			}
+		case strings.HasPrefix(line, ".size "):
+			if fn == nil {
+				return fmt.Errorf("unexpected %q outside of function", line)
+			}
+			if fn.state != stateReturned {
+				return fmt.Errorf("function %s: missing epilogue", fn.name)
+			}
+			fn = nil
+		case strings.HasPrefix(line, "."):
+			// Directive.
+		default:
+			if fn == nil {
+				return fmt.Errorf("instruction %q outside of function", line)
+			}
+			if err := fn.inst(line, labels, funcs); err != nil {
+				return fmt.Errorf("function %s: %q: %v", fn.name, line, err)
+			}
+		}
+	}
+	if fn != nil {
+		return fmt.Errorf("function %s: missing .size directive", fn.name)
+	}
+	return nil
+}
+
+// Function validation states.
+const (
+	// Expecting pushq %rbp.
+	stateEntry = iota
+	// Expecting movq %rsp, %rbp.
+	statePushed
+	// Within the prologue or function body.
+	stateBody
+	// After leave.
+	stateLeft
+	// After ret.
+	stateReturned
+)
+
+// A function keeps track of the state required to validate a function.
+type function struct {
+	// Function name.
+	name string
+	// Validation state.
+	state int
+	// Specifies whether the instructions of the prologue have been validated.
+	bodyStarted bool
+	// Callee-saved registers saved by the prologue.
+	saved map[string]bool
+	// Callee-saved registers restored by the epilogue.
+	restored map[string]bool
+}
+
+// inst validates the given instruction of the function.
+func (fn *function) inst(line string, labels, funcs map[string]bool) error {
+	op, args := line, []string(nil)
+	if i := strings.Index(line, " "); i != -1 {
+		op = line[:i]
+		for _, arg := range strings.Split(line[i+1:], ",") {
+			args = append(args, strings.TrimSpace(arg))
+		}
+	}
+
+	// Prologue and epilogue.
+	switch fn.state {
+	case stateEntry:
+		if line != "pushq %rbp" {
+			return fmt.Errorf("expected pushq %%rbp at function entry")
+		}
+		fn.state = statePushed
+		return nil
+	case statePushed:
+		if line != "movq %rsp, %rbp" {
+			return fmt.Errorf("expected movq %%rsp, %%rbp after pushq %%rbp")
+		}
+		fn.state = stateBody
+		return nil
+	case stateLeft:
+		if op != "ret" {
+			return fmt.Errorf("expected ret after leave")
+		}
+		fn.state = stateReturned
+		return nil
+	case stateReturned:
+		return fmt.Errorf("instruction after ret")
+	}
+	switch op {
+	case "pushq", "popq":
+		return fmt.Errorf("unbalanced stack pointer")
+	case "leave":
+		for r := range fn.saved {
+			if !fn.restored[r] {
+				return fmt.Errorf("callee-saved register %%%s not restored", r)
+			}
+		}
+		fn.state = stateLeft
+		return nil
+	}
+	if !fn.bodyStarted {
+		switch {
+		case op == "subq" && len(args) == 2 && args[1] == "%rsp":
+			n, err := strconv.ParseInt(strings.TrimPrefix(args[0], "$"), 10, 64)
+			if err != nil || n%16 != 0 {
+				return fmt.Errorf("stack frame size not a multiple of 16")
+			}
+			return nil
+		case op == "movq" && len(args) == 2 && calleeSaved[strings.TrimPrefix(args[0], "%")] && memRegexp.MatchString(args[1]):
+			fn.saved[strings.TrimPrefix(args[0], "%")] = true
+			return nil
+		}
+		fn.bodyStarted = true
+	}
+	if op == "movq" && len(args) == 2 && memRegexp.MatchString(args[0]) && calleeSaved[strings.TrimPrefix(args[1], "%")] {
+		fn.restored[strings.TrimPrefix(args[1], "%")] = true
+	}
+	if strings.Contains(line, "%rsp") && !strings.Contains(line, "(%rsp)") {
+		return fmt.Errorf("modification of stack pointer outside of prologue")
+	}
+
+	// Operand count.
+	n, ok := nargs[op]
+	if !ok {
+		n = 2
+		if _, ok := opSizes[op]; !ok {
+			return fmt.Errorf("unknown instruction %q", op)
+		}
+		if strings.HasPrefix(op, "set") {
+			n = 1
+		}
+	}
+	if len(args) != n {
+		return fmt.Errorf("expected %d operands, got %d", n, len(args))
+	}
+
+	// Branch targets.
+	switch op {
+	case "jmp", "jne":
+		if !labels[args[0]] {
+			return fmt.Errorf("branch to undefined label %s", args[0])
+		}
+		return nil
+	case "call":
+		if !funcs[args[0]] && !strings.HasSuffix(args[0], "@PLT") && args[0] != "*%rax" {
+			return fmt.Errorf("call to undefined function %s", args[0])
+		}
+		return nil
+	}
+
+	// Operands.
+	sizes := opSizes[op]
+	nmem := 0
+	for i, arg := range args {
+		size := sizes[0]
+		if len(sizes) > 1 && i == len(args)-1 {
+			size = sizes[1]
+		}
+		switch {
+		case strings.HasPrefix(arg, "$"):
+			if i == len(args)-1 {
+				return fmt.Errorf("immediate destination operand")
+			}
+			if _, err := strconv.ParseInt(arg[1:], 10, 32); err != nil {
+				return fmt.Errorf("invalid immediate operand %q", arg)
+			}
+		case strings.HasPrefix(arg, "%"):
+			name := arg[1:]
+			got, ok := regSizes[name]
+			if !ok {
+				return fmt.Errorf("invalid register operand %q", arg)
+			}
+			// The shift count is held in %cl.
+			if (strings.HasPrefix(op, "shl") || strings.HasPrefix(op, "sar")) && i == 0 {
+				if name != "cl" {
+					return fmt.Errorf("shift count not in %%cl")
+				}
+				continue
+			}
+			if got != size {
+				return fmt.Errorf("register operand %q of size %d, expected size %d", arg, got, size)
+			}
+			if i == len(args)-1 && calleeSaved[regFull[name]] && !strings.HasPrefix(op, "cmp") && !strings.HasPrefix(op, "test") && !fn.saved[regFull[name]] {
+				return fmt.Errorf("write to callee-saved register %%%s not saved by prologue", regFull[name])
+			}
+		default:
+			m := memRegexp.FindStringSubmatch(arg)
+			if m == nil {
+				return fmt.Errorf("invalid operand %q", arg)
+			}
+			if m[2] != "" && regSizes[m[2]] != 8 {
+				return fmt.Errorf("base register of memory operand %q not 64-bit", arg)
+			}
+			nmem++
+		}
+	}
+	if nmem > 1 {
+		return fmt.Errorf("more than one memory operand")
+	}
+	if op == "leaq" && !memRegexp.MatchString(args[0]) {
+		return fmt.Errorf("leaq of non-memory operand")
+	}
+	return nil
+}
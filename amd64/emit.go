@@ -0,0 +1,151 @@
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+)
+
+// function emits the given function definition.
+func (g *generator) function(f *ir.Function) {
+	dbg.Printf("generate function: %v", f.Name)
+	fn := lower(f)
+	intervals := liveIntervals(fn)
+	allocate(fn, intervals)
+	assigned := make(map[*vreg]*interval)
+	for _, it := range intervals {
+		assigned[it.v] = it
+	}
+	fr := fn.frame
+
+	if f.Name == "main" {
+		g.emit(".globl %s", fn.name)
+	}
+	g.emit(".type %s, @function", fn.name)
+	g.label(fn.name)
+
+	// Prologue.
+	g.emit("pushq %%rbp")
+	g.emit("movq %%rsp, %%rbp")
+	if size := fr.total(); size > 0 {
+		g.emit("subq $%d, %%rsp", size)
+	}
+	for i, r := range fr.saved {
+		g.emit("movq %%%s, %d(%%rbp)", r.name(8), fr.saveOffsets[i])
+	}
+	for i, off := range fr.params {
+		g.emit("movq %%%s, %d(%%rbp)", argRegs[i].name(8), off)
+	}
+
+	for i, b := range fn.blocks {
+		if i != 0 {
+			g.label(string(b.label))
+		}
+		next := fn.ret
+		if i+1 < len(fn.blocks) {
+			next = fn.blocks[i+1].label
+		}
+		for _, inst := range b.insts {
+			// Fall through to the next basic block.
+			if inst.op == "jmp" && inst.args[0] == operand(next) {
+				continue
+			}
+			for _, inst := range rewrite(inst, assigned, fr) {
+				g.emit("%v", inst)
+			}
+		}
+	}
+
+	// Epilogue.
+	g.label(string(fn.ret))
+	for i, r := range fr.saved {
+		g.emit("movq %d(%%rbp), %%%s", fr.saveOffsets[i], r.name(8))
+	}
+	g.emit("leave")
+	g.emit("ret")
+	g.emit(".size %s, .-%s", fn.name, fn.name)
+}
+
+// rewrite returns the given instruction with virtual registers replaced by
+// their assigned physical registers. Spilled virtual registers are loaded into
+// scratch registers from their stack slot before the instruction, and stored
+// back after the instruction if written.
+func rewrite(inst *minst, intervals map[*vreg]*interval, fr *frame) []*minst {
+	scratch := make(map[*vreg]preg)
+	var spilled []*vreg
+	// phys returns the physical register operand of the given register operand.
+	phys := func(r *reg) *reg {
+		if r.v == nil {
+			return r
+		}
+		it, ok := intervals[r.v]
+		if !ok {
+			panic(fmt.Sprintf("unable to locate live interval of virtual register %v", r))
+		}
+		if !it.spilled {
+			return &reg{p: it.reg, size: r.size}
+		}
+		p, ok := scratch[r.v]
+		if !ok {
+			if len(spilled) >= len(scratchRegs) {
+				panic(fmt.Sprintf("too many spilled virtual registers in instruction %v", inst))
+			}
+			p = scratchRegs[len(spilled)]
+			scratch[r.v] = p
+			spilled = append(spilled, r.v)
+		}
+		return &reg{p: p, size: r.size}
+	}
+	out := &minst{op: inst.op, kind: inst.kind}
+	for _, arg := range inst.args {
+		switch arg := arg.(type) {
+		case *reg:
+			out.args = append(out.args, phys(arg))
+		case *mem:
+			m := *arg
+			if m.base != nil {
+				m.base = phys(m.base)
+			}
+			out.args = append(out.args, &m)
+		default:
+			out.args = append(out.args, arg)
+		}
+	}
+
+	// Omit moves between identical registers.
+	if inst.kind == kindMove {
+		src, ok1 := out.args[0].(*reg)
+		dst, ok2 := out.args[1].(*reg)
+		if ok1 && ok2 && src.p == dst.p && len(spilled) == 0 {
+			return nil
+		}
+	}
+
+	// Reload and spill the scratch registers of spilled virtual registers.
+	uses, defs := inst.regs()
+	var insts []*minst
+	for _, v := range spilled {
+		if containsReg(uses, v) {
+			slot := &mem{base: &reg{p: rbp, size: 8}, off: fr.spills[v]}
+			insts = append(insts, &minst{op: "movq", args: []operand{slot, &reg{p: scratch[v], size: 8}}, kind: kindDef})
+		}
+	}
+	insts = append(insts, out)
+	for _, v := range spilled {
+		if containsReg(defs, v) {
+			slot := &mem{base: &reg{p: rbp, size: 8}, off: fr.spills[v]}
+			insts = append(insts, &minst{op: "movq", args: []operand{&reg{p: scratch[v], size: 8}, slot}, kind: kindUse})
+		}
+	}
+	return insts
+}
+
+// containsReg reports whether the given list of virtual registers contains v.
+func containsReg(vs []*vreg, v *vreg) bool {
+	for _, x := range vs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package amd64
+
+import (
+	"github.com/llir/llvm/ir"
+)
+
+// A frame represents the stack frame layout of a function.
+type frame struct {
+	// Maps from local variables to their offset relative to the frame pointer.
+	offsets map[*ir.InstAlloca]int64
+	// Offsets of the arguments passed in registers, relative to the frame
+	// pointer.
+	params []int64
+	// Maps from spilled virtual registers to the offset of their stack slot,
+	// relative to the frame pointer.
+	spills map[*vreg]int64
+	// Callee-saved registers used by the function.
+	saved []preg
+	// Offsets of the saved callee-saved registers, relative to the frame
+	// pointer.
+	saveOffsets []int64
+	// Size in bytes of the stack frame below the frame pointer, excluding the
+	// outgoing argument area.
+	size int64
+	// Size in bytes of the outgoing argument area, at the bottom of the stack
+	// frame.
+	outgoing int64
+}
+
+// newFrame returns the stack frame layout of the given function, prior to
+// register allocation.
+func newFrame(f *ir.Function) *frame {
+	fr := &frame{offsets: make(map[*ir.InstAlloca]int64), spills: make(map[*vreg]int64)}
+	for i := range f.Params() {
+		if i < len(argRegs) {
+			fr.params = append(fr.params, fr.alloc(8))
+		}
+	}
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			if inst, ok := inst.(*ir.InstAlloca); ok {
+				fr.offsets[inst] = fr.alloc(sizeof(inst.Elem))
+			}
+		}
+	}
+	return fr
+}
+
+// alloc allocates n bytes of stack space, and returns its offset relative to
+// the frame pointer.
+func (fr *frame) alloc(n int64) int64 {
+	fr.size = align(fr.size+n, 8)
+	return -fr.size
+}
+
+// spill allocates a stack slot for the given spilled virtual register.
+func (fr *frame) spill(v *vreg) {
+	fr.spills[v] = fr.alloc(8)
+}
+
+// save allocates a stack slot for the given callee-saved register.
+func (fr *frame) save(r preg) {
+	fr.saved = append(fr.saved, r)
+	fr.saveOffsets = append(fr.saveOffsets, fr.alloc(8))
+}
+
+// total returns the total size in bytes of the stack frame below the frame
+// pointer, keeping the stack pointer 16-byte aligned at calls.
+func (fr *frame) total() int64 {
+	return align(fr.size+fr.outgoing, 16)
+}
@@ -0,0 +1,235 @@
+package amd64
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A preg represents a physical register.
+type preg int
+
+// Physical registers, in encoding order.
+const (
+	rax preg = iota
+	rcx
+	rdx
+	rbx
+	rsp
+	rbp
+	rsi
+	rdi
+	r8
+	r9
+	r10
+	r11
+	r12
+	r13
+	r14
+	r15
+)
+
+// regNames maps from physical registers to their 8-, 32- and 64-bit names.
+var regNames = [...][3]string{
+	rax: {"al", "eax", "rax"},
+	rcx: {"cl", "ecx", "rcx"},
+	rdx: {"dl", "edx", "rdx"},
+	rbx: {"bl", "ebx", "rbx"},
+	rsp: {"spl", "esp", "rsp"},
+	rbp: {"bpl", "ebp", "rbp"},
+	rsi: {"sil", "esi", "rsi"},
+	rdi: {"dil", "edi", "rdi"},
+	r8:  {"r8b", "r8d", "r8"},
+	r9:  {"r9b", "r9d", "r9"},
+	r10: {"r10b", "r10d", "r10"},
+	r11: {"r11b", "r11d", "r11"},
+	r12: {"r12b", "r12d", "r12"},
+	r13: {"r13b", "r13d", "r13"},
+	r14: {"r14b", "r14d", "r14"},
+	r15: {"r15b", "r15d", "r15"},
+}
+
+var (
+	// calleeSaved specifies the allocatable callee-saved registers.
+	calleeSaved = []preg{rbx, r12, r13, r14, r15}
+	// callerSaved specifies the allocatable caller-saved registers.
+	callerSaved = []preg{rsi, rdi, r8, r9}
+	// argRegs specifies the registers of the first six arguments.
+	argRegs = []preg{rdi, rsi, rdx, rcx, r8, r9}
+	// scratchRegs specifies the registers holding spilled virtual registers
+	// while used by an instruction.
+	scratchRegs = []preg{r10, r11}
+)
+
+// name returns the name of the physical register when accessed with the given
+// size in bytes.
+func (r preg) name(size int64) string {
+	switch size {
+	case 1:
+		return regNames[r][0]
+	case 4:
+		return regNames[r][1]
+	default:
+		return regNames[r][2]
+	}
+}
+
+// A vreg represents a virtual register.
+type vreg struct {
+	// Virtual register number.
+	id int
+}
+
+// An operand represents an instruction operand; one of the following types.
+//
+//    *reg
+//    imm
+//    *mem
+//    label
+type operand interface {
+	isOperand()
+}
+
+// A reg represents a register operand, accessed with a given size in bytes.
+// The register is either a virtual register or a physical register.
+type reg struct {
+	// Virtual register; or nil if physical register.
+	v *vreg
+	// Physical register.
+	p preg
+	// Size in bytes; 1, 4 or 8.
+	size int64
+}
+
+// An imm represents an immediate operand.
+type imm int64
+
+// A mem represents a memory operand.
+type mem struct {
+	// Base register; or nil if sym is non-empty.
+	base *reg
+	// Symbol addressed relative to the instruction pointer; or empty if base is
+	// non-nil.
+	sym string
+	// Offset in bytes.
+	off int64
+}
+
+// A label represents a branch target operand.
+type label string
+
+func (*reg) isOperand()  {}
+func (imm) isOperand()   {}
+func (*mem) isOperand()  {}
+func (label) isOperand() {}
+
+// String returns the assembly representation of the operand.
+func (r *reg) String() string {
+	if r.v != nil {
+		return fmt.Sprintf("%%v%d", r.v.id)
+	}
+	return "%" + r.p.name(r.size)
+}
+
+// String returns the assembly representation of the operand.
+func (x imm) String() string {
+	return fmt.Sprintf("$%d", int64(x))
+}
+
+// String returns the assembly representation of the operand.
+func (m *mem) String() string {
+	if m.base == nil {
+		if m.off != 0 {
+			return fmt.Sprintf("%s%+d(%%rip)", m.sym, m.off)
+		}
+		return m.sym + "(%rip)"
+	}
+	base := &reg{v: m.base.v, p: m.base.p, size: 8}
+	if m.off != 0 {
+		return fmt.Sprintf("%d(%s)", m.off, base)
+	}
+	return fmt.Sprintf("(%s)", base)
+}
+
+// String returns the assembly representation of the operand.
+func (l label) String() string {
+	return string(l)
+}
+
+// kind specifies how an instruction accesses its register operands.
+type kind int
+
+// Instruction kinds.
+const (
+	// All register operands are read.
+	kindUse kind = iota
+	// The last operand is written, all other register operands are read.
+	kindDef
+	// Move; as kindDef, but may be omitted if the source and destination are
+	// assigned the same register.
+	kindMove
+	// The last operand is read and written, all other register operands are
+	// read.
+	kindUpdate
+	// Call instruction; clobbers the caller-saved registers.
+	kindCall
+)
+
+// A minst represents an x86-64 instruction.
+type minst struct {
+	// Mnemonic, including the operand size suffix.
+	op string
+	// Operands, in AT&T order (source before destination).
+	args []operand
+	// Register operand access kind.
+	kind kind
+}
+
+// String returns the assembly representation of the instruction.
+func (inst *minst) String() string {
+	if len(inst.args) == 0 {
+		return inst.op
+	}
+	var args []string
+	for _, arg := range inst.args {
+		args = append(args, fmt.Sprint(arg))
+	}
+	return inst.op + " " + strings.Join(args, ", ")
+}
+
+// regs returns the virtual registers read and written by the instruction.
+func (inst *minst) regs() (uses, defs []*vreg) {
+	for i, arg := range inst.args {
+		last := i == len(inst.args)-1
+		switch arg := arg.(type) {
+		case *reg:
+			if arg.v == nil {
+				continue
+			}
+			def := inst.kind == kindDef || inst.kind == kindMove
+			if !last || !def {
+				uses = append(uses, arg.v)
+			}
+			if last && (def || inst.kind == kindUpdate) {
+				defs = append(defs, arg.v)
+			}
+		case *mem:
+			// Memory operands read their base register.
+			if arg.base != nil && arg.base.v != nil {
+				uses = append(uses, arg.base.v)
+			}
+		}
+	}
+	return uses, defs
+}
+
+// suffix returns the instruction suffix of the given operand size.
+func suffix(size int64) string {
+	switch size {
+	case 1:
+		return "b"
+	case 4:
+		return "l"
+	default:
+		return "q"
+	}
+}
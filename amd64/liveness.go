@@ -0,0 +1,143 @@
+package amd64
+
+import (
+	"sort"
+)
+
+// An interval represents the live interval of a virtual register; the range of
+// instruction positions from its first definition to its last use, including
+// the basic blocks through which it is live.
+type interval struct {
+	// Virtual register.
+	v *vreg
+	// First and last instruction position of the live interval.
+	start, end int
+	// Specifies whether the virtual register is live across a call.
+	call bool
+	// Physical register assigned to the virtual register; valid if not spilled.
+	reg preg
+	// Specifies whether the virtual register is spilled to the stack frame.
+	spilled bool
+}
+
+// A regSet represents a set of virtual registers.
+type regSet map[*vreg]bool
+
+// liveIntervals returns the live intervals of the virtual registers of the
+// given function, sorted by start position.
+func liveIntervals(fn *function) []*interval {
+	// Compute the set of virtual registers read before being written (uses)
+	// and the set of virtual registers written (defs) by each basic block.
+	uses := make(map[*mblock]regSet)
+	defs := make(map[*mblock]regSet)
+	for _, b := range fn.blocks {
+		uses[b], defs[b] = make(regSet), make(regSet)
+		for _, inst := range b.insts {
+			u, d := inst.regs()
+			for _, v := range u {
+				if !defs[b][v] {
+					uses[b][v] = true
+				}
+			}
+			for _, v := range d {
+				defs[b][v] = true
+			}
+		}
+	}
+
+	// Solve the backward dataflow equations of liveness until reaching a fixed
+	// point.
+	//
+	//    out(b) = union of in(s) for each successor s of b
+	//    in(b)  = uses(b) ∪ (out(b) - defs(b))
+	liveIn := make(map[*mblock]regSet)
+	liveOut := make(map[*mblock]regSet)
+	for _, b := range fn.blocks {
+		liveIn[b], liveOut[b] = make(regSet), make(regSet)
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := len(fn.blocks) - 1; i >= 0; i-- {
+			b := fn.blocks[i]
+			for _, succ := range b.succs {
+				for v := range liveIn[succ] {
+					if !liveOut[b][v] {
+						liveOut[b][v] = true
+						changed = true
+					}
+				}
+			}
+			for v := range uses[b] {
+				if !liveIn[b][v] {
+					liveIn[b][v] = true
+					changed = true
+				}
+			}
+			for v := range liveOut[b] {
+				if !defs[b][v] && !liveIn[b][v] {
+					liveIn[b][v] = true
+					changed = true
+				}
+			}
+		}
+	}
+
+	// Build live intervals, spanning every position at which each virtual
+	// register is live.
+	intervals := make(map[*vreg]*interval)
+	extend := func(v *vreg, pos int) {
+		it, ok := intervals[v]
+		if !ok {
+			intervals[v] = &interval{v: v, start: pos, end: pos}
+			return
+		}
+		if pos < it.start {
+			it.start = pos
+		}
+		if pos > it.end {
+			it.end = pos
+		}
+	}
+	var calls []int
+	pos := 0
+	for _, b := range fn.blocks {
+		start, end := pos, pos+len(b.insts)-1
+		for v := range liveIn[b] {
+			extend(v, start)
+		}
+		for _, inst := range b.insts {
+			u, d := inst.regs()
+			for _, v := range u {
+				extend(v, pos)
+			}
+			for _, v := range d {
+				extend(v, pos)
+			}
+			if inst.kind == kindCall {
+				calls = append(calls, pos)
+			}
+			pos++
+		}
+		for v := range liveOut[b] {
+			extend(v, end)
+		}
+	}
+
+	var its []*interval
+	for _, it := range intervals {
+		for _, pos := range calls {
+			if it.start < pos && pos < it.end {
+				it.call = true
+				break
+			}
+		}
+		its = append(its, it)
+	}
+	sort.Slice(its, func(i, j int) bool {
+		if its[i].start != its[j].start {
+			return its[i].start < its[j].start
+		}
+		return its[i].v.id < its[j].v.id
+	})
+	return its
+}
@@ -0,0 +1,579 @@
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// A function represents the x86-64 instructions of a function definition.
+type function struct {
+	// Assembly symbol of the function.
+	name string
+	// Basic blocks of the function, in layout order.
+	blocks []*mblock
+	// Label of the epilogue.
+	ret label
+	// Stack frame layout.
+	frame *frame
+	// Number of virtual registers.
+	nvregs int
+}
+
+// An mblock represents a basic block of x86-64 instructions.
+type mblock struct {
+	// Label of the basic block.
+	label label
+	// Instructions of the basic block.
+	insts []*minst
+	// Successor basic blocks.
+	succs []*mblock
+}
+
+// newVreg returns a new virtual register of the function.
+func (fn *function) newVreg() *vreg {
+	v := &vreg{id: fn.nvregs}
+	fn.nvregs++
+	return v
+}
+
+// A lowerer keeps track of the state required to select instructions for a
+// function.
+type lowerer struct {
+	// Function being lowered.
+	fn *function
+	// Basic block being lowered.
+	cur *mblock
+	// Maps from LLVM IR basic blocks to their lowered basic block.
+	blocks map[*ir.BasicBlock]*mblock
+	// Maps from values (parameters and instructions) to their virtual register.
+	vregs map[value.Value]*vreg
+	// Maps from phi instructions to the virtual register holding their incoming
+	// value.
+	//
+	// Predecessors copy the incoming value of a phi instruction to its incoming
+	// virtual register before branching to its basic block; the phi instruction
+	// then copies the incoming value to its own virtual register, thus
+	// preventing incoming values from being overwritten before being used by
+	// other phi instructions.
+	incoming map[*ir.InstPhi]*vreg
+}
+
+// lower selects x86-64 instructions for the given function definition.
+func lower(f *ir.Function) *function {
+	name := mangle(f.Name)
+	fn := &function{name: name, ret: label(fmt.Sprintf(".L%s.ret", name)), frame: newFrame(f)}
+	l := &lowerer{
+		fn:       fn,
+		blocks:   make(map[*ir.BasicBlock]*mblock),
+		vregs:    make(map[value.Value]*vreg),
+		incoming: make(map[*ir.InstPhi]*vreg),
+	}
+	for i, block := range f.Blocks {
+		b := &mblock{label: label(fmt.Sprintf(".L%s.%d", name, i))}
+		fn.blocks = append(fn.blocks, b)
+		l.blocks[block] = b
+	}
+
+	// Assign virtual registers to parameters and value producing instructions.
+	for _, param := range f.Params() {
+		l.vregs[param] = fn.newVreg()
+	}
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			switch inst := inst.(type) {
+			case *ir.InstAlloca:
+				// Local variables are addressed relative to the frame pointer.
+			case *ir.InstPhi:
+				l.incoming[inst] = fn.newVreg()
+				l.vregs[inst] = fn.newVreg()
+			case value.Value:
+				if !types.IsVoid(inst.Type()) {
+					l.vregs[inst] = fn.newVreg()
+				}
+			}
+		}
+	}
+
+	// Load arguments from the stack frame.
+	l.cur = fn.blocks[0]
+	for i, param := range f.Params() {
+		src := &mem{base: phys(rbp, 8), off: 16 + 8*int64(i-len(argRegs))}
+		if i < len(argRegs) {
+			src.off = fn.frame.params[i]
+		}
+		l.load(src, l.vr(param), sizeof(param.Type()))
+	}
+
+	for _, block := range f.Blocks {
+		l.cur = l.blocks[block]
+		for _, inst := range block.Insts {
+			l.inst(inst)
+		}
+		l.term(block)
+	}
+	return fn
+}
+
+// emit appends the given instruction to the current basic block.
+func (l *lowerer) emit(kind kind, op string, args ...operand) {
+	l.cur.insts = append(l.cur.insts, &minst{op: op, args: args, kind: kind})
+}
+
+// inst lowers the given instruction.
+func (l *lowerer) inst(inst ir.Instruction) {
+	switch inst := inst.(type) {
+	// Memory instructions.
+	case *ir.InstAlloca:
+		// Stack space of local variables is allocated by the prologue.
+	case *ir.InstLoad:
+		l.load(l.addr(inst.Src), l.vr(inst), sizeof(inst.Type()))
+	case *ir.InstStore:
+		size := sizeof(inst.Src.Type())
+		dst := l.addr(inst.Dst)
+		l.emit(kindUse, "mov"+suffix(size), l.operand(inst.Src, size), dst)
+	case *ir.InstGetElementPtr:
+		l.gep(inst)
+
+	// Binary instructions.
+	case *ir.InstAdd:
+		l.binary(inst, "add", inst.X, inst.Y)
+	case *ir.InstSub:
+		l.binary(inst, "sub", inst.X, inst.Y)
+	case *ir.InstMul:
+		l.binary(inst, "imul", inst.X, inst.Y)
+	case *ir.InstSDiv:
+		l.div(inst, inst.X, inst.Y, rax)
+	case *ir.InstSRem:
+		l.div(inst, inst.X, inst.Y, rdx)
+	case *ir.InstAnd:
+		l.binary(inst, "and", inst.X, inst.Y)
+	case *ir.InstOr:
+		l.binary(inst, "or", inst.X, inst.Y)
+	case *ir.InstXor:
+		l.binary(inst, "xor", inst.X, inst.Y)
+	case *ir.InstShl:
+		l.shift(inst, "shl", inst.X, inst.Y)
+	case *ir.InstAShr:
+		l.shift(inst, "sar", inst.X, inst.Y)
+
+	// Comparison instructions.
+	case *ir.InstICmp:
+		size := sizeof(inst.X.Type())
+		x := l.reg(inst.X, size)
+		l.emit(kindUse, "cmp"+suffix(size), l.operand(inst.Y, size), x)
+		dst := l.vr(inst)
+		dst.size = 1
+		l.emit(kindDef, "set"+condCode(inst.Cond), dst)
+
+	// Conversion instructions.
+	case *ir.InstTrunc:
+		dst := l.vr(inst)
+		l.move(l.operand(inst.From, dst.size), dst)
+		if types.IsBool(inst.Type()) {
+			l.emit(kindUpdate, "andl", imm(1), dst)
+		}
+	case *ir.InstZExt:
+		dst := l.vr(inst)
+		from := sizeof(inst.From.Type())
+		if x, ok := l.operand(inst.From, from).(imm); ok {
+			l.move(imm(int64(x)&(1<<uint(8*from)-1)), dst)
+			break
+		}
+		if from == 1 {
+			l.emit(kindDef, "movzb"+suffix(dst.size), l.reg(inst.From, 1), dst)
+		} else {
+			// Writes to 32-bit registers clear the upper half of the register.
+			tmp := *dst
+			tmp.size = 4
+			l.emit(kindDef, "movl", l.reg(inst.From, 4), &tmp)
+		}
+	case *ir.InstSExt:
+		dst := l.vr(inst)
+		from := sizeof(inst.From.Type())
+		switch {
+		case types.IsBool(inst.From.Type()):
+			l.emit(kindDef, "movzb"+suffix(dst.size), l.reg(inst.From, 1), dst)
+			l.emit(kindUpdate, "neg"+suffix(dst.size), dst)
+		case from == dst.size:
+			l.move(l.operand(inst.From, from), dst)
+		default:
+			l.emit(kindDef, "movs"+suffix(from)+suffix(dst.size), l.reg(inst.From, from), dst)
+		}
+
+	// Other instructions.
+	case *ir.InstPhi:
+		dst := l.vr(inst)
+		l.move(&reg{v: l.incoming[inst], size: dst.size}, dst)
+	case *ir.InstSelect:
+		dst := l.vr(inst)
+		if c, ok := inst.Cond.(*constant.Int); ok {
+			v := inst.Y
+			if c.X.Int64()&1 != 0 {
+				v = inst.X
+			}
+			l.move(l.operand(v, dst.size), dst)
+			break
+		}
+		cond := l.reg(inst.Cond, 1)
+		x := l.reg(inst.X, dst.size)
+		l.move(l.operand(inst.Y, dst.size), dst)
+		l.emit(kindUse, "testb", cond, cond)
+		l.emit(kindUpdate, "cmovne"+suffix(dst.size), x, dst)
+	case *ir.InstCall:
+		l.call(inst)
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+}
+
+// binary lowers the binary operation op of x and y, storing the result in the
+// virtual register of inst.
+func (l *lowerer) binary(inst value.Value, op string, x, y value.Value) {
+	dst := l.vr(inst)
+	l.move(l.operand(x, dst.size), dst)
+	l.emit(kindUpdate, op+suffix(dst.size), l.operand(y, dst.size), dst)
+}
+
+// div lowers the signed division of x by y, storing the quotient (%rax) or
+// remainder (%rdx) in the virtual register of inst.
+func (l *lowerer) div(inst value.Value, x, y value.Value, result preg) {
+	dst := l.vr(inst)
+	size := dst.size
+	l.move(l.extend(x, size), phys(rax, size))
+	if size == 8 {
+		l.emit(kindUse, "cqto")
+	} else {
+		l.emit(kindUse, "cltd")
+	}
+	d := l.extend(y, size)
+	if _, ok := d.(imm); ok {
+		// The divisor of idiv may not be an immediate.
+		d = l.reg(y, size)
+	}
+	l.emit(kindUse, "idiv"+suffix(size), d)
+	l.move(phys(result, size), dst)
+}
+
+// shift lowers the shift operation op of x by y, storing the result in the
+// virtual register of inst.
+func (l *lowerer) shift(inst value.Value, op string, x, y value.Value) {
+	dst := l.vr(inst)
+	count := l.operand(y, 4)
+	if _, ok := count.(imm); !ok {
+		l.move(count, phys(rcx, 4))
+		count = phys(rcx, 1)
+	}
+	l.move(l.extend(x, dst.size), dst)
+	l.emit(kindUpdate, op+suffix(dst.size), count, dst)
+}
+
+// extend returns an operand holding the value v sign-extended to the given
+// register size; 8-bit values are only guaranteed to have valid low bytes in
+// registers.
+func (l *lowerer) extend(v value.Value, size int64) operand {
+	if sizeof(v.Type()) != 1 {
+		return l.operand(v, size)
+	}
+	x := l.operand(v, 1)
+	if _, ok := x.(imm); ok {
+		return x
+	}
+	tmp := l.newReg(size)
+	l.emit(kindDef, "movsb"+suffix(size), x, tmp)
+	return tmp
+}
+
+// condCode returns the condition code of the given integer comparison
+// predicate.
+func condCode(cond ir.IntPred) string {
+	switch cond {
+	case ir.IntEQ:
+		return "e"
+	case ir.IntNE:
+		return "ne"
+	case ir.IntUGT:
+		return "a"
+	case ir.IntUGE:
+		return "ae"
+	case ir.IntULT:
+		return "b"
+	case ir.IntULE:
+		return "be"
+	case ir.IntSGT:
+		return "g"
+	case ir.IntSGE:
+		return "ge"
+	case ir.IntSLT:
+		return "l"
+	case ir.IntSLE:
+		return "le"
+	default:
+		panic(fmt.Sprintf("support for integer comparison predicate %v not yet implemented", cond))
+	}
+}
+
+// gep lowers the address computation of the given getelementptr instruction.
+func (l *lowerer) gep(inst *ir.InstGetElementPtr) {
+	dst := l.vr(inst)
+	// Fold constant indices into the displacement of the base address.
+	addr := *l.addr(inst.Src)
+	type scaled struct {
+		index value.Value
+		size  int64
+	}
+	var vars []scaled
+	elem := inst.Elem
+	for i, index := range inst.Indices {
+		if i > 0 {
+			elem = elemType(elem)
+		}
+		size := sizeof(elem)
+		if c, ok := index.(*constant.Int); ok {
+			addr.off += c.X.Int64() * size
+			continue
+		}
+		vars = append(vars, scaled{index: index, size: size})
+	}
+	l.emit(kindDef, "leaq", &addr, dst)
+	for _, v := range vars {
+		tmp := l.newReg(8)
+		if sizeof(v.index.Type()) == 8 {
+			l.move(l.operand(v.index, 8), tmp)
+		} else {
+			l.emit(kindDef, "movs"+suffix(sizeof(v.index.Type()))+"q", l.reg(v.index, sizeof(v.index.Type())), tmp)
+		}
+		if v.size != 1 {
+			l.emit(kindUpdate, "imulq", imm(v.size), tmp)
+		}
+		l.emit(kindUpdate, "addq", tmp, dst)
+	}
+}
+
+// elemType returns the type indexed by a getelementptr index into the given
+// type.
+func elemType(typ types.Type) types.Type {
+	switch t := typ.(type) {
+	case *types.ArrayType:
+		return t.Elem
+	case *types.PointerType:
+		return t.Elem
+	default:
+		panic(fmt.Sprintf("support for getelementptr index into type %T not yet implemented", t))
+	}
+}
+
+// call lowers the given call instruction.
+func (l *lowerer) call(inst *ir.InstCall) {
+	fr := l.fn.frame
+	n := int64(len(inst.Args))
+	if n*8 > fr.outgoing {
+		fr.outgoing = n * 8
+	}
+	// Arguments passed on the stack are stored at the bottom of the outgoing
+	// argument area, followed by the arguments passed in registers.
+	nstack := n - int64(len(argRegs))
+	if nstack < 0 {
+		nstack = 0
+	}
+	slot := func(i int) *mem {
+		off := 8 * (nstack + int64(i))
+		if i >= len(argRegs) {
+			off = 8 * int64(i-len(argRegs))
+		}
+		return &mem{base: phys(rsp, 8), off: off}
+	}
+	for i, arg := range inst.Args {
+		size := regSize(arg.Type())
+		l.emit(kindUse, "mov"+suffix(size), l.operand(arg, size), slot(i))
+	}
+	var target operand
+	switch callee := inst.Callee.(type) {
+	case *ir.Function:
+		if len(callee.Blocks) == 0 {
+			// External functions are resolved through the procedure linkage
+			// table.
+			target = label(mangle(callee.Name) + "@PLT")
+		} else {
+			target = label(mangle(callee.Name))
+		}
+	default:
+		l.move(l.operand(callee, 8), phys(rax, 8))
+		target = label("*%rax")
+	}
+	for i := range inst.Args {
+		if i >= len(argRegs) {
+			break
+		}
+		l.emit(kindDef, "movq", slot(i), phys(argRegs[i], 8))
+	}
+	l.emit(kindCall, "call", target)
+	if !types.IsVoid(inst.Type()) {
+		dst := l.vr(inst)
+		l.move(phys(rax, dst.size), dst)
+	}
+}
+
+// term lowers the terminator of the given basic block.
+func (l *lowerer) term(block *ir.BasicBlock) {
+	switch term := block.Term.(type) {
+	case *ir.TermRet:
+		if term.X != nil {
+			size := regSize(term.X.Type())
+			l.move(l.operand(term.X, size), phys(rax, size))
+		}
+		l.emit(kindUse, "jmp", l.fn.ret)
+	case *ir.TermBr:
+		l.phiCopies(block, term.Target)
+		l.jump(term.Target)
+	case *ir.TermCondBr:
+		l.phiCopies(block, term.TargetTrue)
+		l.phiCopies(block, term.TargetFalse)
+		if c, ok := term.Cond.(*constant.Int); ok {
+			if c.X.Int64()&1 != 0 {
+				l.jump(term.TargetTrue)
+			} else {
+				l.jump(term.TargetFalse)
+			}
+			break
+		}
+		cond := l.reg(term.Cond, 1)
+		l.emit(kindUse, "testb", cond, cond)
+		l.emit(kindUse, "jne", l.blocks[term.TargetTrue].label)
+		l.cur.succs = append(l.cur.succs, l.blocks[term.TargetTrue])
+		l.jump(term.TargetFalse)
+	case *ir.TermUnreachable:
+		l.emit(kindUse, "ud2")
+	default:
+		panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+	}
+}
+
+// jump lowers an unconditional branch to the given basic block.
+func (l *lowerer) jump(target *ir.BasicBlock) {
+	succ := l.blocks[target]
+	l.emit(kindUse, "jmp", succ.label)
+	l.cur.succs = append(l.cur.succs, succ)
+}
+
+// phiCopies lowers copies of the incoming values from the predecessor basic
+// block pred, to the phi instructions of the successor basic block succ.
+func (l *lowerer) phiCopies(pred, succ *ir.BasicBlock) {
+	for _, inst := range succ.Insts {
+		phi, ok := inst.(*ir.InstPhi)
+		if !ok {
+			continue
+		}
+		for _, inc := range phi.Incs {
+			if inc.Pred == pred {
+				size := regSize(phi.Type())
+				l.move(l.operand(inc.X, size), &reg{v: l.incoming[phi], size: size})
+			}
+		}
+	}
+}
+
+// move lowers a register sized move from src to dst.
+func (l *lowerer) move(src operand, dst *reg) {
+	l.emit(kindMove, "mov"+suffix(dst.size), src, dst)
+}
+
+// load lowers a load of the given size from src into dst.
+func (l *lowerer) load(src *mem, dst *reg, size int64) {
+	if size == 1 {
+		l.emit(kindDef, "movsb"+suffix(dst.size), src, dst)
+		return
+	}
+	l.emit(kindDef, "mov"+suffix(size), src, dst)
+}
+
+// vr returns the register operand of the virtual register of the given value.
+func (l *lowerer) vr(v value.Value) *reg {
+	vreg, ok := l.vregs[v]
+	if !ok {
+		panic(fmt.Sprintf("unable to locate virtual register of value %T", v))
+	}
+	return &reg{v: vreg, size: regSize(v.Type())}
+}
+
+// newReg returns a register operand of a new virtual register.
+func (l *lowerer) newReg(size int64) *reg {
+	return &reg{v: l.fn.newVreg(), size: size}
+}
+
+// operand returns an immediate or register operand of the given size holding
+// the value v.
+func (l *lowerer) operand(v value.Value, size int64) operand {
+	switch v := v.(type) {
+	case *constant.Int:
+		x := v.X.Int64()
+		if types.IsBool(v.Typ) {
+			x &= 1
+		}
+		return imm(x)
+	case *ir.Global, *ir.Function, *constant.ExprGetElementPtr, *ir.InstAlloca:
+		tmp := l.newReg(8)
+		l.emit(kindDef, "leaq", l.addr(v), tmp)
+		return &reg{v: tmp.v, size: size}
+	default:
+		r := l.vr(v)
+		r.size = size
+		return r
+	}
+}
+
+// reg returns a register operand of the given size holding the value v.
+func (l *lowerer) reg(v value.Value, size int64) *reg {
+	x := l.operand(v, size)
+	if r, ok := x.(*reg); ok {
+		return r
+	}
+	tmp := l.newReg(regSize(v.Type()))
+	l.move(x, tmp)
+	return &reg{v: tmp.v, size: size}
+}
+
+// addr returns the memory operand of the given address.
+func (l *lowerer) addr(v value.Value) *mem {
+	switch v := v.(type) {
+	case *ir.InstAlloca:
+		return &mem{base: phys(rbp, 8), off: l.fn.frame.offsets[v]}
+	case *ir.Global:
+		return &mem{sym: mangle(v.Name)}
+	case *ir.Function:
+		return &mem{sym: mangle(v.Name)}
+	case *constant.ExprGetElementPtr:
+		addr := *l.addr(v.Src)
+		elem := v.Elem
+		for i, index := range v.Indices {
+			if i > 0 {
+				elem = elemType(elem)
+			}
+			x, ok := index.(*constant.Int)
+			if !ok {
+				panic(fmt.Sprintf("support for getelementptr index %T not yet implemented", index))
+			}
+			addr.off += x.X.Int64() * sizeof(elem)
+		}
+		return &addr
+	default:
+		return &mem{base: l.reg(v, 8)}
+	}
+}
+
+// phys returns a register operand of the given physical register.
+func phys(p preg, size int64) *reg {
+	return &reg{p: p, size: size}
+}
+
+// regSize returns the size in bytes of registers holding values of the given
+// type; 8-bit values are held in 32-bit registers.
+func regSize(typ types.Type) int64 {
+	if sizeof(typ) == 8 {
+		return 8
+	}
+	return 4
+}
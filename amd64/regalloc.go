@@ -0,0 +1,97 @@
+package amd64
+
+// allocate assigns physical registers to the given live intervals, sorted by
+// start position, using the linear scan register allocation algorithm of
+// Poletto and Sarkar. Intervals are spilled to the stack frame of the
+// function when running out of physical registers.
+func allocate(fn *function, intervals []*interval) {
+	fr := fn.frame
+	// Active intervals, occupying physical registers.
+	var active []*interval
+	free := make(map[preg]bool)
+	for _, r := range callerSaved {
+		free[r] = true
+	}
+	for _, r := range calleeSaved {
+		free[r] = true
+	}
+	used := make(map[preg]bool)
+	for _, it := range intervals {
+		// Expire intervals ending before the start of the current interval.
+		var remaining []*interval
+		for _, a := range active {
+			if a.end < it.start {
+				free[a.reg] = true
+				continue
+			}
+			remaining = append(remaining, a)
+		}
+		active = remaining
+
+		// Intervals live across calls are assigned callee-saved registers, as
+		// calls clobber the caller-saved registers. Other intervals prefer
+		// caller-saved registers, which need not be saved by the prologue.
+		candidates := calleeSaved
+		if !it.call {
+			candidates = append(append([]preg{}, callerSaved...), calleeSaved...)
+		}
+		assigned := false
+		for _, r := range candidates {
+			if free[r] {
+				free[r] = false
+				it.reg = r
+				used[r] = true
+				active = append(active, it)
+				assigned = true
+				break
+			}
+		}
+		if assigned {
+			continue
+		}
+
+		// Spill the interval ending last; either an active interval occupying
+		// a candidate register, or the current interval.
+		var spill *interval
+		for _, a := range active {
+			if !contains(candidates, a.reg) {
+				continue
+			}
+			if spill == nil || a.end > spill.end {
+				spill = a
+			}
+		}
+		if spill != nil && spill.end > it.end {
+			it.reg = spill.reg
+			used[it.reg] = true
+			spill.spilled = true
+			fr.spill(spill.v)
+			for i, a := range active {
+				if a == spill {
+					active[i] = it
+					break
+				}
+			}
+			continue
+		}
+		it.spilled = true
+		fr.spill(it.v)
+	}
+
+	// Save the callee-saved registers used by the function.
+	for _, r := range calleeSaved {
+		if used[r] {
+			fr.save(r)
+		}
+	}
+}
+
+// contains reports whether the given list of registers contains r.
+func contains(regs []preg, r preg) bool {
+	for _, x := range regs {
+		if x == r {
+			return true
+		}
+	}
+	return false
+}
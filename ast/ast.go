@@ -18,6 +18,8 @@ import (
 type File struct {
 	// Top-level declarations.
 	Decls []Decl
+	// Comments of the source file; or nil if comments were not recorded.
+	Comments []*CommentGroup
 }
 
 // A Node represents a node within the abstract syntax tree, and has one of the
@@ -37,6 +39,8 @@ type Node interface {
 // A Decl node represents a declaration, and has one of the following underlying
 // types.
 //
+//    *EnumDecl
+//    *Enumerator
 //    *FuncDecl
 //    *VarDecl
 //    *TypeDef
@@ -64,6 +68,10 @@ type Decl interface {
 	// Underlying type for type definitions.
 	//
 	//    Type
+	//
+	// Underlying type for enumerators.
+	//
+	//    Expr
 	Value() Node
 	// isDecl ensures that only declaration nodes can be assigned to the Decl
 	// interface.
@@ -72,6 +80,46 @@ type Decl interface {
 
 // Declaration nodes.
 type (
+	// An EnumDecl node represents an enumeration declaration.
+	//
+	// Examples.
+	//
+	//    enum color { RED, GREEN = 5, BLUE };
+	//    enum { N = 10 };
+	EnumDecl struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
+		// Position of `enum` keyword.
+		Enum int
+		// Enumeration tag; or nil if anonymous.
+		Tag *Ident
+		// Position of left-brace `{`.
+		Lbrace int
+		// Enumeration constants.
+		Enumerators []*Enumerator
+		// Position of right-brace `}`.
+		Rbrace int
+		// Line comment following the declaration on the same line; or nil.
+		Comment *CommentGroup
+	}
+
+	// An Enumerator node represents an enumeration constant.
+	//
+	// Examples.
+	//
+	//    RED
+	//    GREEN = 5
+	Enumerator struct {
+		// Enumeration constant name.
+		ConstName *Ident
+		// Enumeration constant value; evaluated from the value expression during
+		// semantic analysis.
+		Val int
+		// Value expression; or nil if the value is implicitly one greater than
+		// the value of the preceding enumeration constant (or 0 if first).
+		ValExpr Expr
+	}
+
 	// A FuncDecl node represents a function declaration.
 	//
 	// Examples.
@@ -79,6 +127,8 @@ type (
 	//    int puts(char s[]);
 	//    int add(int a, int b) { return a+b; }
 	FuncDecl struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Function signature.
 		FuncType *FuncType
 		// Function name.
@@ -86,6 +136,8 @@ type (
 		// Function body; or nil if function declaration (i.e. not function
 		// definition).
 		Body *BlockStmt
+		// Line comment following the declaration on the same line; or nil.
+		Comment *CommentGroup
 	}
 
 	// A VarDecl node represents a variable declaration.
@@ -95,6 +147,8 @@ type (
 	//    int x;
 	//    char buf[128];
 	VarDecl struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Variable type.
 		VarType Type
 		// Variable name.
@@ -102,6 +156,8 @@ type (
 		// Variable value expression; or nil if variable declaration (i.e. not
 		// variable definition).
 		Val Expr
+		// Line comment following the declaration on the same line; or nil.
+		Comment *CommentGroup
 	}
 
 	// A TypeDef node represents a type definition.
@@ -110,6 +166,8 @@ type (
 	//
 	//    typedef int foo;
 	TypeDef struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Position of `typedef` keyword.
 		Typedef int
 		// Underlying type of type definition.
@@ -118,6 +176,8 @@ type (
 		TypeName *Ident
 		// Underlying type of type definition.
 		Val types.Type
+		// Line comment following the type definition on the same line; or nil.
+		Comment *CommentGroup
 	}
 )
 
@@ -146,12 +206,16 @@ type (
 	//    {}
 	//    { int x; x = 42; }
 	BlockStmt struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Position of left-brace `{`.
 		Lbrace int
 		// List of block items contained within the block.
 		Items []BlockItem
 		// Position of right-brace `}`.
 		Rbrace int
+		// Line comment following the statement on the same line; or nil.
+		Comment *CommentGroup
 	}
 
 	// An EmptyStmt node represents an empty statement (i.e. ";").
@@ -160,8 +224,12 @@ type (
 	//
 	//    ;
 	EmptyStmt struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Position of semicolon `;`.
 		Semicolon int
+		// Line comment following the statement on the same line; or nil.
+		Comment *CommentGroup
 	}
 
 	// An ExprStmt node represents a stand-alone expression in a statement list.
@@ -171,8 +239,12 @@ type (
 	//    42;
 	//    f();
 	ExprStmt struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Stand-alone expression.
 		X Expr
+		// Line comment following the statement on the same line; or nil.
+		Comment *CommentGroup
 	}
 
 	// An IfStmt node represents an if statement.
@@ -182,6 +254,8 @@ type (
 	//    if (x != 0) { x++; }
 	//    if (i < max) { i; } else { max; }
 	IfStmt struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Position of `if` keyword.
 		If int
 		// Condition.
@@ -190,6 +264,8 @@ type (
 		Body Stmt
 		// False branch; or nil if 1-way conditional.
 		Else Stmt
+		// Line comment following the statement on the same line; or nil.
+		Comment *CommentGroup
 	}
 
 	// A ReturnStmt node represents a return statement.
@@ -199,10 +275,14 @@ type (
 	//    return;
 	//    return 42;
 	ReturnStmt struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Position of `return` keyword.
 		Return int
 		// Result expression; or nil if void return.
 		Result Expr
+		// Line comment following the statement on the same line; or nil.
+		Comment *CommentGroup
 	}
 
 	// A WhileStmt node represents a while statement.
@@ -211,12 +291,16 @@ type (
 	//
 	//    while (i < 10) { i++; }
 	WhileStmt struct {
+		// Associated documentation; or nil.
+		Doc *CommentGroup
 		// Position of `while` keyword.
 		While int
 		// Condition.
 		Cond Expr
 		// Loop body.
 		Body Stmt
+		// Line comment following the statement on the same line; or nil.
+		Comment *CommentGroup
 	}
 )
 
@@ -238,9 +322,12 @@ type BlockItem interface {
 //    *BasicLit
 //    *BinaryExpr
 //    *CallExpr
+//    *CastExpr
+//    *CondExpr
 //    *Ident
 //    *IndexExpr
 //    *ParenExpr
+//    *SizeofExpr
 //    *UnaryExpr
 type Expr interface {
 	Node
@@ -275,6 +362,7 @@ type (
 	//
 	//    x + y
 	//    x = 42
+	//    x = 1, y = 2
 	BinaryExpr struct {
 		// First operand.
 		X Expr
@@ -293,6 +381,7 @@ type (
 		//    token.Eq       // ==
 		//    token.Land     // &&
 		//    token.Assign   // =
+		//    token.Comma    // ,
 		Op token.Kind
 		// Second operand.
 		Y Expr
@@ -315,6 +404,41 @@ type (
 		Rparen int
 	}
 
+	// A CastExpr node represents a cast expression; (type) X.
+	//
+	// Examples.
+	//
+	//    (int)c
+	//    (char)(x + 1)
+	CastExpr struct {
+		// Position of left-parenthesis `(`.
+		Lparen int
+		// Target type of the conversion.
+		Type Type
+		// Position of right-parenthesis `)`.
+		Rparen int
+		// Operand.
+		X Expr
+	}
+
+	// A CondExpr node represents a conditional expression; Cond ? X : Y.
+	//
+	// Examples.
+	//
+	//    x < y ? x : y
+	CondExpr struct {
+		// Condition.
+		Cond Expr
+		// Position of question mark `?`.
+		Question int
+		// Operand evaluated if the condition is true.
+		X Expr
+		// Position of colon `:`.
+		Colon int
+		// Operand evaluated if the condition is false.
+		Y Expr
+	}
+
 	// An Ident node represents an identifier.
 	//
 	// Examples.
@@ -358,6 +482,20 @@ type (
 		Rparen int
 	}
 
+	// A SizeofExpr node represents a sizeof expression.
+	//
+	// Examples.
+	//
+	//    sizeof x
+	//    sizeof(int)
+	SizeofExpr struct {
+		// Position of `sizeof` keyword.
+		Sizeof int
+		// Operand; either an expression or a parenthesized type name. Type names
+		// are distinguished from expressions during semantic analysis.
+		X Expr
+	}
+
 	// An UnaryExpr node represents an unary expression; op X.
 	//
 	// Examples.
@@ -380,6 +518,7 @@ type (
 // types.
 //
 //    *ArrayType
+//    *EnumType
 //    *FuncType
 //    *Ident
 type Type interface {
@@ -401,12 +540,27 @@ type (
 		Elem Type
 		// Position of left-bracket `[`.
 		Lbracket int
-		// Array length.
+		// Array length; or 0 if unspecified. The array length is evaluated from
+		// the array length expression during semantic analysis.
 		Len int
+		// Array length expression; or nil if unspecified.
+		LenExpr Expr
 		// Position of right-bracket `]`.
 		Rbracket int
 	}
 
+	// An EnumType node represents an enumerated type.
+	//
+	// Examples.
+	//
+	//    enum color
+	EnumType struct {
+		// Position of `enum` keyword.
+		Enum int
+		// Enumeration tag.
+		Tag *Ident
+	}
+
 	// A FuncType node represents a function signature.
 	//
 	// Examples.
@@ -426,10 +580,14 @@ type (
 )
 
 func (n *ArrayType) String() string {
-	if n.Len > 0 {
+	switch {
+	case n.Len > 0:
 		return fmt.Sprintf("%v[%d]", n.Elem, n.Len)
+	case n.LenExpr != nil:
+		return fmt.Sprintf("%v[%v]", n.Elem, n.LenExpr)
+	default:
+		return fmt.Sprintf("%v[]", n.Elem)
 	}
-	return fmt.Sprintf("%v[]", n.Elem)
 }
 
 func (n *BasicLit) String() string {
@@ -437,6 +595,9 @@ func (n *BasicLit) String() string {
 }
 
 func (n *BinaryExpr) String() string {
+	if n.Op == token.Comma {
+		return fmt.Sprintf("%v, %v", n.X, n.Y)
+	}
 	return fmt.Sprintf("%v %v %v", n.X, n.Op, n.Y)
 }
 
@@ -464,10 +625,47 @@ func (n *CallExpr) String() string {
 	return buf.String()
 }
 
+func (n *CastExpr) String() string {
+	return fmt.Sprintf("(%v)%v", n.Type, n.X)
+}
+
+func (n *CondExpr) String() string {
+	return fmt.Sprintf("%v ? %v : %v", n.Cond, n.X, n.Y)
+}
+
 func (n *EmptyStmt) String() string {
 	return ";"
 }
 
+func (n *EnumDecl) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("enum ")
+	if n.Tag != nil {
+		buf.WriteString(n.Tag.String())
+		buf.WriteString(" ")
+	}
+	buf.WriteString("{")
+	for i, enumerator := range n.Enumerators {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(enumerator.String())
+	}
+	buf.WriteString("};")
+	return buf.String()
+}
+
+func (n *EnumType) String() string {
+	return fmt.Sprintf("enum %v", n.Tag)
+}
+
+func (n *Enumerator) String() string {
+	if n.ValExpr != nil {
+		return fmt.Sprintf("%v = %v", n.ConstName, n.ValExpr)
+	}
+	return n.ConstName.String()
+}
+
 func (n *ExprStmt) String() string {
 	return fmt.Sprintf("%v;", n.X)
 }
@@ -541,6 +739,10 @@ func (n *ReturnStmt) String() string {
 	return "return;"
 }
 
+func (n *SizeofExpr) String() string {
+	return fmt.Sprintf("sizeof %v", n.X)
+}
+
 func (n *TypeDef) String() string {
 	return fmt.Sprintf("typedef %v %v;", n.DeclType, n.TypeName)
 }
@@ -552,11 +754,18 @@ func (n *UnaryExpr) String() string {
 func (n *VarDecl) String() string {
 	switch typ := n.VarType.(type) {
 	case *ArrayType:
-		if typ.Len > 0 {
+		switch {
+		case typ.Len > 0:
 			return fmt.Sprintf("%v %v[%d];", typ.Elem, n.VarName, typ.Len)
+		case typ.LenExpr != nil:
+			return fmt.Sprintf("%v %v[%v];", typ.Elem, n.VarName, typ.LenExpr)
+		default:
+			return fmt.Sprintf("%v %v[];", typ.Elem, n.VarName)
 		}
-		return fmt.Sprintf("%v %v[];", typ.Elem, n.VarName)
 	default:
+		if n.Val != nil {
+			return fmt.Sprintf("%v %v = %v;", typ, n.VarName, n.Val)
+		}
 		return fmt.Sprintf("%v %v;", typ, n.VarName)
 	}
 }
@@ -590,11 +799,36 @@ func (n *CallExpr) Start() int {
 	return n.Name.Start()
 }
 
+// Start returns the start position of the node within the input stream.
+func (n *CastExpr) Start() int {
+	return n.Lparen
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *CondExpr) Start() int {
+	return n.Cond.Start()
+}
+
 // Start returns the start position of the node within the input stream.
 func (n *EmptyStmt) Start() int {
 	return n.Semicolon
 }
 
+// Start returns the start position of the node within the input stream.
+func (n *EnumDecl) Start() int {
+	return n.Enum
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *EnumType) Start() int {
+	return n.Enum
+}
+
+// Start returns the start position of the node within the input stream.
+func (n *Enumerator) Start() int {
+	return n.ConstName.Start()
+}
+
 // Start returns the start position of the node within the input stream.
 func (n *ExprStmt) Start() int {
 	return n.X.Start()
@@ -643,6 +877,11 @@ func (n *ReturnStmt) Start() int {
 	return n.Return
 }
 
+// Start returns the start position of the node within the input stream.
+func (n *SizeofExpr) Start() int {
+	return n.Sizeof
+}
+
 // Start returns the start position of the node within the input stream.
 func (n *TypeDef) Start() int {
 	return n.Typedef
@@ -670,7 +909,12 @@ var (
 	_ Node = &BinaryExpr{}
 	_ Node = &BlockStmt{}
 	_ Node = &CallExpr{}
+	_ Node = &CastExpr{}
+	_ Node = &CondExpr{}
 	_ Node = &EmptyStmt{}
+	_ Node = &EnumDecl{}
+	_ Node = &EnumType{}
+	_ Node = &Enumerator{}
 	_ Node = &ExprStmt{}
 	_ Node = &File{}
 	_ Node = &FuncDecl{}
@@ -680,12 +924,25 @@ var (
 	_ Node = &IndexExpr{}
 	_ Node = &ParenExpr{}
 	_ Node = &ReturnStmt{}
+	_ Node = &SizeofExpr{}
 	_ Node = &TypeDef{}
 	_ Node = &UnaryExpr{}
 	_ Node = &VarDecl{}
 	_ Node = &WhileStmt{}
 )
 
+// Type returns the type of the declared identifier.
+func (n *EnumDecl) Type() types.Type {
+	return newType(&EnumType{Enum: n.Enum, Tag: n.Tag})
+}
+
+// Type returns the type of the declared identifier.
+func (n *Enumerator) Type() types.Type {
+	// NOTE: "An identifier declared as an enumeration constant has type int."
+	// (see §6.4.4.3)
+	return &types.Basic{Kind: types.Int}
+}
+
 // Type returns the type of the declared identifier.
 func (n *FuncDecl) Type() types.Type {
 	// TODO: Consider caching the types.Type.
@@ -709,6 +966,16 @@ func (n *TypeDef) Type() types.Type {
 	return n.Val
 }
 
+// Name returns the name of the declared identifier.
+func (n *EnumDecl) Name() *Ident {
+	return n.Tag
+}
+
+// Name returns the name of the declared identifier.
+func (n *Enumerator) Name() *Ident {
+	return n.ConstName
+}
+
 // Name returns the name of the declared identifier.
 func (n *FuncDecl) Name() *Ident {
 	return n.FuncName
@@ -724,6 +991,28 @@ func (n *TypeDef) Name() *Ident {
 	return n.TypeName
 }
 
+// Value returns the initializing value of the defined identifier; or nil if
+// declaration or tentative definition.
+//
+// Enumeration declarations have no initializing value.
+func (n *EnumDecl) Value() Node {
+	return nil
+}
+
+// Value returns the initializing value of the defined identifier; or nil if
+// the value is implicit.
+//
+// Underlying type for enumerators.
+//
+//    Expr
+func (n *Enumerator) Value() Node {
+	// ref: https://golang.org/doc/faq#nil_error
+	if n.ValExpr != nil {
+		return n.ValExpr
+	}
+	return nil
+}
+
 // Value returns the initializing value of the defined identifier; or nil if
 // declaration or tentative definition.
 //
@@ -768,12 +1057,16 @@ func (n *TypeDef) Value() Node {
 
 // isDecl ensures that only declaration nodes can be assigned to the Decl
 // interface.
-func (n *FuncDecl) isDecl() {}
-func (n *VarDecl) isDecl()  {}
-func (n *TypeDef) isDecl()  {}
+func (n *EnumDecl) isDecl()   {}
+func (n *Enumerator) isDecl() {}
+func (n *FuncDecl) isDecl()   {}
+func (n *VarDecl) isDecl()    {}
+func (n *TypeDef) isDecl()    {}
 
 // Verify that the declaration nodes implement the Decl interface.
 var (
+	_ Decl = &EnumDecl{}
+	_ Decl = &Enumerator{}
 	_ Decl = &FuncDecl{}
 	_ Decl = &VarDecl{}
 	_ Decl = &TypeDef{}
@@ -802,6 +1095,7 @@ var (
 // BlockItem interface.
 func (n *BlockStmt) isBlockItem()  {}
 func (n *EmptyStmt) isBlockItem()  {}
+func (n *EnumDecl) isBlockItem()   {}
 func (n *ExprStmt) isBlockItem()   {}
 func (n *FuncDecl) isBlockItem()   {}
 func (n *IfStmt) isBlockItem()     {}
@@ -814,6 +1108,7 @@ func (n *WhileStmt) isBlockItem()  {}
 var (
 	_ BlockItem = &BlockStmt{}
 	_ BlockItem = &EmptyStmt{}
+	_ BlockItem = &EnumDecl{}
 	_ BlockItem = &ExprStmt{}
 	_ BlockItem = &FuncDecl{}
 	_ BlockItem = &IfStmt{}
@@ -828,9 +1123,12 @@ var (
 func (n *BasicLit) isExpr()   {}
 func (n *BinaryExpr) isExpr() {}
 func (n *CallExpr) isExpr()   {}
+func (n *CastExpr) isExpr()   {}
+func (n *CondExpr) isExpr()   {}
 func (n *Ident) isExpr()      {}
 func (n *IndexExpr) isExpr()  {}
 func (n *ParenExpr) isExpr()  {}
+func (n *SizeofExpr) isExpr() {}
 func (n *UnaryExpr) isExpr()  {}
 
 // Verify that the expression nodes implement the Expr interface.
@@ -838,20 +1136,25 @@ var (
 	_ Expr = &BasicLit{}
 	_ Expr = &BinaryExpr{}
 	_ Expr = &CallExpr{}
+	_ Expr = &CastExpr{}
+	_ Expr = &CondExpr{}
 	_ Expr = &Ident{}
 	_ Expr = &IndexExpr{}
 	_ Expr = &ParenExpr{}
+	_ Expr = &SizeofExpr{}
 	_ Expr = &UnaryExpr{}
 )
 
 // isType ensures that only type nodes can be assigned to the Type interface.
 func (n *Ident) isType()     {}
 func (n *ArrayType) isType() {}
+func (n *EnumType) isType()  {}
 func (n *FuncType) isType()  {}
 
 // Verify that the type nodes implement the Type interface.
 var (
 	_ Type = &Ident{}
 	_ Type = &ArrayType{}
+	_ Type = &EnumType{}
 	_ Type = &FuncType{}
 )
@@ -0,0 +1,506 @@
+// Package astjson implements encoding and decoding of µC abstract syntax trees
+// to and from JSON.
+//
+// Nodes are encoded as JSON objects, with the "node" member specifying the node
+// type (e.g. "VarDecl"), followed by the fields of the node in declaration
+// order. Field names are converted to lower camel case (e.g. "varName"), nil
+// fields are omitted, positions are encoded as byte offsets and token kinds as
+// their names (e.g. "Add"). The cached types of type definitions are not
+// encoded.
+//
+// Declarations are assigned unique IDs, which are used to encode the resolved
+// declarations of identifiers. Identifiers of predeclared types (e.g. "int")
+// are resolved to declarations of the universe scope, encoded as "universe".
+//
+//    {
+//       "node": "VarDecl",
+//       "id": 1,
+//       "varType": {
+//          "node": "Ident",
+//          "namePos": 0,
+//          "name": "int",
+//          "universe": true
+//       },
+//       "varName": {
+//          "node": "Ident",
+//          "namePos": 4,
+//          "name": "x",
+//          "decl": 1
+//       }
+//    }
+package astjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+// nodeTypes maps from node type names to node types.
+var nodeTypes = map[string]reflect.Type{}
+
+// kinds maps from token kind names to token kinds.
+var kinds = map[string]token.Kind{}
+
+func init() {
+	nodes := []ast.Node{
+		// Source file.
+		&ast.File{},
+		// Declarations.
+		&ast.EnumDecl{},
+		&ast.Enumerator{},
+		&ast.FuncDecl{},
+		&ast.VarDecl{},
+		&ast.TypeDef{},
+		// Statements.
+		&ast.BlockStmt{},
+		&ast.EmptyStmt{},
+		&ast.ExprStmt{},
+		&ast.IfStmt{},
+		&ast.ReturnStmt{},
+		&ast.WhileStmt{},
+		// Expressions.
+		&ast.BasicLit{},
+		&ast.BinaryExpr{},
+		&ast.CallExpr{},
+		&ast.CastExpr{},
+		&ast.CondExpr{},
+		&ast.Ident{},
+		&ast.IndexExpr{},
+		&ast.ParenExpr{},
+		&ast.SizeofExpr{},
+		&ast.UnaryExpr{},
+		// Types.
+		&ast.ArrayType{},
+		&ast.EnumType{},
+		&ast.FuncType{},
+	}
+	for _, n := range nodes {
+		typ := reflect.TypeOf(n)
+		nodeTypes[typ.Elem().Name()] = typ
+	}
+	for kind := token.EOF; !strings.HasPrefix(kind.GoString(), "Kind("); kind++ {
+		kinds[kind.GoString()] = kind
+	}
+}
+
+// Reflection types used for encoding and decoding.
+var (
+	nodeType  = reflect.TypeOf((*ast.Node)(nil)).Elem()
+	kindType  = reflect.TypeOf(token.Kind(0))
+	groupType = reflect.TypeOf(&ast.CommentGroup{})
+	typeType  = reflect.TypeOf((*types.Type)(nil)).Elem()
+)
+
+// predeclared maps from the names of predeclared types to their basic kinds.
+var predeclared = map[string]types.BasicKind{
+	"char": types.Char,
+	"int":  types.Int,
+	"void": types.Void,
+}
+
+// Marshal returns the JSON encoding of the given file, indented by tabs.
+func Marshal(file *ast.File) ([]byte, error) {
+	e := &encoder{ids: make(map[ast.Decl]int)}
+	// Assign IDs to declarations, in depth first order.
+	assign := func(n ast.Node) error {
+		if decl, ok := n.(ast.Decl); ok {
+			if _, ok := e.ids[decl]; !ok {
+				e.ids[decl] = len(e.ids) + 1
+			}
+		}
+		return nil
+	}
+	if err := astutil.WalkBeforeAfter(file, assign, func(ast.Node) error { return nil }); err != nil {
+		return nil, errutil.Err(err)
+	}
+	v, err := e.node(reflect.ValueOf(file))
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	buf, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return append(buf, '\n'), nil
+}
+
+// An encoder keeps track of the information required to encode syntax trees.
+type encoder struct {
+	// IDs of declarations.
+	ids map[ast.Decl]int
+}
+
+// An object is a JSON object which preserves the order of its members.
+type object []member
+
+// A member is a member of a JSON object.
+type member struct {
+	// Member name.
+	name string
+	// Member value.
+	val interface{}
+}
+
+// MarshalJSON returns the JSON encoding of the object.
+func (o object) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString("{")
+	for i, m := range o {
+		if i != 0 {
+			buf.WriteString(",")
+		}
+		name, err := json.Marshal(m.name)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		val, err := json.Marshal(m.val)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		buf.Write(name)
+		buf.WriteString(":")
+		buf.Write(val)
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// node returns the JSON object of the given node.
+func (e *encoder) node(v reflect.Value) (object, error) {
+	n := v.Interface().(ast.Node)
+	obj := object{{name: "node", val: v.Type().Elem().Name()}}
+	if decl, ok := n.(ast.Decl); ok {
+		obj = append(obj, member{name: "id", val: e.ids[decl]})
+	}
+	ident, isIdent := n.(*ast.Ident)
+	s := v.Elem()
+	for i := 0; i < s.NumField(); i++ {
+		field := s.Type().Field(i)
+		name := fieldName(field.Name)
+		f := s.Field(i)
+		switch {
+		case field.Type == typeType:
+			// Skip cached types of type definitions.
+		case isIdent && field.Name == "Decl":
+			if ident.Decl == nil {
+				continue
+			}
+			if id, ok := e.ids[ident.Decl]; ok {
+				obj = append(obj, member{name: name, val: id})
+				continue
+			}
+			if isUniverse(ident.Decl) {
+				obj = append(obj, member{name: "universe", val: true})
+				continue
+			}
+			return nil, errutil.Newf("declaration of identifier %q (at offset %d) not present in file", ident.Name, ident.NamePos)
+		default:
+			val, ok, err := e.value(f)
+			if err != nil {
+				return nil, errutil.Err(err)
+			}
+			if ok {
+				obj = append(obj, member{name: name, val: val})
+			}
+		}
+	}
+	return obj, nil
+}
+
+// value returns the JSON value of the given field value. The boolean return
+// value indicates whether the field is present; nil fields are omitted.
+func (e *encoder) value(f reflect.Value) (interface{}, bool, error) {
+	switch {
+	case f.Type() == kindType:
+		return token.Kind(f.Uint()).GoString(), true, nil
+	case f.Type() == groupType:
+		if f.IsNil() {
+			return nil, false, nil
+		}
+		return commentGroup(f.Interface().(*ast.CommentGroup)), true, nil
+	case f.Kind() == reflect.Int, f.Kind() == reflect.String:
+		return f.Interface(), true, nil
+	case f.Kind() == reflect.Interface:
+		if f.IsNil() {
+			return nil, false, nil
+		}
+		return e.value(f.Elem())
+	case f.Kind() == reflect.Ptr:
+		if f.IsNil() {
+			return nil, false, nil
+		}
+		if !f.Type().Implements(nodeType) {
+			return nil, false, errutil.Newf("support for field type %v not yet implemented", f.Type())
+		}
+		obj, err := e.node(f)
+		if err != nil {
+			return nil, false, errutil.Err(err)
+		}
+		return obj, true, nil
+	case f.Kind() == reflect.Slice:
+		if f.IsNil() {
+			return nil, false, nil
+		}
+		vals := make([]interface{}, 0, f.Len())
+		for i := 0; i < f.Len(); i++ {
+			val, ok, err := e.value(f.Index(i))
+			if err != nil {
+				return nil, false, errutil.Err(err)
+			}
+			if !ok {
+				return nil, false, errutil.Newf("invalid nil element of %v", f.Type())
+			}
+			vals = append(vals, val)
+		}
+		return vals, true, nil
+	default:
+		return nil, false, errutil.Newf("support for field type %v not yet implemented", f.Type())
+	}
+}
+
+// commentGroup returns the JSON object of the given comment group.
+func commentGroup(g *ast.CommentGroup) object {
+	var list []object
+	for _, c := range g.List {
+		list = append(list, object{{name: "slash", val: c.Slash}, {name: "text", val: c.Text}})
+	}
+	return object{{name: "list", val: list}}
+}
+
+// Unmarshal decodes the JSON encoding of a file, as produced by Marshal, and
+// returns the file. The resolved declarations of identifiers are restored.
+func Unmarshal(data []byte) (*ast.File, error) {
+	d := &decoder{
+		decls:    make(map[int]ast.Decl),
+		groups:   make(map[int]*ast.CommentGroup),
+		universe: make(map[string]*ast.TypeDef),
+	}
+	// Decode comments of the file first, so that the documentation and line
+	// comments of nodes refer to the comment groups of the file.
+	var obj struct {
+		Comments []json.RawMessage `json:"comments"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, errutil.Err(err)
+	}
+	for _, raw := range obj.Comments {
+		if _, err := d.commentGroup(raw); err != nil {
+			return nil, errutil.Err(err)
+		}
+	}
+	n, err := d.node(data)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	file, ok := n.(*ast.File)
+	if !ok {
+		return nil, errutil.Newf("invalid root node type; expected *ast.File, got %T", n)
+	}
+	// Resolve declarations of identifiers, which may refer to subsequent
+	// declarations.
+	for _, ref := range d.refs {
+		decl, ok := d.decls[ref.id]
+		if !ok {
+			return nil, errutil.Newf("invalid declaration ID %d of identifier %q", ref.id, ref.ident.Name)
+		}
+		ref.ident.Decl = decl
+	}
+	return file, nil
+}
+
+// A decoder keeps track of the information required to decode syntax trees.
+type decoder struct {
+	// Declarations, indexed by ID.
+	decls map[int]ast.Decl
+	// Comment groups, indexed by start position.
+	groups map[int]*ast.CommentGroup
+	// Predeclared type definitions of the universe scope, indexed by name.
+	universe map[string]*ast.TypeDef
+	// Identifiers referring to declarations.
+	refs []ref
+}
+
+// A ref represents an identifier referring to the declaration of a given ID.
+type ref struct {
+	// Identifier.
+	ident *ast.Ident
+	// Declaration ID.
+	id int
+}
+
+// node decodes the given JSON object into a node.
+func (d *decoder) node(data []byte) (ast.Node, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, errutil.Err(err)
+	}
+	var kind string
+	if err := json.Unmarshal(obj["node"], &kind); err != nil {
+		return nil, errutil.Newf("invalid node type; %v", err)
+	}
+	typ, ok := nodeTypes[kind]
+	if !ok {
+		return nil, errutil.Newf("invalid node type %q", kind)
+	}
+	v := reflect.New(typ.Elem())
+	n := v.Interface().(ast.Node)
+	s := v.Elem()
+	for i := 0; i < s.NumField(); i++ {
+		field := s.Type().Field(i)
+		raw, ok := obj[fieldName(field.Name)]
+		if !ok || field.Type == typeType {
+			continue
+		}
+		if ident, ok := n.(*ast.Ident); ok && field.Name == "Decl" {
+			var id int
+			if err := json.Unmarshal(raw, &id); err != nil {
+				return nil, errutil.Newf("invalid declaration ID of identifier; %v", err)
+			}
+			d.refs = append(d.refs, ref{ident: ident, id: id})
+			continue
+		}
+		if err := d.value(s.Field(i), raw); err != nil {
+			return nil, errutil.Newf("invalid field %s of %s; %v", field.Name, kind, err)
+		}
+	}
+	if ident, ok := n.(*ast.Ident); ok {
+		if raw, ok := obj["universe"]; ok && string(raw) == "true" {
+			decl, err := d.universeDecl(ident.Name)
+			if err != nil {
+				return nil, errutil.Err(err)
+			}
+			ident.Decl = decl
+		}
+	}
+	if decl, ok := n.(ast.Decl); ok {
+		if raw, ok := obj["id"]; ok {
+			var id int
+			if err := json.Unmarshal(raw, &id); err != nil {
+				return nil, errutil.Newf("invalid declaration ID; %v", err)
+			}
+			d.decls[id] = decl
+		}
+	}
+	return n, nil
+}
+
+// value decodes the given JSON value into the field value f.
+func (d *decoder) value(f reflect.Value, raw json.RawMessage) error {
+	switch {
+	case f.Type() == kindType:
+		var name string
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return errutil.Err(err)
+		}
+		kind, ok := kinds[name]
+		if !ok {
+			return errutil.Newf("invalid token kind %q", name)
+		}
+		f.SetUint(uint64(kind))
+	case f.Type() == groupType:
+		g, err := d.commentGroup(raw)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		f.Set(reflect.ValueOf(g))
+	case f.Kind() == reflect.Int, f.Kind() == reflect.String:
+		if err := json.Unmarshal(raw, f.Addr().Interface()); err != nil {
+			return errutil.Err(err)
+		}
+	case f.Kind() == reflect.Interface, f.Kind() == reflect.Ptr:
+		n, err := d.node(raw)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		v := reflect.ValueOf(n)
+		if !v.Type().AssignableTo(f.Type()) {
+			return errutil.Newf("invalid node type; expected %v, got %T", f.Type(), n)
+		}
+		f.Set(v)
+	case f.Kind() == reflect.Slice:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return errutil.Err(err)
+		}
+		s := reflect.MakeSlice(f.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			if err := d.value(s.Index(i), elem); err != nil {
+				return errutil.Err(err)
+			}
+		}
+		f.Set(s)
+	default:
+		return errutil.Newf("support for field type %v not yet implemented", f.Type())
+	}
+	return nil
+}
+
+// commentGroup decodes the given JSON object into a comment group. Comment
+// groups are identified by their start position.
+func (d *decoder) commentGroup(raw json.RawMessage) (*ast.CommentGroup, error) {
+	var obj struct {
+		List []struct {
+			Slash int    `json:"slash"`
+			Text  string `json:"text"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, errutil.Err(err)
+	}
+	if len(obj.List) == 0 {
+		return nil, errutil.Newf("invalid empty comment group")
+	}
+	if g, ok := d.groups[obj.List[0].Slash]; ok {
+		return g, nil
+	}
+	g := &ast.CommentGroup{}
+	for _, c := range obj.List {
+		g.List = append(g.List, &ast.Comment{Slash: c.Slash, Text: c.Text})
+	}
+	d.groups[g.Start()] = g
+	return g, nil
+}
+
+// universeDecl returns the predeclared type definition of the given name.
+func (d *decoder) universeDecl(name string) (*ast.TypeDef, error) {
+	if decl, ok := d.universe[name]; ok {
+		return decl, nil
+	}
+	kind, ok := predeclared[name]
+	if !ok {
+		return nil, errutil.Newf("invalid predeclared type %q", name)
+	}
+	// Mirror the universe scope of the semantic analysis.
+	ident := &ast.Ident{NamePos: -1, Name: name}
+	decl := &ast.TypeDef{DeclType: ident, TypeName: ident, Val: &types.Basic{Kind: kind}}
+	ident.Decl = decl
+	d.universe[name] = decl
+	return decl, nil
+}
+
+// isUniverse reports whether the given declaration is a predeclared type
+// definition of the universe scope.
+func isUniverse(decl ast.Decl) bool {
+	def, ok := decl.(*ast.TypeDef)
+	if !ok || def.TypeName == nil || def.TypeName.NamePos >= 0 {
+		return false
+	}
+	_, ok = predeclared[def.TypeName.Name]
+	return ok
+}
+
+// fieldName returns the JSON member name of the given field name.
+func fieldName(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
+}
@@ -0,0 +1,178 @@
+package astjson_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astjson"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+)
+
+// TestRoundTrip verifies that decoding the JSON encoding of syntax trees
+// restores the syntax trees, including the resolved declarations of
+// identifiers and the comments.
+func TestRoundTrip(t *testing.T) {
+	var paths []string
+	for _, pattern := range []string{"../../testdata/quiet/*/*.c", "../../testdata/extra/*/*.c"} {
+		ps, err := filepath.Glob(pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, ps...)
+	}
+	for _, path := range paths {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		input := string(buf)
+		s := scanner.NewFromString(input)
+		p := parser.NewParser()
+		file, err := p.Parse(s)
+		if err != nil {
+			// Skip input which fails syntactic analysis; e.g. the preprocessor
+			// directives of quiet/rtl/r06.c.
+			continue
+		}
+		want := file.(*ast.File)
+		if cs, ok := s.(astutil.CommentScanner); ok {
+			astutil.AddComments(want, input, cs.Comments(), cs.Semicolons())
+		}
+		if _, err := sem.Check(want); err != nil {
+			// Skip input which fails semantic analysis.
+			continue
+		}
+		buf, err = astjson.Marshal(want)
+		if err != nil {
+			t.Errorf("%q: unable to encode syntax tree; %v", path, err)
+			continue
+		}
+		got, err := astjson.Unmarshal(buf)
+		if err != nil {
+			t.Errorf("%q: unable to decode syntax tree; %v", path, err)
+			continue
+		}
+		if got.String() != want.String() {
+			t.Errorf("%q: syntax tree mismatch; expected `%v`, got `%v`", path, want, got)
+			continue
+		}
+		again, err := astjson.Marshal(got)
+		if err != nil {
+			t.Errorf("%q: unable to encode decoded syntax tree; %v", path, err)
+			continue
+		}
+		if !bytes.Equal(again, buf) {
+			t.Errorf("%q: JSON encoding mismatch; expected `%s`, got `%s`", path, buf, again)
+			continue
+		}
+		checkDecls(t, path, want, got)
+		checkComments(t, path, got)
+	}
+}
+
+// checkDecls verifies that the identifiers of the decoded file resolve to the
+// corresponding declarations of the decoded file.
+func checkDecls(t *testing.T, path string, want, got *ast.File) {
+	wantIdents, wantDecls := collect(want)
+	gotIdents, gotDecls := collect(got)
+	if len(wantIdents) != len(gotIdents) {
+		t.Errorf("%q: number of identifiers mismatch; expected %d, got %d", path, len(wantIdents), len(gotIdents))
+		return
+	}
+	for i, w := range wantIdents {
+		g := gotIdents[i]
+		if w.Decl == nil {
+			if g.Decl != nil {
+				t.Errorf("%q: declaration of identifier %q mismatch; expected nil, got %v", path, w.Name, g.Decl)
+			}
+			continue
+		}
+		if g.Decl == nil {
+			t.Errorf("%q: declaration of identifier %q mismatch; expected %v, got nil", path, w.Name, w.Decl)
+			continue
+		}
+		j, ok := wantDecls[w.Decl]
+		if !ok {
+			// Predeclared type of the universe scope.
+			if !reflect.DeepEqual(g.Decl, w.Decl) {
+				t.Errorf("%q: predeclared type of identifier %q mismatch; expected %#v, got %#v", path, w.Name, w.Decl, g.Decl)
+			}
+			continue
+		}
+		if k, ok := gotDecls[g.Decl]; !ok || k != j {
+			t.Errorf("%q: declaration of identifier %q (at offset %d) mismatch; expected declaration %d, got %d", path, w.Name, w.NamePos, j, k)
+		}
+	}
+}
+
+// collect returns the identifiers of the given file in depth first order, and
+// the index of each declaration in depth first order.
+func collect(file *ast.File) ([]*ast.Ident, map[ast.Decl]int) {
+	var idents []*ast.Ident
+	decls := make(map[ast.Decl]int)
+	f := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.Ident:
+			idents = append(idents, n)
+		case ast.Decl:
+			decls[n] = len(decls)
+		}
+		return nil
+	}
+	if err := astutil.Walk(file, f); err != nil {
+		panic(err)
+	}
+	return idents, decls
+}
+
+// checkComments verifies that the documentation and line comments of the
+// top-level declarations of the decoded file refer to its comment groups.
+func checkComments(t *testing.T, path string, file *ast.File) {
+	groups := make(map[*ast.CommentGroup]bool)
+	for _, g := range file.Comments {
+		groups[g] = true
+	}
+	for _, decl := range file.Decls {
+		var doc, comment *ast.CommentGroup
+		switch decl := decl.(type) {
+		case *ast.EnumDecl:
+			doc, comment = decl.Doc, decl.Comment
+		case *ast.FuncDecl:
+			doc, comment = decl.Doc, decl.Comment
+		case *ast.TypeDef:
+			doc, comment = decl.Doc, decl.Comment
+		case *ast.VarDecl:
+			doc, comment = decl.Doc, decl.Comment
+		}
+		for _, g := range []*ast.CommentGroup{doc, comment} {
+			if g != nil && !groups[g] {
+				t.Errorf("%q: comment group %q of %v not present in file", path, g.Text(), decl.Name())
+			}
+		}
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	golden := []struct {
+		in string
+	}{
+		{in: `{"node": "Foo"}`},
+		{in: `{"node": "VarDecl"}`},
+		{in: `{"node": "File", "decls": [{"node": "BasicLit"}]}`},
+		{in: `{"node": "File", "decls": [{"node": "VarDecl", "id": 1, "varName": {"node": "Ident", "name": "x", "decl": 2}}]}`},
+		{in: `{"node": "File", "decls": [{"node": "VarDecl", "id": 1, "varType": {"node": "Ident", "name": "float", "universe": true}}]}`},
+	}
+	for _, g := range golden {
+		if _, err := astjson.Unmarshal([]byte(g.in)); err == nil {
+			t.Errorf("%s: expected error, got nil", g.in)
+		}
+	}
+}
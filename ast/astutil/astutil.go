@@ -5,8 +5,20 @@ import "github.com/mewmew/uc/ast"
 
 // IsDef reports whether the given declaration is a definition.
 func IsDef(decl ast.Decl) bool {
-	if _, ok := decl.(*ast.VarDecl); ok {
+	switch decl.(type) {
+	case *ast.EnumDecl, *ast.Enumerator, *ast.VarDecl:
 		return true
 	}
 	return decl.Value() != nil
 }
+
+// Unparen returns the given expression with any enclosing parentheses removed.
+func Unparen(x ast.Expr) ast.Expr {
+	for {
+		paren, ok := x.(*ast.ParenExpr)
+		if !ok {
+			return x
+		}
+		x = paren.X
+	}
+}
@@ -0,0 +1,312 @@
+package astutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mewmew/uc/ast"
+)
+
+// AddComments records the given comment groups of the source file, and
+// associates them with the declarations and statements of the file. The end of
+// declarations and statements terminated by a semicolon is located using the
+// given positions of the semicolon tokens of the source file.
+//
+// A comment group following a declaration or statement on the same line is
+// recorded as its line comment; if several declarations and statements end at
+// the same position (e.g. a while statement and its body), the outermost one is
+// used. Otherwise, a comment group starting a line, and separated by at most one
+// newline from the subsequent declaration or statement, is recorded as its
+// documentation.
+func AddComments(file *ast.File, src string, comments []*ast.CommentGroup, semicolons []int) {
+	file.Comments = comments
+	if len(comments) == 0 {
+		return
+	}
+	c := &commenter{src: src, comments: comments, semicolons: semicolons}
+	for _, decl := range file.Decls {
+		c.item(decl, 0)
+	}
+	for _, g := range comments {
+		c.associate(g)
+	}
+}
+
+// A commenter keeps track of the information required to associate comment
+// groups with declarations and statements.
+type commenter struct {
+	// Source input.
+	src string
+	// Comment groups of the source input.
+	comments []*ast.CommentGroup
+	// Positions of the semicolon tokens of the source input, in source order.
+	semicolons []int
+	// Declarations and statements of the source input, in source order.
+	items []*commentItem
+}
+
+// A commentItem represents a declaration or statement which may be associated
+// with comment groups.
+type commentItem struct {
+	// Declaration or statement.
+	node ast.Node
+	// End position of the declaration or statement.
+	end int
+	// Nesting depth of the declaration or statement.
+	depth int
+}
+
+// item records the given declaration or statement, at the specified nesting
+// depth, and its nested declarations and statements.
+func (c *commenter) item(node ast.Node, depth int) {
+	c.items = append(c.items, &commentItem{node: node, end: c.end(node), depth: depth})
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		if n.Body != nil {
+			c.block(n.Body, depth+1)
+		}
+	case *ast.BlockStmt:
+		c.block(n, depth+1)
+	case *ast.IfStmt:
+		c.item(n.Body, depth+1)
+		if n.Else != nil {
+			c.item(n.Else, depth+1)
+		}
+	case *ast.WhileStmt:
+		c.item(n.Body, depth+1)
+	}
+}
+
+// block records the items of the given block, at the specified nesting depth.
+func (c *commenter) block(block *ast.BlockStmt, depth int) {
+	for _, item := range block.Items {
+		c.item(item, depth)
+	}
+}
+
+// end returns the end position of the given declaration or statement.
+func (c *commenter) end(node ast.Node) int {
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		if n.Body != nil {
+			return n.Body.Rbrace + 1
+		}
+		return c.semicolonEnd(n.FuncType.Rparen)
+	case *ast.BlockStmt:
+		return n.Rbrace + 1
+	case *ast.EmptyStmt:
+		return n.Semicolon + 1
+	case *ast.EnumDecl:
+		return c.semicolonEnd(n.Rbrace)
+	case *ast.IfStmt:
+		if n.Else != nil {
+			return c.end(n.Else)
+		}
+		return c.end(n.Body)
+	case *ast.WhileStmt:
+		return c.end(n.Body)
+	case *ast.ExprStmt, *ast.ReturnStmt, *ast.TypeDef, *ast.VarDecl:
+		return c.semicolonEnd(n.Start())
+	default:
+		panic(fmt.Sprintf("support for node %T not yet implemented", n))
+	}
+}
+
+// semicolonEnd returns the end position of the first semicolon token at or
+// after pos in the source input.
+func (c *commenter) semicolonEnd(pos int) int {
+	i := sort.SearchInts(c.semicolons, pos)
+	if i == len(c.semicolons) {
+		return len(c.src)
+	}
+	return c.semicolons[i] + 1
+}
+
+// associate associates the given comment group with a declaration or
+// statement; either as its line comment or as its documentation.
+func (c *commenter) associate(g *ast.CommentGroup) {
+	start := g.Start()
+	lineStart := strings.LastIndex(c.src[:start], "\n") + 1
+	if strings.TrimSpace(c.src[lineStart:start]) != "" {
+		// Line comment.
+		var outer *commentItem
+		for _, item := range c.items {
+			if item.end > start || !isSpace(c.src[item.end:start]) {
+				continue
+			}
+			if outer == nil || item.depth < outer.depth {
+				outer = item
+			}
+		}
+		if outer != nil {
+			setComment(outer.node, g)
+		}
+		return
+	}
+	// Documentation.
+	last := g.List[len(g.List)-1]
+	end := commentEnd(c.src, last)
+	i := sort.Search(len(c.items), func(i int) bool {
+		return c.items[i].node.Start() >= end
+	})
+	if i == len(c.items) {
+		return
+	}
+	between := c.src[end:c.items[i].node.Start()]
+	if isSpace(between) && strings.Count(between, "\n") <= 1 {
+		setDoc(c.items[i].node, g)
+	}
+}
+
+// setComment sets the line comment of the given declaration or statement.
+func setComment(node ast.Node, g *ast.CommentGroup) {
+	switch n := node.(type) {
+	case *ast.EnumDecl:
+		n.Comment = g
+	case *ast.FuncDecl:
+		n.Comment = g
+	case *ast.VarDecl:
+		n.Comment = g
+	case *ast.TypeDef:
+		n.Comment = g
+	case *ast.BlockStmt:
+		n.Comment = g
+	case *ast.EmptyStmt:
+		n.Comment = g
+	case *ast.ExprStmt:
+		n.Comment = g
+	case *ast.IfStmt:
+		n.Comment = g
+	case *ast.ReturnStmt:
+		n.Comment = g
+	case *ast.WhileStmt:
+		n.Comment = g
+	default:
+		panic(fmt.Sprintf("support for node %T not yet implemented", n))
+	}
+}
+
+// setDoc sets the documentation of the given declaration or statement.
+func setDoc(node ast.Node, g *ast.CommentGroup) {
+	switch n := node.(type) {
+	case *ast.EnumDecl:
+		n.Doc = g
+	case *ast.FuncDecl:
+		n.Doc = g
+	case *ast.VarDecl:
+		n.Doc = g
+	case *ast.TypeDef:
+		n.Doc = g
+	case *ast.BlockStmt:
+		n.Doc = g
+	case *ast.EmptyStmt:
+		n.Doc = g
+	case *ast.ExprStmt:
+		n.Doc = g
+	case *ast.IfStmt:
+		n.Doc = g
+	case *ast.ReturnStmt:
+		n.Doc = g
+	case *ast.WhileStmt:
+		n.Doc = g
+	default:
+		panic(fmt.Sprintf("support for node %T not yet implemented", n))
+	}
+}
+
+// commentEnd returns the end position of the given comment in the source
+// input; the text of comments excludes carriage returns, and the terminating
+// newline of line comments.
+func commentEnd(src string, comment *ast.Comment) int {
+	s := src[comment.Slash:]
+	if strings.HasPrefix(comment.Text, "//") {
+		if end := strings.IndexByte(s, '\n'); end != -1 {
+			return comment.Slash + end
+		}
+		return len(src)
+	}
+	if end := strings.Index(s[len("/*"):], "*/"); end != -1 {
+		return comment.Slash + len("/*") + end + len("*/")
+	}
+	return len(src)
+}
+
+// isSpace reports whether s consists of white-space characters only.
+func isSpace(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+// A CommentScanner is a scanner which records the comments and semicolon
+// tokens of the source input, as required by AddComments.
+type CommentScanner interface {
+	// Comments returns the comment groups of the source input skipped so far.
+	Comments() []*ast.CommentGroup
+	// Semicolons returns the positions of the semicolon tokens of the source
+	// input scanned so far.
+	Semicolons() []int
+}
+
+// A CommentRecorder records the comment groups and semicolon tokens of a source
+// input, as reported by a scanner in source order. It implements the
+// CommentScanner interface.
+type CommentRecorder struct {
+	// Source input.
+	src string
+	// Comment groups recorded so far.
+	comments []*ast.CommentGroup
+	// Positions of semicolon tokens recorded so far.
+	semicolons []int
+	// Previous token, if a comment; or nil otherwise.
+	prev *ast.Comment
+	// End position of the previous non-comment token; or -1 if none.
+	end int
+	// Specifies whether the current comment group follows a non-comment token
+	// on the same line.
+	trailing bool
+}
+
+// NewCommentRecorder returns a new comment recorder of the given source input.
+func NewCommentRecorder(src string) *CommentRecorder {
+	return &CommentRecorder{src: src, end: -1}
+}
+
+// Comment records the given comment, located at the specified position; either
+// as part of the current comment group, or as a new comment group.
+func (r *CommentRecorder) Comment(pos int, text string) {
+	c := &ast.Comment{Slash: pos, Text: text}
+	if r.prev != nil {
+		// Comments separated by at most one newline belong to the same group,
+		// except for trailing comment groups which end at the end of the line.
+		// Newlines of the previous comment are excluded from the count.
+		n := strings.Count(r.src[r.prev.Slash:c.Slash], "\n") - strings.Count(r.prev.Text, "\n")
+		if n == 0 || (n == 1 && !r.trailing) {
+			g := r.comments[len(r.comments)-1]
+			g.List = append(g.List, c)
+			r.prev = c
+			return
+		}
+	}
+	r.comments = append(r.comments, &ast.CommentGroup{List: []*ast.Comment{c}})
+	r.prev = c
+	r.trailing = r.end != -1 && !strings.Contains(r.src[r.end:c.Slash], "\n")
+}
+
+// Token records the given non-comment token, located at the specified position.
+func (r *CommentRecorder) Token(pos int, lit string) {
+	if lit == ";" {
+		r.semicolons = append(r.semicolons, pos)
+	}
+	r.prev = nil
+	r.end = pos + len(lit)
+}
+
+// Comments returns the comment groups recorded so far.
+func (r *CommentRecorder) Comments() []*ast.CommentGroup {
+	return r.comments
+}
+
+// Semicolons returns the positions of the semicolon tokens recorded so far.
+func (r *CommentRecorder) Semicolons() []int {
+	return r.semicolons
+}
@@ -0,0 +1,84 @@
+package astutil_test
+
+import (
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/gocc/parser"
+	goccscanner "github.com/mewmew/uc/gocc/scanner"
+	handscanner "github.com/mewmew/uc/hand/scanner"
+)
+
+func TestAddComments(t *testing.T) {
+	const src = `// Package comment.
+
+// Number of elements.
+int n; // line comment of n
+
+/* Sum returns the sum of the
+ * first n elements of a. */
+int sum(int a[]) {
+	int i;
+	int s;
+	i = 0; /* first */ // index
+	s = 0;
+
+	// Iterate over elements.
+	while (i < n) s = s + a[i];
+	return s;
+}
+
+void set(void) {
+	char c;
+	c = ';'; // trailing
+}
+`
+	scanners := []struct {
+		name string
+		new  func(input string) parser.Scanner
+	}{
+		{name: "hand", new: func(input string) parser.Scanner { return handscanner.NewFromString(input) }},
+		{name: "gocc", new: func(input string) parser.Scanner { return goccscanner.NewFromString(input) }},
+	}
+	for _, scanner := range scanners {
+		s := scanner.new(src)
+		p := parser.NewParser()
+		f, err := p.Parse(s)
+		if err != nil {
+			t.Fatalf("%s: %v", scanner.name, err)
+		}
+		file := f.(*ast.File)
+		cs, ok := s.(astutil.CommentScanner)
+		if !ok {
+			t.Fatalf("%s: scanner does not record comments", scanner.name)
+		}
+		astutil.AddComments(file, src, cs.Comments(), cs.Semicolons())
+		if got, want := len(file.Comments), 7; got != want {
+			t.Fatalf("%s: number of comment groups mismatch; expected %d, got %d", scanner.name, want, got)
+		}
+		n := file.Decls[0].(*ast.VarDecl)
+		sum := file.Decls[1].(*ast.FuncDecl)
+		set := file.Decls[2].(*ast.FuncDecl)
+		golden := []struct {
+			name string
+			got  *ast.CommentGroup
+			want string
+		}{
+			{name: "package", got: file.Comments[0], want: "Package comment.\n"},
+			{name: "n doc", got: n.Doc, want: "Number of elements.\n"},
+			{name: "n comment", got: n.Comment, want: "line comment of n\n"},
+			{name: "sum doc", got: sum.Doc, want: " Sum returns the sum of the\n * first n elements of a.\n"},
+			{name: "sum comment", got: sum.Comment, want: ""},
+			{name: "i doc", got: sum.Body.Items[0].(*ast.VarDecl).Doc, want: ""},
+			{name: "i = 0 comment", got: sum.Body.Items[2].(*ast.ExprStmt).Comment, want: " first\nindex\n"},
+			{name: "while doc", got: sum.Body.Items[4].(*ast.WhileStmt).Doc, want: "Iterate over elements.\n"},
+			{name: "c = ';' comment", got: set.Body.Items[1].(*ast.ExprStmt).Comment, want: "trailing\n"},
+		}
+		for _, g := range golden {
+			if got := g.got.Text(); got != g.want {
+				t.Errorf("%s: %s: comment text mismatch; expected %q, got %q", scanner.name, g.name, g.want, got)
+			}
+		}
+	}
+}
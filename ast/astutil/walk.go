@@ -27,6 +27,14 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		}
 
 	// Declarations.
+	case *ast.EnumDecl:
+		if n != nil {
+			return walkEnumDecl(n, before, after)
+		}
+	case *ast.Enumerator:
+		if n != nil {
+			return walkEnumerator(n, before, after)
+		}
 	case *ast.FuncDecl:
 		if n != nil {
 			return walkFuncDecl(n, before, after)
@@ -79,6 +87,14 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkCallExpr(n, before, after)
 		}
+	case *ast.CastExpr:
+		if n != nil {
+			return walkCastExpr(n, before, after)
+		}
+	case *ast.CondExpr:
+		if n != nil {
+			return walkCondExpr(n, before, after)
+		}
 	case *ast.Ident:
 		if n != nil {
 			return walkIdent(n, before, after)
@@ -91,6 +107,10 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkParenExpr(n, before, after)
 		}
+	case *ast.SizeofExpr:
+		if n != nil {
+			return walkSizeofExpr(n, before, after)
+		}
 	case *ast.UnaryExpr:
 		if n != nil {
 			return walkUnaryExpr(n, before, after)
@@ -101,6 +121,10 @@ func WalkBeforeAfter(node ast.Node, before, after func(ast.Node) error) error {
 		if n != nil {
 			return walkArrayType(n, before, after)
 		}
+	case *ast.EnumType:
+		if n != nil {
+			return walkEnumType(n, before, after)
+		}
 	case *ast.FuncType:
 		if n != nil {
 			return walkFuncType(n, before, after)
@@ -136,6 +160,42 @@ func walkFile(file *ast.File, before, after func(ast.Node) error) error {
 
 // === [ Top-level declarations ] ===
 
+// walkEnumDecl walks the parse tree of the given enumeration declaration in
+// depth first order. The enumeration tag is not walked, as tags reside in a
+// separate name space from ordinary identifiers.
+func walkEnumDecl(decl *ast.EnumDecl, before, after func(ast.Node) error) error {
+	if err := before(decl); err != nil {
+		return errutil.Err(err)
+	}
+	for _, enumerator := range decl.Enumerators {
+		if err := WalkBeforeAfter(enumerator, before, after); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	if err := after(decl); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkEnumerator walks the parse tree of the given enumerator in depth first
+// order.
+func walkEnumerator(decl *ast.Enumerator, before, after func(ast.Node) error) error {
+	if err := before(decl); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(decl.ConstName, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(decl.ValExpr, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(decl); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkFuncDecl walks the parse tree of the given function declaration in depth
 // first order.
 func walkFuncDecl(decl *ast.FuncDecl, before, after func(ast.Node) error) error {
@@ -348,6 +408,45 @@ func walkCallExpr(call *ast.CallExpr, before, after func(ast.Node) error) error
 	return nil
 }
 
+// walkCastExpr walks the parse tree of the given cast expression in depth first
+// order.
+func walkCastExpr(expr *ast.CastExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.Type, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// walkCondExpr walks the parse tree of the given conditional expression in
+// depth first order.
+func walkCondExpr(expr *ast.CondExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.Cond, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.Y, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkIdent walks the parse tree of the given identifier expression in depth
 // first order.
 func walkIdent(ident *ast.Ident, before, after func(ast.Node) error) error {
@@ -393,6 +492,21 @@ func walkParenExpr(expr *ast.ParenExpr, before, after func(ast.Node) error) erro
 	return nil
 }
 
+// walkSizeofExpr walks the parse tree of the given sizeof expression in depth
+// first order.
+func walkSizeofExpr(expr *ast.SizeofExpr, before, after func(ast.Node) error) error {
+	if err := before(expr); err != nil {
+		return errutil.Err(err)
+	}
+	if err := WalkBeforeAfter(expr.X, before, after); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(expr); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkUnaryExpr walks the parse tree of the given unary expression in depth
 // first order.
 func walkUnaryExpr(expr *ast.UnaryExpr, before, after func(ast.Node) error) error {
@@ -419,12 +533,28 @@ func walkArrayType(arr *ast.ArrayType, before, after func(ast.Node) error) error
 	if err := WalkBeforeAfter(arr.Elem, before, after); err != nil {
 		return errutil.Err(err)
 	}
+	if err := WalkBeforeAfter(arr.LenExpr, before, after); err != nil {
+		return errutil.Err(err)
+	}
 	if err := after(arr); err != nil {
 		return errutil.Err(err)
 	}
 	return nil
 }
 
+// walkEnumType walks the parse tree of the given enumerated type in depth first
+// order. The enumeration tag is not walked, as tags reside in a separate name
+// space from ordinary identifiers.
+func walkEnumType(typ *ast.EnumType, before, after func(ast.Node) error) error {
+	if err := before(typ); err != nil {
+		return errutil.Err(err)
+	}
+	if err := after(typ); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
 // walkFuncType walks the parse tree of the given function signature in depth
 // first order.
 func walkFuncType(fn *ast.FuncType, before, after func(ast.Node) error) error {
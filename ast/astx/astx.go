@@ -3,8 +3,6 @@
 package astx
 
 import (
-	"strconv"
-
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	gocctoken "github.com/mewmew/uc/gocc/token"
@@ -135,7 +133,8 @@ func NewScalarDecl(typ, name interface{}) (*ast.VarDecl, error) {
 // production rule.
 //
 //    ArrayDecl
-//       : BasicType ident "[" int_lit "]"
+//       : BasicType ident "[" Expr3R "]"
+//       | BasicType ident "[" "]"
 //    ;
 func NewArrayDecl(elem, name, lbracket, length, rbracket interface{}) (*ast.VarDecl, error) {
 	typ, err := NewArrayType(elem, lbracket, length, rbracket)
@@ -149,33 +148,22 @@ func NewArrayDecl(elem, name, lbracket, length, rbracket interface{}) (*ast.VarD
 	return &ast.VarDecl{VarType: typ, VarName: ident}, nil
 }
 
-// NewIntLit returns a new integer, based on the following production rule.
+// NewVarDef returns a new variable definition node, based on the following
+// production rule.
 //
-//    IntLit
-//       : int_lit
-//       | char_lit
-func NewIntLit(nToken interface{}, kind token.Kind) (int, error) {
-	nTok, ok := nToken.(*gocctoken.Token)
+//    VarDef
+//       : ScalarDecl "=" Expr2R
+//    ;
+func NewVarDef(decl, val interface{}) (*ast.VarDecl, error) {
+	d, ok := decl.(*ast.VarDecl)
 	if !ok {
-		return 0, errutil.Newf("invalid integer literal type; expectd *gocctoken.Token, got %T", nToken)
+		return nil, errutil.Newf("invalid variable declaration type; expected *ast.VarDecl, got %T", decl)
 	}
-	s := string(nTok.Lit)
-	switch kind {
-	case token.IntLit:
-		n, err := strconv.Atoi(s)
-		if err != nil {
-			return 0, errutil.Err(err)
-		}
-		return n, nil
-	case token.CharLit:
-		s, err := strconv.Unquote(s)
-		if err != nil {
-			return 0, errutil.Newf("unable to unquote character literal; %v", err)
-		}
-		return int(s[0]), nil
-	default:
-		return 0, errutil.Newf(`invalid integer literal kind; expected "IntLit" or "CharLit", got %q`, kind)
+	if val, ok := val.(ast.Expr); ok {
+		d.Val = val
+		return d, nil
 	}
+	return nil, errutil.Newf("invalid variable value type; expected ast.Expr, got %T", val)
 }
 
 // NewTypeDef returns a new type definition node, based on the following
@@ -200,6 +188,94 @@ func NewTypeDef(typedefTok, typ, name interface{}) (*ast.TypeDef, error) {
 	return &ast.TypeDef{Typedef: typedef.Offset, DeclType: declType, TypeName: ident}, nil
 }
 
+// NewEnumDecl returns a new enumeration declaration node, based on the
+// following production rules.
+//
+//    EnumDecl
+//       : "enum" ident "{" Enumerators "}"
+//       | "enum" "{" Enumerators "}"
+//    ;
+func NewEnumDecl(enumTok, tag, lbrace, enumerators, rbrace interface{}) (*ast.EnumDecl, error) {
+	enum, ok := enumTok.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid enum keyword type; expectd *gocctoken.Token, got %T", enumTok)
+	}
+	var ident *ast.Ident
+	if tag != nil {
+		var err error
+		ident, err = NewIdent(tag)
+		if err != nil {
+			return nil, errutil.Newf("invalid enumeration tag identifier; %v", err)
+		}
+	}
+	lbrc, ok := lbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid left-brace type; expectd *gocctoken.Token, got %T", lbrace)
+	}
+	enums, ok := enumerators.([]*ast.Enumerator)
+	if !ok {
+		return nil, errutil.Newf("invalid enumerators type; expected []*ast.Enumerator, got %T", enumerators)
+	}
+	rbrc, ok := rbrace.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid right-brace type; expectd *gocctoken.Token, got %T", rbrace)
+	}
+	return &ast.EnumDecl{Enum: enum.Offset, Tag: ident, Lbrace: lbrc.Offset, Enumerators: enums, Rbrace: rbrc.Offset}, nil
+}
+
+// NewEnumeratorList returns a new enumerator list, based on the following
+// production rule.
+//
+//    EnumeratorList
+//       : Enumerator
+//    ;
+func NewEnumeratorList(enumerator interface{}) ([]*ast.Enumerator, error) {
+	if enumerator, ok := enumerator.(*ast.Enumerator); ok {
+		return []*ast.Enumerator{enumerator}, nil
+	}
+	return nil, errutil.Newf("invalid enumerator list enumerator type; expected *ast.Enumerator, got %T", enumerator)
+}
+
+// AppendEnumerator appends enumerator to the enumerator list, based on the
+// following production rule.
+//
+//    EnumeratorList
+//       : EnumeratorList "," Enumerator
+//    ;
+func AppendEnumerator(list, enumerator interface{}) ([]*ast.Enumerator, error) {
+	lst, ok := list.([]*ast.Enumerator)
+	if !ok {
+		return nil, errutil.Newf("invalid enumerator list type; expected []*ast.Enumerator, got %T", list)
+	}
+	if enumerator, ok := enumerator.(*ast.Enumerator); ok {
+		return append(lst, enumerator), nil
+	}
+	return nil, errutil.Newf("invalid enumerator list enumerator type; expected *ast.Enumerator, got %T", enumerator)
+}
+
+// NewEnumerator returns a new enumerator node, based on the following
+// production rules.
+//
+//    Enumerator
+//       : ident
+//       | ident "=" Expr3R
+//    ;
+func NewEnumerator(name, val interface{}) (*ast.Enumerator, error) {
+	ident, err := NewIdent(name)
+	if err != nil {
+		return nil, errutil.Newf("invalid enumerator identifier; %v", err)
+	}
+	enumerator := &ast.Enumerator{ConstName: ident}
+	if val != nil {
+		valExpr, ok := val.(ast.Expr)
+		if !ok {
+			return nil, errutil.Newf("invalid enumerator value type; expected ast.Expr, got %T", val)
+		}
+		enumerator.ValExpr = valExpr
+	}
+	return enumerator, nil
+}
+
 // NewParamList returns a new parameter list, based on the following production
 // rule.
 //
@@ -403,8 +479,12 @@ func NewEmptyStmt(semicolonToken interface{}) (*ast.EmptyStmt, error) {
 // NewBinaryExpr returns a new binary experssion node, based on the following
 // production rules.
 //
+//    Expr1L
+//       : Expr1L "," Expr2R
+//    ;
+//
 //    Expr2R
-//       : Expr2R "=" Expr5L
+//       : Expr5L "=" Expr2R
 //    ;
 //
 //    Expr5L
@@ -439,6 +519,8 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 	}
 	var op token.Kind
 	switch lit := string(opTok.Lit); lit {
+	case ",":
+		op = token.Comma
 	case "=":
 		op = token.Assign
 	case "&&":
@@ -464,7 +546,7 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 	case "/":
 		op = token.Div
 	default:
-		return nil, errutil.Newf(`invalid binary operator; expected "=", "&&", "==", "!=", "<", ">", "<=", ">=", "+", "-", "*" or "/", got %q`, lit)
+		return nil, errutil.Newf(`invalid binary operator; expected ",", "=", "&&", "==", "!=", "<", ">", "<=", ">=", "+", "-", "*" or "/", got %q`, lit)
 	}
 
 	arg0, ok := x.(ast.Expr)
@@ -478,6 +560,36 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 	return &ast.BinaryExpr{X: arg0, OpPos: opTok.Offset, Op: op, Y: arg1}, nil
 }
 
+// NewCondExpr returns a new conditional expression node, based on the
+// following production rule.
+//
+//    Expr3R
+//       : Expr5L "?" Expr ":" Expr3R
+//    ;
+func NewCondExpr(cond, question, x, colon, y interface{}) (*ast.CondExpr, error) {
+	c, ok := cond.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid condition type; expected ast.Expr, got %T", cond)
+	}
+	questionTok, ok := question.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid question mark type; expectd *gocctoken.Token, got %T", question)
+	}
+	arg0, ok := x.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid first conditional operand type; expected ast.Expr, got %T", x)
+	}
+	colonTok, ok := colon.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid colon type; expectd *gocctoken.Token, got %T", colon)
+	}
+	arg1, ok := y.(ast.Expr)
+	if !ok {
+		return nil, errutil.Newf("invalid second conditional operand type; expected ast.Expr, got %T", y)
+	}
+	return &ast.CondExpr{Cond: c, Question: questionTok.Offset, X: arg0, Colon: colonTok.Offset, Y: arg1}, nil
+}
+
 // NewUnaryExpr returns a new unary experssion node, based on the following
 // production rules.
 //
@@ -485,6 +597,10 @@ func NewBinaryExpr(x, opToken, y interface{}) (*ast.BinaryExpr, error) {
 //       : "-" Expr15
 //       | "!" Expr15
 //    ;
+//
+//    CastOperand
+//       : "!" Expr14
+//    ;
 func NewUnaryExpr(opToken, x interface{}) (*ast.UnaryExpr, error) {
 	opTok, ok := opToken.(*gocctoken.Token)
 	if !ok {
@@ -505,6 +621,46 @@ func NewUnaryExpr(opToken, x interface{}) (*ast.UnaryExpr, error) {
 	return nil, errutil.Newf("invalid unary operand type; expected ast.Expr, got %T", x)
 }
 
+// NewCastExpr returns a new cast expression node, based on the following
+// production rule.
+//
+//    CastExpr
+//       : ParenExpr CastOperand
+//    ;
+func NewCastExpr(typ, x interface{}) (*ast.CastExpr, error) {
+	paren, ok := typ.(*ast.ParenExpr)
+	if !ok {
+		return nil, errutil.Newf("invalid cast type; expected *ast.ParenExpr, got %T", typ)
+	}
+	// The type name of the cast expression is parsed as a parenthesized
+	// expression, which must consist of a single identifier.
+	ident, ok := paren.X.(*ast.Ident)
+	if !ok {
+		return nil, errutil.Newf("invalid cast type; expected type name, got %v", paren.X)
+	}
+	if x, ok := x.(ast.Expr); ok {
+		return &ast.CastExpr{Lparen: paren.Lparen, Type: ident, Rparen: paren.Rparen, X: x}, nil
+	}
+	return nil, errutil.Newf("invalid cast operand type; expected ast.Expr, got %T", x)
+}
+
+// NewSizeofExpr returns a new sizeof expression node, based on the following
+// production rule.
+//
+//    SizeofExpr
+//       : "sizeof" Expr14
+//    ;
+func NewSizeofExpr(sizeofToken, x interface{}) (*ast.SizeofExpr, error) {
+	sizeofTok, ok := sizeofToken.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid sizeof keyword type; expectd *gocctoken.Token, got %T", sizeofToken)
+	}
+	if x, ok := x.(ast.Expr); ok {
+		return &ast.SizeofExpr{Sizeof: sizeofTok.Offset, X: x}, nil
+	}
+	return nil, errutil.Newf("invalid sizeof operand type; expected ast.Expr, got %T", x)
+}
+
 // NewBasicLit returns a new basic literal experssion node of the given kind,
 // based on the following production rule.
 //
@@ -618,7 +774,7 @@ func NewParenExpr(lparen, x, rparen interface{}) (*ast.ParenExpr, error) {
 // rule.
 //
 //    ExprList
-//       : Expr
+//       : Expr2R
 //    ;
 func NewExprList(x interface{}) ([]ast.Expr, error) {
 	if x, ok := x.(ast.Expr); ok {
@@ -631,7 +787,7 @@ func NewExprList(x interface{}) ([]ast.Expr, error) {
 // production rule.
 //
 //    ExprList
-//       : ExprList "," Expr
+//       : ExprList "," Expr2R
 //    ;
 func AppendExpr(list, x interface{}) ([]ast.Expr, error) {
 	lst, ok := list.([]ast.Expr)
@@ -1,9 +1,12 @@
 package astx
 
 import (
+	"strconv"
+
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	gocctoken "github.com/mewmew/uc/gocc/token"
+	"github.com/mewmew/uc/token"
 )
 
 // NewType returns a new type of µC.
@@ -15,10 +18,25 @@ func NewType(typ interface{}) (ast.Type, error) {
 }
 
 // NewArrayType returns a new array type based on the given element type and
-// length.
+// length. The length is either an integer (0 if unspecified) or an integer
+// constant expression.
 func NewArrayType(elem, lbracket, length, rbracket interface{}) (*ast.ArrayType, error) {
-	len, ok := length.(int)
-	if !ok {
+	var len int
+	var lenExpr ast.Expr
+	switch length := length.(type) {
+	case int:
+		len = length
+	case *ast.BasicLit:
+		// The length of arrays with literal lengths is known at parse time.
+		n, err := intLit(length)
+		if err != nil {
+			return nil, errutil.Newf("invalid array length; %v", err)
+		}
+		len = n
+		lenExpr = length
+	case ast.Expr:
+		lenExpr = length
+	default:
 		return nil, errutil.Newf("invalid array length type; %T", length)
 	}
 
@@ -44,5 +62,43 @@ func NewArrayType(elem, lbracket, length, rbracket interface{}) (*ast.ArrayType,
 	if err != nil {
 		return nil, errutil.Newf("invalid array element type; %v", err)
 	}
-	return &ast.ArrayType{Elem: elemType, Lbracket: lbrack, Len: len, Rbracket: rbrack}, nil
+	return &ast.ArrayType{Elem: elemType, Lbracket: lbrack, Len: len, LenExpr: lenExpr, Rbracket: rbrack}, nil
+}
+
+// NewEnumType returns a new enumerated type, based on the following
+// production rule.
+//
+//    BasicType
+//       : "enum" ident
+//    ;
+func NewEnumType(enumTok, tag interface{}) (*ast.EnumType, error) {
+	enum, ok := enumTok.(*gocctoken.Token)
+	if !ok {
+		return nil, errutil.Newf("invalid enum keyword type; expectd *gocctoken.Token, got %T", enumTok)
+	}
+	ident, err := NewIdent(tag)
+	if err != nil {
+		return nil, errutil.Newf("invalid enumeration tag identifier; %v", err)
+	}
+	return &ast.EnumType{Enum: enum.Offset, Tag: ident}, nil
+}
+
+// intLit returns the integer value of the given integer or character literal.
+func intLit(lit *ast.BasicLit) (int, error) {
+	switch lit.Kind {
+	case token.IntLit:
+		n, err := strconv.Atoi(lit.Val)
+		if err != nil {
+			return 0, errutil.Err(err)
+		}
+		return n, nil
+	case token.CharLit:
+		s, err := strconv.Unquote(lit.Val)
+		if err != nil {
+			return 0, errutil.Newf("unable to unquote character literal; %v", err)
+		}
+		return int(s[0]), nil
+	default:
+		return 0, errutil.Newf(`invalid integer literal kind; expected "IntLit" or "CharLit", got %q`, lit.Kind)
+	}
 }
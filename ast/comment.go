@@ -0,0 +1,69 @@
+package ast
+
+import "strings"
+
+// A Comment represents a line comment or a block comment.
+//
+// Examples.
+//
+//    // line comment
+//    /* block comment */
+type Comment struct {
+	// Position of `/` starting the comment.
+	Slash int
+	// Comment text, including the comment markers; excluding the newline of
+	// line comments.
+	Text string
+}
+
+// A CommentGroup represents a sequence of comments with no other tokens and no
+// blank lines between.
+type CommentGroup struct {
+	// Comments of the group; len(List) > 0.
+	List []*Comment
+}
+
+// Start returns the start position of the comment within the input stream.
+func (c *Comment) Start() int {
+	return c.Slash
+}
+
+// Start returns the start position of the comment group within the input
+// stream.
+func (g *CommentGroup) Start() int {
+	return g.List[0].Start()
+}
+
+// Text returns the text of the comment group. Comment markers, the leading
+// space of line comments, trailing white-space of lines, and leading and
+// trailing blank lines are removed. The text ends with a newline, unless
+// empty.
+func (g *CommentGroup) Text() string {
+	if g == nil {
+		return ""
+	}
+	var lines []string
+	for _, c := range g.List {
+		text := c.Text
+		switch {
+		case strings.HasPrefix(text, "//"):
+			text = strings.TrimPrefix(text[len("//"):], " ")
+		case strings.HasPrefix(text, "/*"):
+			text = strings.TrimSuffix(text[len("/*"):], "*/")
+		}
+		for _, line := range strings.Split(text, "\n") {
+			lines = append(lines, strings.TrimRight(line, " \t"))
+		}
+	}
+	// Remove leading and trailing blank lines.
+	for len(lines) > 0 && len(lines[0]) == 0 {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -11,6 +11,12 @@ func newType(n Node) types.Type {
 	switch n := n.(type) {
 	case *ArrayType:
 		return &types.Array{Elem: newType(n.Elem), Len: n.Len}
+	case *EnumType:
+		// NOTE: "Each enumerated type shall be compatible with char, a signed
+		// integer type, or an unsigned integer type." (see §6.7.2.2)
+		//
+		// Enumerated types are represented as int.
+		return &types.Basic{Kind: types.Int}
 	case *FuncType:
 		params := make([]*types.Field, len(n.Params))
 		for i := range n.Params {
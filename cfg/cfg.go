@@ -0,0 +1,228 @@
+// Package cfg implements control flow graphs of µC function bodies, and a
+// generic solver of dataflow analyses over such graphs.
+//
+// The nodes of a control flow graph are basic blocks of syntax tree nodes,
+// which are executed in sequence. Block items (e.g. variable declarations,
+// expression statements and return statements) and branch conditions are
+// recorded in basic blocks; compound statements (e.g. if and while
+// statements) are represented by the edges between basic blocks.
+package cfg
+
+import (
+	"fmt"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem/constant"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+// A Graph is the control flow graph of a function body.
+type Graph struct {
+	// Basic blocks of the graph, in order of creation. The index of each basic
+	// block in Blocks is recorded by Block.Index.
+	Blocks []*Block
+	// Entry basic block; which is also Blocks[0].
+	Entry *Block
+	// Exit basic block; which is empty, and is the successor of each basic
+	// block ending with a return statement and of the basic block reaching the
+	// end of the function body.
+	Exit *Block
+}
+
+// A Block is a basic block of a control flow graph.
+type Block struct {
+	// Index of the basic block in the graph.
+	Index int
+	// Syntax tree nodes of the basic block, in order of execution; block items
+	// except for statements containing other statements, and branch
+	// conditions.
+	//
+	// The following nodes are recorded in basic blocks.
+	//
+	//    *ast.EnumDecl
+	//    *ast.FuncDecl   (nested function declaration)
+	//    *ast.TypeDef
+	//    *ast.VarDecl
+	//    *ast.ExprStmt
+	//    *ast.ReturnStmt
+	//    ast.Expr        (branch condition)
+	Nodes []ast.Node
+	// Branch condition; or nil if the basic block ends with an unconditional
+	// branch. The condition is also the last node of the basic block.
+	//
+	// The operands of logical AND and NOT expressions of conditions are split
+	// into separate basic blocks, to represent short-circuit evaluation.
+	Cond ast.Expr
+	// Successor basic blocks. The true and false branches of conditional basic
+	// blocks are at index 0 and 1, respectively; except for conditions which
+	// evaluate to constants, which have a single successor.
+	Succs []*Block
+	// Predecessor basic blocks.
+	Preds []*Block
+	// Live reports whether the basic block is reachable from the entry basic
+	// block.
+	Live bool
+}
+
+// String returns a string representation of the basic block.
+func (block *Block) String() string {
+	return fmt.Sprintf("block %d", block.Index)
+}
+
+// New returns the control flow graph of the body of the given function
+// definition. Branch conditions which are integer constant expressions are
+// evaluated based on the types of expressions in exprTypes, and only the
+// branch taken is recorded as a successor; exprTypes may be nil, in which case
+// no conditions are evaluated.
+func New(fn *ast.FuncDecl, exprTypes map[ast.Expr]types.Type) *Graph {
+	if fn.Body == nil {
+		panic(fmt.Sprintf("unable to create control flow graph of function declaration %q; missing function body", fn.FuncName))
+	}
+	b := &builder{g: &Graph{}, exprTypes: exprTypes}
+	b.g.Entry = b.newBlock()
+	b.g.Exit = b.newBlock()
+	b.cur = b.g.Entry
+	b.stmt(fn.Body)
+	addEdge(b.cur, b.g.Exit)
+	reach := Reachable(b.g)
+	for _, block := range b.g.Blocks {
+		block.Live = reach[block.Index]
+	}
+	return b.g
+}
+
+// ReachesEnd reports whether control may reach the end of the function body
+// represented by the given control flow graph, without executing a return
+// statement.
+func (g *Graph) ReachesEnd() bool {
+	for _, pred := range g.Exit.Preds {
+		if !pred.Live {
+			continue
+		}
+		if n := len(pred.Nodes); n > 0 {
+			if _, ok := pred.Nodes[n-1].(*ast.ReturnStmt); ok {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// A builder tracks information required to create control flow graphs.
+type builder struct {
+	// Control flow graph being created.
+	g *Graph
+	// Current basic block.
+	cur *Block
+	// Types of expressions; or nil.
+	exprTypes map[ast.Expr]types.Type
+}
+
+// newBlock appends a new basic block to the control flow graph.
+func (b *builder) newBlock() *Block {
+	block := &Block{Index: len(b.g.Blocks)}
+	b.g.Blocks = append(b.g.Blocks, block)
+	return block
+}
+
+// addEdge adds an edge from the basic block from to the basic block to.
+func addEdge(from, to *Block) {
+	from.Succs = append(from.Succs, to)
+	to.Preds = append(to.Preds, from)
+}
+
+// stmt records the given statement in the control flow graph, starting at the
+// current basic block.
+func (b *builder) stmt(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStmt:
+		for _, item := range stmt.Items {
+			if stmt, ok := item.(ast.Stmt); ok {
+				b.stmt(stmt)
+				continue
+			}
+			b.cur.Nodes = append(b.cur.Nodes, item)
+		}
+	case *ast.EmptyStmt:
+		// Nothing to do.
+	case *ast.ExprStmt:
+		b.cur.Nodes = append(b.cur.Nodes, stmt)
+	case *ast.IfStmt:
+		body := b.newBlock()
+		done := b.newBlock()
+		els := done
+		if stmt.Else != nil {
+			els = b.newBlock()
+		}
+		b.cond(stmt.Cond, body, els)
+		b.cur = body
+		b.stmt(stmt.Body)
+		addEdge(b.cur, done)
+		if stmt.Else != nil {
+			b.cur = els
+			b.stmt(stmt.Else)
+			addEdge(b.cur, done)
+		}
+		b.cur = done
+	case *ast.ReturnStmt:
+		b.cur.Nodes = append(b.cur.Nodes, stmt)
+		addEdge(b.cur, b.g.Exit)
+		// Statements following a return statement are unreachable, unless
+		// branched to.
+		b.cur = b.newBlock()
+	case *ast.WhileStmt:
+		cond := b.newBlock()
+		addEdge(b.cur, cond)
+		body := b.newBlock()
+		done := b.newBlock()
+		b.cur = cond
+		b.cond(stmt.Cond, body, done)
+		b.cur = body
+		b.stmt(stmt.Body)
+		addEdge(b.cur, cond)
+		b.cur = done
+	default:
+		panic(fmt.Sprintf("support for statement %T not yet implemented", stmt))
+	}
+}
+
+// cond records the given branch condition in the control flow graph, starting
+// at the current basic block, with edges to the basic block t if the condition
+// is true and to the basic block f otherwise.
+func (b *builder) cond(x ast.Expr, t, f *Block) {
+	switch x := x.(type) {
+	case *ast.ParenExpr:
+		b.cond(x.X, t, f)
+		return
+	case *ast.UnaryExpr:
+		if x.Op == token.Not {
+			b.cond(x.X, f, t)
+			return
+		}
+	case *ast.BinaryExpr:
+		if x.Op == token.Land {
+			// The second operand is only evaluated if the first operand is true.
+			y := b.newBlock()
+			b.cond(x.X, y, f)
+			b.cur = y
+			b.cond(x.Y, t, f)
+			return
+		}
+	}
+	b.cur.Nodes = append(b.cur.Nodes, x)
+	b.cur.Cond = x
+	if b.exprTypes != nil {
+		if v, err := constant.Eval(x, b.exprTypes); err == nil {
+			if v != 0 {
+				addEdge(b.cur, t)
+			} else {
+				addEdge(b.cur, f)
+			}
+			return
+		}
+	}
+	addEdge(b.cur, t)
+	addEdge(b.cur, f)
+}
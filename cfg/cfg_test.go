@@ -0,0 +1,210 @@
+package cfg_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/cfg"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/types"
+)
+
+func TestNew(t *testing.T) {
+	golden := []struct {
+		src  string
+		want string
+	}{
+		{
+			src: "void f(int x) { x = 1; return; }",
+			want: `block 0: x = 1; return; -> 1
+block 1:
+block 2 (dead): -> 1
+`,
+		},
+		{
+			src: "void f(int x) { if (x) x = 1; else x = 2; x = 3; }",
+			want: `block 0: x ? -> 2 4
+block 1:
+block 2: x = 1; -> 3
+block 3: x = 3; -> 1
+block 4: x = 2; -> 3
+`,
+		},
+		{
+			src: "void f(int x) { while (x > 0) x = x - 1; }",
+			want: `block 0: -> 2
+block 1:
+block 2: x > 0 ? -> 3 4
+block 3: x = x - 1; -> 2
+block 4: -> 1
+`,
+		},
+		{
+			src: "void f(int x) { if (x && !(x < 2)) x = 1; }",
+			want: `block 0: x ? -> 4 3
+block 1:
+block 2: x = 1; -> 3
+block 3: -> 1
+block 4: x < 2 ? -> 3 2
+`,
+		},
+		{
+			src: "int f(int x) { while (1) { return x; } }",
+			want: `block 0: -> 2
+block 1:
+block 2: 1 ? -> 3
+block 3: return x; -> 1
+block 4 (dead): -> 1
+block 5 (dead): -> 2
+`,
+		},
+		{
+			src: "int f(int x) { if (0) x = 1; return x; }",
+			want: `block 0: 0 ? -> 3
+block 1:
+block 2 (dead): x = 1; -> 3
+block 3: return x; -> 1
+block 4 (dead): -> 1
+`,
+		},
+	}
+	for _, g := range golden {
+		fn, exprTypes := check(t, g.src)
+		if fn == nil {
+			continue
+		}
+		got := format(cfg.New(fn, exprTypes))
+		if got != g.want {
+			t.Errorf("%q: control flow graph mismatch; expected `%s`, got `%s`", g.src, g.want, got)
+		}
+	}
+}
+
+func TestReachesEnd(t *testing.T) {
+	golden := []struct {
+		src  string
+		want bool
+	}{
+		{src: "void f(int x) { }", want: true},
+		{src: "void f(int x) { return; }", want: false},
+		{src: "void f(int x) { return; x = 1; }", want: false},
+		{src: "void f(int x) { if (x) return; }", want: true},
+		{src: "void f(int x) { if (x) return; else return; }", want: false},
+		{src: "void f(int x) { if (1) return; }", want: false},
+		{src: "void f(int x) { if (!1) return; }", want: true},
+		{src: "void f(int x) { while (1) { return; } }", want: false},
+		{src: "void f(int x) { while (1) { x = x + 1; } }", want: false},
+		{src: "void f(int x) { while (x) { return; } }", want: true},
+		{src: "void f(int x) { while (1 && x) { return; } }", want: true},
+	}
+	for _, g := range golden {
+		fn, exprTypes := check(t, g.src)
+		if fn == nil {
+			continue
+		}
+		got := cfg.New(fn, exprTypes).ReachesEnd()
+		if got != g.want {
+			t.Errorf("%q: reaches end mismatch; expected %v, got %v", g.src, g.want, got)
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	// Count the maximum number of assignments executed along acyclic paths
+	// from the entry basic block; facts of loops grow without bound, and are
+	// capped at 10.
+	const src = "void f(int x) { x = 1; if (x) { x = 2; x = 3; } else x = 4; while (x) x = 5; }"
+	max := func(x, y cfg.Fact) cfg.Fact {
+		if x.(int) > y.(int) {
+			return x
+		}
+		return y
+	}
+	a := &cfg.Analysis{
+		Boundary: 0,
+		Init:     0,
+		Meet:     max,
+		Transfer: func(block *cfg.Block, in cfg.Fact) cfg.Fact {
+			n := in.(int)
+			for _, node := range block.Nodes {
+				if _, ok := node.(*ast.ExprStmt); ok && n < 10 {
+					n++
+				}
+			}
+			return n
+		},
+		Equal: func(x, y cfg.Fact) bool { return x == y },
+	}
+	fn, _ := check(t, src)
+	if fn == nil {
+		return
+	}
+	g := cfg.New(fn, nil)
+	res := cfg.Solve(g, a)
+	if got, want := res.Out[g.Exit.Index], 10; got != want {
+		t.Errorf("forward analysis mismatch; expected %v, got %v", want, got)
+	}
+	if got, want := res.In[g.Blocks[3].Index], 3; got != want {
+		t.Errorf("forward analysis mismatch at if-done; expected %v, got %v", want, got)
+	}
+
+	// The same analysis, backward from the exit basic block.
+	a.Backward = true
+	res = cfg.Solve(g, a)
+	if got, want := res.Out[g.Exit.Index], 0; got != want {
+		t.Errorf("backward analysis mismatch at exit; expected %v, got %v", want, got)
+	}
+	if got, want := res.In[g.Entry.Index], 10; got != want {
+		t.Errorf("backward analysis mismatch at entry; expected %v, got %v", want, got)
+	}
+}
+
+// check parses and type-checks the given source code, and returns its last
+// function definition and the types of its expressions.
+func check(t *testing.T, src string) (*ast.FuncDecl, map[ast.Expr]types.Type) {
+	p := parser.NewParser()
+	file, err := p.Parse(scanner.NewFromString(src))
+	if err != nil {
+		t.Errorf("%q: unable to parse source; %v", src, err)
+		return nil, nil
+	}
+	f := file.(*ast.File)
+	info, err := sem.Check(f)
+	if err != nil {
+		t.Errorf("%q: unable to check source; %v", src, err)
+		return nil, nil
+	}
+	return f.Decls[len(f.Decls)-1].(*ast.FuncDecl), info.Types
+}
+
+// format returns a string representation of the given control flow graph,
+// with one line per basic block.
+func format(g *cfg.Graph) string {
+	buf := &bytes.Buffer{}
+	for _, block := range g.Blocks {
+		fmt.Fprintf(buf, "%v", block)
+		if !block.Live {
+			buf.WriteString(" (dead)")
+		}
+		buf.WriteString(":")
+		for _, node := range block.Nodes {
+			if node == block.Cond {
+				fmt.Fprintf(buf, " %v ?", node)
+				continue
+			}
+			fmt.Fprintf(buf, " %v", node)
+		}
+		if len(block.Succs) > 0 {
+			buf.WriteString(" ->")
+			for _, succ := range block.Succs {
+				fmt.Fprintf(buf, " %d", succ.Index)
+			}
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
@@ -0,0 +1,112 @@
+package cfg
+
+// A Fact is a dataflow fact; an element of the lattice of a dataflow analysis.
+type Fact interface{}
+
+// An Analysis is a monotone dataflow analysis over control flow graphs.
+type Analysis struct {
+	// Backward reports whether facts flow from successors to predecessors,
+	// instead of from predecessors to successors.
+	Backward bool
+	// Boundary is the fact at the start of the entry basic block of forward
+	// analyses, and at the end of the exit basic block of backward analyses.
+	Boundary Fact
+	// Init is the initial fact of the remaining basic blocks; the identity of
+	// Meet.
+	Init Fact
+	// Meet returns the meet of the given facts, at control flow merge points.
+	Meet func(x, y Fact) Fact
+	// Transfer returns the fact after the given basic block, based on the fact
+	// before the basic block (in the direction of the analysis).
+	Transfer func(block *Block, in Fact) Fact
+	// Equal reports whether the given facts are equal.
+	Equal func(x, y Fact) bool
+}
+
+// A Result holds the facts of a solved dataflow analysis, indexed by basic
+// block index.
+type Result struct {
+	// In holds the facts at the start of each basic block.
+	In []Fact
+	// Out holds the facts at the end of each basic block.
+	Out []Fact
+}
+
+// Solve solves the given dataflow analysis over the control flow graph g, by
+// iterating the transfer functions of basic blocks until a fixed point is
+// reached.
+func Solve(g *Graph, a *Analysis) *Result {
+	n := len(g.Blocks)
+	res := &Result{In: make([]Fact, n), Out: make([]Fact, n)}
+	// before and after hold the facts before and after each basic block, in
+	// the direction of the analysis.
+	before, after := res.In, res.Out
+	start := g.Entry
+	preds := func(block *Block) []*Block { return block.Preds }
+	succs := func(block *Block) []*Block { return block.Succs }
+	if a.Backward {
+		before, after = res.Out, res.In
+		start = g.Exit
+		preds, succs = succs, preds
+	}
+	for i := range g.Blocks {
+		before[i] = a.Init
+		after[i] = a.Init
+	}
+
+	// Process basic blocks in order of creation (reversed for backward
+	// analyses), which approximates the order of control flow.
+	var worklist []*Block
+	queued := make([]bool, n)
+	for i := range g.Blocks {
+		block := g.Blocks[i]
+		if a.Backward {
+			block = g.Blocks[n-1-i]
+		}
+		worklist = append(worklist, block)
+		queued[block.Index] = true
+	}
+	for len(worklist) > 0 {
+		block := worklist[0]
+		worklist = worklist[1:]
+		queued[block.Index] = false
+		in := a.Init
+		if block == start {
+			in = a.Boundary
+		}
+		for _, pred := range preds(block) {
+			in = a.Meet(in, after[pred.Index])
+		}
+		before[block.Index] = in
+		out := a.Transfer(block, in)
+		if a.Equal(out, after[block.Index]) {
+			continue
+		}
+		after[block.Index] = out
+		for _, succ := range succs(block) {
+			if !queued[succ.Index] {
+				worklist = append(worklist, succ)
+				queued[succ.Index] = true
+			}
+		}
+	}
+	return res
+}
+
+// Reachable returns the reachability of each basic block of the control flow
+// graph g from its entry basic block, indexed by basic block index.
+func Reachable(g *Graph) []bool {
+	a := &Analysis{
+		Boundary: true,
+		Init:     false,
+		Meet:     func(x, y Fact) Fact { return x.(bool) || y.(bool) },
+		Transfer: func(block *Block, in Fact) Fact { return in },
+		Equal:    func(x, y Fact) bool { return x == y },
+	}
+	res := Solve(g, a)
+	reach := make([]bool, len(g.Blocks))
+	for i, fact := range res.In {
+		reach[i] = fact.(bool)
+	}
+	return reach
+}
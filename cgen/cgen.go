@@ -0,0 +1,633 @@
+// Package cgen implements a C source code generator for type-checked µC syntax
+// trees.
+//
+// The generated C11 source code is also valid µC, and may thus be processed
+// again by the µC compiler. Declarations of the runtime library functions of
+// testdata/uc.c are prepended, unless already declared by the input.
+//
+// Implicit conversions recorded by the semantic analysis are made explicit
+// through casts, and the results of arithmetic on char operands are cast to
+// char, as µC does not perform integer promotion.
+//
+// Nested function definitions are lifted to file scope, and renamed to include
+// the names of their enclosing functions; e.g. the nested function g of f is
+// renamed to f_g. Nested functions which refer to local declarations of their
+// enclosing functions (e.g. captured variables) are not supported, as they
+// have no counterpart in C.
+package cgen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+// runtime lists the declarations of the runtime library functions, as provided
+// by testdata/uc.c.
+var runtime = []struct {
+	name string
+	decl string
+}{
+	{name: "putint", decl: "void putint(int x);"},
+	{name: "putstring", decl: "void putstring(char s[]);"},
+	{name: "getint", decl: "int getint(void);"},
+	{name: "getstring", decl: "int getstring(char s[]);"},
+}
+
+// Gen generates C source code for the given type-checked file.
+func Gen(file *ast.File, info *sem.Info) (string, error) {
+	g := newGenerator(info)
+	if err := g.rename(file); err != nil {
+		return "", errutil.Err(err)
+	}
+
+	// Emit declarations of the runtime library functions not declared by the
+	// input.
+	declared := make(map[string]bool)
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok {
+			declared[decl.FuncName.Name] = true
+		}
+	}
+	for _, f := range runtime {
+		if !declared[f.name] {
+			g.emit("%s", f.decl)
+		}
+	}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !astutil.IsDef(fn) {
+			g.topDecl(false, func() { g.decl(decl) })
+			continue
+		}
+		// Lift nested function definitions to file scope, preceded by their
+		// prototypes.
+		nested := g.nested[fn]
+		for _, fn := range nested {
+			g.topDecl(false, func() { g.emit("%s;", g.funcHeader(fn)) })
+		}
+		for _, fn := range append(nested, fn) {
+			g.topDecl(true, func() { g.emit("%s %s", g.funcHeader(fn), g.block(fn.Body)) })
+		}
+	}
+	return g.buf.String(), nil
+}
+
+// A generator keeps track of the state required to generate C source code.
+type generator struct {
+	// Semantic information of the file.
+	info *sem.Info
+	// Output buffer of the generated source code.
+	buf *bytes.Buffer
+	// Indentation level.
+	indent int
+	// Maps from nested function definitions to their new names.
+	names map[ast.Decl]string
+	// Maps from identifiers named after C keywords to their new names.
+	keywords map[string]string
+	// Maps from function definitions to the nested function definitions they
+	// enclose, directly or indirectly, in source order.
+	nested map[*ast.FuncDecl][]*ast.FuncDecl
+	// Set of nested function definitions.
+	lifted map[*ast.FuncDecl]bool
+	// Specifies whether the previous top-level declaration was a function
+	// definition.
+	prevDef bool
+}
+
+// newGenerator returns a new C source code generator.
+func newGenerator(info *sem.Info) *generator {
+	return &generator{
+		info:     info,
+		buf:      &bytes.Buffer{},
+		names:    make(map[ast.Decl]string),
+		keywords: make(map[string]string),
+		nested:   make(map[*ast.FuncDecl][]*ast.FuncDecl),
+		lifted:   make(map[*ast.FuncDecl]bool),
+	}
+}
+
+// emit emits the given line at the current indentation level.
+func (g *generator) emit(format string, a ...interface{}) {
+	g.buf.WriteString(strings.Repeat("\t", g.indent))
+	fmt.Fprintf(g.buf, format, a...)
+	g.buf.WriteString("\n")
+}
+
+// keywords is the set of C11 keywords which are not keywords of µC.
+var keywords = map[string]bool{
+	"auto": true, "break": true, "case": true, "const": true, "continue": true,
+	"default": true, "do": true, "double": true, "extern": true, "float": true,
+	"for": true, "goto": true, "inline": true, "long": true, "register": true,
+	"restrict": true, "short": true, "signed": true, "static": true,
+	"struct": true, "switch": true, "union": true, "unsigned": true,
+	"volatile": true, "_Alignas": true, "_Alignof": true, "_Atomic": true,
+	"_Bool": true, "_Complex": true, "_Generic": true, "_Imaginary": true,
+	"_Noreturn": true, "_Static_assert": true, "_Thread_local": true,
+}
+
+// rename locates the nested function definitions of the given file, and
+// assigns new names to nested functions and to identifiers named after C
+// keywords.
+func (g *generator) rename(file *ast.File) error {
+	// Names of identifiers used within the file.
+	used := make(map[string]bool)
+	// Declarations of the file, in source order.
+	var decls []ast.Decl
+	// Maps from local declarations to their enclosing top-level function
+	// definition.
+	owners := make(map[ast.Decl]*ast.FuncDecl)
+	// Stack of function definitions, where the top-most entry represents the
+	// currently active function.
+	var funcs []*ast.FuncDecl
+	before := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.Ident:
+			used[n.Name] = true
+		case ast.Decl:
+			decls = append(decls, n)
+			if len(funcs) > 0 {
+				owners[n] = funcs[0]
+			}
+			if fn, ok := n.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+				if len(funcs) > 0 {
+					g.nested[funcs[0]] = append(g.nested[funcs[0]], fn)
+					g.lifted[fn] = true
+				}
+				funcs = append(funcs, fn)
+			}
+		}
+		return nil
+	}
+	after := func(n ast.Node) error {
+		if fn, ok := n.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+			funcs = funcs[:len(funcs)-1]
+		}
+		return nil
+	}
+	if err := astutil.WalkBeforeAfter(file, before, after); err != nil {
+		return errutil.Err(err)
+	}
+
+	// unique returns a unique name based on the given name.
+	unique := func(name string) string {
+		new := name
+		for i := 1; used[new]; i++ {
+			new = fmt.Sprintf("%s%d", name, i)
+		}
+		used[new] = true
+		return new
+	}
+
+	for _, decl := range decls {
+		name := decl.Name()
+		if name == nil || !keywords[name.Name] {
+			continue
+		}
+		if _, ok := g.keywords[name.Name]; !ok {
+			g.keywords[name.Name] = unique(name.Name + "_")
+		}
+	}
+
+	// Verify that nested functions only refer to file-scope declarations, their
+	// own declarations and other nested functions.
+	for _, outer := range file.Decls {
+		outer, ok := outer.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		for _, fn := range g.nested[outer] {
+			local := make(map[ast.Decl]bool)
+			check := func(n ast.Node) error {
+				switch n := n.(type) {
+				case ast.Decl:
+					local[n] = true
+				case *ast.Ident:
+					decl := n.Decl
+					if owners[decl] != outer || local[decl] {
+						return nil
+					}
+					if fn, ok := decl.(*ast.FuncDecl); ok && g.lifted[fn] {
+						return nil
+					}
+					return errutil.Newf("nested function %q refers to local declaration %q of enclosing function %q", fn.FuncName, n, outer.FuncName)
+				}
+				return nil
+			}
+			nop := func(n ast.Node) error { return nil }
+			if err := astutil.WalkBeforeAfter(fn, check, nop); err != nil {
+				return errutil.Err(err)
+			}
+		}
+	}
+
+	// Rename nested functions after their enclosing functions.
+	var lift func(prefix string, items []ast.BlockItem)
+	lift = func(prefix string, items []ast.BlockItem) {
+		for _, item := range items {
+			switch item := item.(type) {
+			case *ast.FuncDecl:
+				if item.Body == nil {
+					continue
+				}
+				name := unique(prefix + "_" + item.FuncName.Name)
+				g.names[item] = name
+				lift(name, item.Body.Items)
+			case *ast.BlockStmt:
+				lift(prefix, item.Items)
+			case *ast.IfStmt:
+				lift(prefix, stmts(item.Body, item.Else))
+			case *ast.WhileStmt:
+				lift(prefix, stmts(item.Body))
+			}
+		}
+	}
+	for _, outer := range file.Decls {
+		if outer, ok := outer.(*ast.FuncDecl); ok && len(g.nested[outer]) > 0 {
+			lift(g.name(outer.FuncName), outer.Body.Items)
+		}
+	}
+	return nil
+}
+
+// stmts returns the given non-nil statements as block items.
+func stmts(ss ...ast.Stmt) []ast.BlockItem {
+	var items []ast.BlockItem
+	for _, s := range ss {
+		if s, ok := s.(ast.BlockItem); ok {
+			items = append(items, s)
+		}
+	}
+	return items
+}
+
+// name returns the C identifier of the given identifier.
+func (g *generator) name(ident *ast.Ident) string {
+	if name, ok := g.names[ident.Decl]; ok {
+		return name
+	}
+	if name, ok := g.keywords[ident.Name]; ok {
+		return name
+	}
+	return ident.Name
+}
+
+// topDecl emits a top-level declaration using the given emit function.
+// Function definitions are separated from other declarations by blank lines.
+func (g *generator) topDecl(isDef bool, emit func()) {
+	if g.buf.Len() > 0 && (isDef || g.prevDef) {
+		g.buf.WriteString("\n")
+	}
+	g.prevDef = isDef
+	emit()
+}
+
+// funcHeader returns the result type, name and parameters of the given function
+// declaration.
+func (g *generator) funcHeader(fn *ast.FuncDecl) string {
+	var params []string
+	for _, param := range fn.FuncType.Params {
+		params = append(params, g.varDecl(param))
+	}
+	return fmt.Sprintf("%s %s(%s)", g.typ(fn.FuncType.Result), g.name(fn.FuncName), strings.Join(params, ", "))
+}
+
+// decl emits the given declaration.
+func (g *generator) decl(decl ast.Decl) {
+	switch decl := decl.(type) {
+	case *ast.EnumDecl:
+		var enumerators []string
+		for _, enumerator := range decl.Enumerators {
+			s := g.name(enumerator.ConstName)
+			if enumerator.ValExpr != nil {
+				s += " = " + g.expr(enumerator.ValExpr)
+			}
+			enumerators = append(enumerators, s)
+		}
+		tag := ""
+		if decl.Tag != nil {
+			tag = decl.Tag.Name + " "
+		}
+		g.emit("enum %s{ %s };", tag, strings.Join(enumerators, ", "))
+	case *ast.FuncDecl:
+		if decl.Body != nil {
+			// Nested function definitions are lifted to file scope.
+			return
+		}
+		g.emit("%s;", g.funcHeader(decl))
+	case *ast.VarDecl:
+		s := g.varDecl(decl)
+		if decl.Val != nil {
+			s += " = " + g.expr(decl.Val)
+		}
+		g.emit("%s;", s)
+	case *ast.TypeDef:
+		g.emit("typedef %s;", g.declarator(decl.DeclType, g.name(decl.TypeName)))
+	default:
+		panic(fmt.Sprintf("support for declaration %T not yet implemented", decl))
+	}
+}
+
+// varDecl returns the type and name of the given variable declaration or
+// function parameter.
+func (g *generator) varDecl(decl *ast.VarDecl) string {
+	if decl.VarName == nil {
+		return g.typ(decl.VarType)
+	}
+	return g.declarator(decl.VarType, g.name(decl.VarName))
+}
+
+// declarator returns the declaration of name with the given type.
+func (g *generator) declarator(typ ast.Type, name string) string {
+	if arr, ok := typ.(*ast.ArrayType); ok {
+		n := ""
+		switch {
+		case arr.LenExpr != nil:
+			n = g.expr(arr.LenExpr)
+		case arr.Len > 0:
+			n = fmt.Sprint(arr.Len)
+		}
+		return g.declarator(arr.Elem, fmt.Sprintf("%s[%s]", name, n))
+	}
+	return g.typ(typ) + " " + name
+}
+
+// typ returns the C type name of the given type.
+func (g *generator) typ(typ ast.Type) string {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		return g.name(typ)
+	case *ast.EnumType:
+		return "enum " + typ.Tag.Name
+	default:
+		panic(fmt.Sprintf("support for type %T not yet implemented", typ))
+	}
+}
+
+// block returns the given block statement, indented one level deeper than the
+// current indentation level, without trailing new line.
+func (g *generator) block(block *ast.BlockStmt) string {
+	buf := g.buf
+	g.buf = &bytes.Buffer{}
+	g.buf.WriteString("{\n")
+	g.indent++
+	for _, item := range block.Items {
+		switch item := item.(type) {
+		case ast.Decl:
+			g.decl(item)
+		case ast.Stmt:
+			g.stmt(item)
+		}
+	}
+	g.indent--
+	g.buf.WriteString(strings.Repeat("\t", g.indent))
+	g.buf.WriteString("}")
+	s := g.buf.String()
+	g.buf = buf
+	return s
+}
+
+// stmt emits the given statement.
+func (g *generator) stmt(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStmt:
+		g.emit("%s", g.block(stmt))
+	case *ast.EmptyStmt:
+		g.emit(";")
+	case *ast.ExprStmt:
+		g.emit("%s;", g.expr(stmt.X))
+	case *ast.IfStmt:
+		g.body(fmt.Sprintf("if (%s)", g.expr(stmt.Cond)), stmt.Body, stmt.Else)
+	case *ast.ReturnStmt:
+		if stmt.Result == nil {
+			g.emit("return;")
+			return
+		}
+		g.emit("return %s;", g.expr(stmt.Result))
+	case *ast.WhileStmt:
+		g.body(fmt.Sprintf("while (%s)", g.expr(stmt.Cond)), stmt.Body, nil)
+	default:
+		panic(fmt.Sprintf("support for statement %T not yet implemented", stmt))
+	}
+}
+
+// body emits the given if or while statement header followed by its body, and
+// the optional else branch.
+func (g *generator) body(header string, body, els ast.Stmt) {
+	if block, ok := body.(*ast.BlockStmt); ok {
+		header += " " + g.block(block)
+		if els == nil {
+			g.emit("%s", header)
+			return
+		}
+		header += " else"
+	} else {
+		g.emit("%s", header)
+		g.indent++
+		g.stmt(body)
+		g.indent--
+		if els == nil {
+			return
+		}
+		header = "else"
+	}
+	switch els := els.(type) {
+	case *ast.IfStmt:
+		g.body(fmt.Sprintf("%s if (%s)", header, g.expr(els.Cond)), els.Body, els.Else)
+	case *ast.BlockStmt:
+		g.emit("%s %s", header, g.block(els))
+	default:
+		g.emit("%s", header)
+		g.indent++
+		g.stmt(els)
+		g.indent--
+	}
+}
+
+// Operator precedence levels of expressions.
+const (
+	precComma = iota + 1
+	precAssign
+	precCond
+	precLand
+	precEq
+	precRel
+	precAdd
+	precMul
+	precUnary
+	precPrimary
+)
+
+// precedence returns the operator precedence level of the given expression.
+func precedence(x ast.Expr) int {
+	switch x := x.(type) {
+	case *ast.BinaryExpr:
+		switch x.Op {
+		case token.Comma:
+			return precComma
+		case token.Assign:
+			return precAssign
+		case token.Land:
+			return precLand
+		case token.Eq, token.Ne:
+			return precEq
+		case token.Lt, token.Gt, token.Le, token.Ge:
+			return precRel
+		case token.Add, token.Sub:
+			return precAdd
+		default:
+			return precMul
+		}
+	case *ast.CondExpr:
+		return precCond
+	case *ast.CastExpr, *ast.SizeofExpr, *ast.UnaryExpr:
+		return precUnary
+	default:
+		return precPrimary
+	}
+}
+
+// expr returns the given expression, with implicit conversions made explicit.
+func (g *generator) expr(x ast.Expr) string {
+	s := g.exprNoConv(x)
+	if to, ok := g.info.Conversions[x]; ok {
+		s = cast(to, s, g.prec(x))
+	}
+	return s
+}
+
+// prec returns the operator precedence level of the given expression, as
+// emitted by exprNoConv.
+func (g *generator) prec(x ast.Expr) int {
+	if g.isCharArith(x) {
+		return precUnary
+	}
+	return precedence(x)
+}
+
+// cast returns the conversion of the expression s with the given precedence
+// level to the given type.
+func cast(to types.Type, s string, prec int) string {
+	if prec < precUnary {
+		s = "(" + s + ")"
+	}
+	return fmt.Sprintf("(%v)%s", to, s)
+}
+
+// isCharArith reports whether the given expression is an arithmetic expression
+// of type char.
+func (g *generator) isCharArith(x ast.Expr) bool {
+	switch x := x.(type) {
+	case *ast.BinaryExpr:
+		switch x.Op {
+		case token.Add, token.Sub, token.Mul, token.Div:
+		default:
+			return false
+		}
+	case *ast.UnaryExpr:
+		if x.Op != token.Sub {
+			return false
+		}
+	default:
+		return false
+	}
+	typ, ok := g.info.Types[x].(*types.Basic)
+	return ok && typ.Kind == types.Char
+}
+
+// exprNoConv returns the given expression, without its implicit conversion.
+func (g *generator) exprNoConv(x ast.Expr) string {
+	s := g.operation(x)
+	if g.isCharArith(x) {
+		s = cast(g.info.Types[x], s, precedence(x))
+	}
+	return s
+}
+
+// operation returns the given expression, with implicit conversions of its
+// operands made explicit.
+func (g *generator) operation(x ast.Expr) string {
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		return x.Val
+	case *ast.BinaryExpr:
+		prec := precedence(x)
+		// Assignment is right-associative, all other binary operators are
+		// left-associative.
+		xprec, yprec := prec, prec+1
+		if x.Op == token.Assign {
+			xprec, yprec = prec+1, prec
+		}
+		op := " " + x.Op.String() + " "
+		if x.Op == token.Comma {
+			op = ", "
+		}
+		return g.operand(x.X, xprec) + op + g.operand(x.Y, yprec)
+	case *ast.CallExpr:
+		var args []string
+		for _, arg := range x.Args {
+			args = append(args, g.operand(arg, precAssign))
+		}
+		return fmt.Sprintf("%s(%s)", g.name(x.Name), strings.Join(args, ", "))
+	case *ast.CastExpr:
+		operand := x.X
+		for {
+			paren, ok := operand.(*ast.ParenExpr)
+			if !ok {
+				break
+			}
+			operand = paren.X
+		}
+		if types.Equal(g.info.Types[x], g.info.Types[operand]) && g.isCharArith(operand) {
+			// Omit redundant cast of char arithmetic.
+			if _, ok := g.info.Conversions[operand]; !ok {
+				return fmt.Sprintf("(%s)(%s)", g.typ(x.Type), g.operation(operand))
+			}
+		}
+		return fmt.Sprintf("(%s)%s", g.typ(x.Type), g.operand(x.X, precUnary))
+	case *ast.CondExpr:
+		return fmt.Sprintf("%s ? %s : %s", g.operand(x.Cond, precLand), g.operand(x.X, precComma), g.operand(x.Y, precCond))
+	case *ast.Ident:
+		return g.name(x)
+	case *ast.IndexExpr:
+		return fmt.Sprintf("%s[%s]", g.name(x.Name), g.expr(x.Index))
+	case *ast.ParenExpr:
+		return "(" + g.expr(x.X) + ")"
+	case *ast.SizeofExpr:
+		if _, ok := x.X.(*ast.ParenExpr); ok {
+			return "sizeof" + g.expr(x.X)
+		}
+		return "sizeof " + g.operand(x.X, precUnary)
+	case *ast.UnaryExpr:
+		s := g.operand(x.X, precUnary)
+		if x.Op == token.Sub && strings.HasPrefix(s, "-") {
+			// Prevent the lexing of "--".
+			s = " " + s
+		}
+		return x.Op.String() + s
+	default:
+		panic(fmt.Sprintf("support for expression %T not yet implemented", x))
+	}
+}
+
+// operand returns the given operand expression, parenthesized if its
+// precedence level (after conversion) is lower than prec.
+func (g *generator) operand(x ast.Expr, prec int) string {
+	s := g.expr(x)
+	p := g.prec(x)
+	if _, ok := g.info.Conversions[x]; ok {
+		p = precUnary
+	}
+	if p < prec {
+		return "(" + s + ")"
+	}
+	return s
+}
@@ -0,0 +1,101 @@
+package cgen_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/cgen"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/sem"
+)
+
+func TestGen(t *testing.T) {
+	golden := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../testdata/extra/cgen/cgen.c",
+			want: "../testdata/extra/cgen/cgen.golden",
+		},
+	}
+	for _, g := range golden {
+		got, err := gen(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		buf, err := ioutil.ReadFile(g.want)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		want := string(buf)
+		if got != want {
+			t.Errorf("%q: C source mismatch; expected `%v`, got `%v`", g.path, want, got)
+		}
+	}
+}
+
+// TestRoundTrip verifies that the generated C source code is accepted by the
+// µC compiler, and that generating C source code for it again yields the same
+// output.
+func TestRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob("../testdata/noisy/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths, "../testdata/extra/cgen/cgen.c", "../testdata/extra/irgen/enum.c", "../testdata/extra/semantic/cast-expr.c")
+	for _, path := range paths {
+		got, err := gen(path)
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		again, err := genFromString(got)
+		if err != nil {
+			t.Errorf("%q: unable to compile generated C source; %v", path, err)
+			continue
+		}
+		if got != again {
+			t.Errorf("%q: C source mismatch after round-trip; expected `%v`, got `%v`", path, got, again)
+		}
+	}
+}
+
+func TestGenCapture(t *testing.T) {
+	const want = `nested function "add" refers to local declaration "sum" of enclosing function "main"`
+	_, err := gen("../testdata/extra/semantic/nested-function-capture.c")
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("error mismatch; expected %q, got %v", want, err)
+	}
+}
+
+// gen generates C source code for the given µC source file.
+func gen(path string) (string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return genFromString(string(buf))
+}
+
+// genFromString generates C source code for the given µC source code.
+func genFromString(input string) (string, error) {
+	s := scanner.NewFromString(input)
+	p := parser.NewParser()
+	f, err := p.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	file := f.(*ast.File)
+	info, err := sem.Check(file)
+	if err != nil {
+		return "", err
+	}
+	return cgen.Gen(file, info)
+}
@@ -6,6 +6,8 @@
 //
 // If FILE is -, read standard input.
 //
+//   -g
+//        generate debug information
 //   -gocc-lexer
 //        use Gocc generated lexer
 //   -no-colors
@@ -27,16 +29,9 @@ import (
 
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewkiz/pkg/goutil"
-	"github.com/mewkiz/pkg/ioutilx"
-	"github.com/mewmew/uc/ast"
-	goccerrors "github.com/mewmew/uc/gocc/errors"
-	"github.com/mewmew/uc/gocc/parser"
-	goccscanner "github.com/mewmew/uc/gocc/scanner"
-	handscanner "github.com/mewmew/uc/hand/scanner"
-	"github.com/mewmew/uc/irgen"
-	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
 	semerrors "github.com/mewmew/uc/sem/errors"
-	"github.com/mewmew/uc/sem/semcheck"
 )
 
 func usage() {
@@ -58,10 +53,13 @@ func main() {
 		noColors bool
 		// outputPath specifies the output path for the generated LLVM IR.
 		outputPath string
+		// opts specifies the compiler options.
+		opts = &driver.Options{Output: driver.LLVM}
 	)
+	flag.BoolVar(&opts.DebugInfo, "g", false, "generate debug information")
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
 	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
-	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.BoolVar(&opts.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
 	flag.StringVar(&outputPath, "o", "a.out", "output path")
 	flag.Usage = usage
 	flag.Parse()
@@ -70,73 +68,33 @@ func main() {
 		flag.Usage()
 		os.Exit(1)
 	}
-	// TODO: Remove once nested functions are supported. For now, disallow during
-	// semantic analysis.
-	semcheck.NoNestedFunctions = true
+	if goccLexer {
+		opts.Lexer = driver.GoccLexer
+	}
 
 	// Parse input.
 	for _, path := range flag.Args() {
-		err := compileFile(path, outputPath, goccLexer)
+		err := compileFile(path, outputPath, opts)
 		if err != nil {
-			log.Fatal(err)
+			log.Print(err)
+			os.Exit(run.ExitStatus(err))
 		}
 	}
 }
 
-// checkFile performs a static semantic analysis check on the given file.
-func compileFile(path string, outputPath string, goccLexer bool) error {
-	// Lexical analysis
-	// Syntactic analysis
-	// Semantic analysis
-	// Intermediate representation generation
-
-	// Create lexer for the input.
-	buf, err := ioutilx.ReadFile(path)
-	if err != nil {
-		return errutil.Err(err)
-	}
+// compileFile compiles the given file, and links the corresponding LLVM IR
+// with the runtime library into a binary at outputPath.
+func compileFile(path string, outputPath string, opts *driver.Options) error {
+	name := path
 	if path == "-" {
-		path = "<stdin>"
-	}
-
-	fmt.Fprintf(os.Stderr, "Compiling %q\n", path)
-
-	var s parser.Scanner
-	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
-	} else {
-		s = handscanner.NewFromBytes(buf)
+		name = "<stdin>"
 	}
-
-	// Parse input.
-	p := parser.NewParser()
-	f, err := p.Parse(s)
-	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
-		}
-		return errutil.Err(err)
-	}
-	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
-	info, err := sem.Check(file)
+	fmt.Fprintf(os.Stderr, "Compiling %q\n", name)
+	result, err := driver.Compile(path, opts)
 	if err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
-		}
-		return errutil.Err(err)
+		return err
 	}
 
-	// Generate LLVM IR module based on the syntax tree of the given file.
-	module := irgen.Gen(file, info)
-
 	// Add path to uc lib.
 	lib, err := goutil.SrcDir("github.com/mewmew/uc/testdata")
 	if err != nil {
@@ -145,8 +103,12 @@ func compileFile(path string, outputPath string, goccLexer bool) error {
 	lib = filepath.Join(lib, "uc.ll")
 
 	// Link and create binary through clang
-	clang := exec.Command("clang", "-o", outputPath, "-x", "ir", lib, "-")
-	clang.Stdin = strings.NewReader(module.String())
+	args := []string{"-o", outputPath, "-x", "ir", lib, "-"}
+	if opts.DebugInfo {
+		args = append([]string{"-g"}, args...)
+	}
+	clang := exec.Command("clang", args...)
+	clang.Stdin = strings.NewReader(result.Output)
 	clang.Stderr = os.Stderr
 	clang.Stdout = os.Stdout
 	if err := clang.Run(); err != nil {
@@ -5,16 +5,32 @@
 //
 // If FILE is -, read standard input.
 //
+//   -O0
+//        disable optimizations (default)
+//   -O1
+//        enable optimizations
 //   -debug
 //        enable debug output
+//   -dot-cfg
+//        print control flow graphs of functions as DOT graphs
+//   -g
+//        generate debug information
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -j int
+//        number of input files processed in parallel (default number of CPUs)
+//   -k
+//        keep going after failed input files, and summarise failures
 //   -no-colors
 //        disable colors in output
 //   -no-nested-functions
 //        disable support for nested functions
 //   -o string
 //        output path
+//   -print-after-all
+//        print LLVM IR to standard error after each optimization pass
+//   -ssa
+//        map scalar local variables to SSA values
 package main
 
 import (
@@ -23,19 +39,14 @@ import (
 	"io"
 	"log"
 	"os"
+	"runtime"
 
 	"github.com/kr/pretty"
 	"github.com/mewkiz/pkg/errutil"
-	"github.com/mewkiz/pkg/ioutilx"
-	"github.com/mewmew/uc/ast"
-	goccerrors "github.com/mewmew/uc/gocc/errors"
-	"github.com/mewmew/uc/gocc/parser"
-	goccscanner "github.com/mewmew/uc/gocc/scanner"
-	handscanner "github.com/mewmew/uc/hand/scanner"
-	"github.com/mewmew/uc/irgen"
-	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/dot"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
 	semerrors "github.com/mewmew/uc/sem/errors"
-	"github.com/mewmew/uc/sem/semcheck"
 )
 
 func usage() {
@@ -48,8 +59,27 @@ If FILE is -, read standard input.
 	flag.PrintDefaults()
 }
 
-// debug specifies whether to enable debug output.
-var debug bool
+var (
+	// debug specifies whether to enable debug output.
+	debug bool
+	// dotCFG specifies whether to print control flow graphs as DOT graphs.
+	dotCFG bool
+	// optLevel specifies the optimization level.
+	optLevel int
+)
+
+// levelFlag is a boolean flag which sets the optimization level; e.g. -O1.
+type levelFlag int
+
+func (l levelFlag) String() string   { return "false" }
+func (l levelFlag) IsBoolFlag() bool { return true }
+
+func (l levelFlag) Set(s string) error {
+	if s == "true" {
+		optLevel = int(l)
+	}
+	return nil
+}
 
 func main() {
 	var (
@@ -58,14 +88,31 @@ func main() {
 		goccLexer bool
 		// noColors specifies whether to disable colors in output.
 		noColors bool
+		// jobs specifies the number of input files processed in parallel.
+		jobs int
+		// keepGoing specifies whether to keep going after failed input files.
+		keepGoing bool
 		// outputPath specifies the output path for the generated LLVM IR.
 		outputPath string
+		// printAfterAll specifies whether to print LLVM IR after each
+		// optimization pass.
+		printAfterAll bool
+		// opts specifies the compiler options.
+		opts = &driver.Options{Output: driver.LLVM}
 	)
+	flag.Var(levelFlag(0), "O0", "disable optimizations (default)")
+	flag.Var(levelFlag(1), "O1", "enable optimizations")
 	flag.BoolVar(&debug, "debug", false, "enable debug output")
+	flag.BoolVar(&dotCFG, "dot-cfg", false, "print control flow graphs of functions as DOT graphs")
+	flag.BoolVar(&opts.DebugInfo, "g", false, "generate debug information")
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "number of input files processed in parallel")
+	flag.BoolVar(&keepGoing, "k", false, "keep going after failed input files, and summarise failures")
 	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
-	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.BoolVar(&opts.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
 	flag.StringVar(&outputPath, "o", "", "output path")
+	flag.BoolVar(&printAfterAll, "print-after-all", false, "print LLVM IR to standard error after each optimization pass")
+	flag.BoolVar(&opts.SSA, "ssa", false, "map scalar local variables to SSA values")
 	flag.Usage = usage
 	flag.Parse()
 	semerrors.UseColor = !noColors
@@ -73,87 +120,69 @@ func main() {
 		flag.Usage()
 		os.Exit(1)
 	}
-	// TODO: Remove once nested functions are supported. For now, disallow during
-	// semantic analysis.
-	semcheck.NoNestedFunctions = true
-
+	if goccLexer {
+		opts.Lexer = driver.GoccLexer
+	}
+	opts.OptLevel = optLevel
+	if printAfterAll {
+		opts.PrintAfter = os.Stderr
+	}
 	// Parse input.
-	output := os.Stdout
+	r := &run.Runner{
+		Process: func(path string, stdout, stderr io.Writer) error {
+			return compileFile(path, stdout, stderr, opts)
+		},
+		KeepGoing: keepGoing,
+		Jobs:      jobs,
+	}
 	if len(outputPath) > 0 {
-		var err error
-		output, err = os.Create(outputPath)
+		output, err := os.Create(outputPath)
 		if err != nil {
-			log.Fatal(errutil.Err(err))
+			log.Print(errutil.Err(err))
+			os.Exit(run.ExitIO)
 		}
-		defer output.Close()
-	}
-	for _, path := range flag.Args() {
-		err := compileFile(path, output, goccLexer)
-		if err != nil {
-			log.Fatal(err)
+		r.Stdout = output
+		status := r.Run(flag.Args())
+		if err := output.Close(); err != nil && status == run.ExitSuccess {
+			log.Print(errutil.Err(err))
+			status = run.ExitIO
 		}
+		os.Exit(status)
 	}
+	os.Exit(r.Run(flag.Args()))
 }
 
-// checkFile performs a static semantic analysis check on the given file.
-func compileFile(path string, output io.Writer, goccLexer bool) error {
-	// Lexical analysis
-	// Syntactic analysis
-	// Semantic analysis
-	// Intermediate representation generation
-
-	// Create lexer for the input.
-	buf, err := ioutilx.ReadFile(path)
-	if err != nil {
-		return errutil.Err(err)
-	}
+// compileFile compiles the given file, and writes the corresponding LLVM IR
+// assembly to stdout. Warnings of semantic analysis are reported to stderr.
+func compileFile(path string, stdout, stderr io.Writer, opts *driver.Options) error {
+	name := path
 	if path == "-" {
-		path = "<stdin>"
+		name = "<stdin>"
 	}
-
-	fmt.Fprintf(os.Stderr, "Compiling %q\n", path)
-
-	var s parser.Scanner
-	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
-	} else {
-		s = handscanner.NewFromBytes(buf)
+	fmt.Fprintf(stderr, "Compiling %q\n", name)
+	if opts.PrintAfter != nil {
+		// Print LLVM IR after each optimization pass to the standard error of
+		// the input file.
+		o := *opts
+		o.PrintAfter = stderr
+		opts = &o
 	}
-
-	// Parse input.
-	p := parser.NewParser()
-	f, err := p.Parse(s)
+	result, err := driver.Compile(path, opts)
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
-		}
-		return errutil.Err(err)
+		return err
 	}
-	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
-	info, err := sem.Check(file)
-	if err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
-		}
-		return errutil.Err(err)
+	for _, warning := range result.Info.Warnings {
+		fmt.Fprintln(stderr, warning)
 	}
-
-	// Generate LLVM IR module based on the syntax tree of the given file.
-	module := irgen.Gen(file, info)
-	if _, err := fmt.Fprint(output, module); err != nil {
+	if dotCFG {
+		if err := dot.CFG(stdout, result.Module); err != nil {
+			return errutil.Err(err)
+		}
+	} else if _, err := fmt.Fprint(stdout, result.Output); err != nil {
 		return errutil.Err(err)
 	}
 	if debug {
-		pretty.Println(module)
+		pretty.Fprintf(stdout, "%# v\n", result.Module)
 	}
-
 	return nil
 }
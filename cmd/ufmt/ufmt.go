@@ -0,0 +1,173 @@
+// ufmt is a formatter for the µC language which prints source files in
+// canonical format to standard output.
+//
+// Usage: ufmt [OPTION]... FILE...
+//
+// If FILE is -, read standard input.
+//
+//   -d
+//        display diffs instead of rewriting files
+//   -j int
+//        number of input files processed in parallel (default number of CPUs)
+//   -k
+//        keep going after failed input files, and summarise failures
+//   -l
+//        list files whose formatting differs from ufmt's
+//   -w
+//        write result to (source) file instead of standard output
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewmew/uc/driver/run"
+	"github.com/mewmew/uc/format"
+)
+
+func usage() {
+	const use = `
+Usage: ufmt [OPTION]... FILE...
+
+If FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+// Output modes.
+var (
+	// diff specifies whether to display diffs instead of rewriting files.
+	diff bool
+	// list specifies whether to list files whose formatting differs.
+	list bool
+	// write specifies whether to write the result to the source file.
+	write bool
+)
+
+func main() {
+	var (
+		// jobs specifies the number of input files processed in parallel.
+		jobs int
+		// keepGoing specifies whether to keep going after failed input files.
+		keepGoing bool
+	)
+	flag.BoolVar(&diff, "d", false, "display diffs instead of rewriting files")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "number of input files processed in parallel")
+	flag.BoolVar(&keepGoing, "k", false, "keep going after failed input files, and summarise failures")
+	flag.BoolVar(&list, "l", false, "list files whose formatting differs from ufmt's")
+	flag.BoolVar(&write, "w", false, "write result to (source) file instead of standard output")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	// Format input.
+	r := &run.Runner{
+		Process:   formatFile,
+		KeepGoing: keepGoing,
+		Jobs:      jobs,
+	}
+	os.Exit(r.Run(flag.Args()))
+}
+
+// formatFile formats the given file, and either prints the result to stdout,
+// lists the file, displays a diff or writes the result to the file, as
+// specified by the output mode.
+func formatFile(path string, stdout, stderr io.Writer) error {
+	name := path
+	if path == "-" {
+		name = "<stdin>"
+		if write {
+			return errutil.Newf("unable to write result of standard input")
+		}
+	}
+	src, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return &run.Error{Stage: run.StageRead, Err: errutil.Err(err)}
+	}
+	res, err := format.Source(src)
+	if err != nil {
+		return &run.Error{Stage: run.StageParse, Err: errutil.Newf("%s: %v", name, err)}
+	}
+	if !list && !write && !diff {
+		if _, err := stdout.Write(res); err != nil {
+			return errutil.Err(err)
+		}
+		return nil
+	}
+	if bytes.Equal(src, res) {
+		return nil
+	}
+	if list {
+		fmt.Fprintln(stdout, name)
+	}
+	if write {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		if err := ioutil.WriteFile(path, res, fi.Mode().Perm()); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	if diff {
+		data, err := diffSource(name, src, res)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		if _, err := stdout.Write(data); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	return nil
+}
+
+// diffSource returns the unified diff between the original and the formatted
+// source of the given file, as computed by diff.
+func diffSource(name string, src, res []byte) ([]byte, error) {
+	f1, err := writeTemp(src)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	defer os.Remove(f1)
+	f2, err := writeTemp(res)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	defer os.Remove(f2)
+	cmd := exec.Command("diff", "-u", "-L", name+".orig", "-L", name, f1, f2)
+	data, err := cmd.Output()
+	if len(data) > 0 {
+		// diff exits with status 1 if the files differ.
+		return data, nil
+	}
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return data, nil
+}
+
+// writeTemp writes the given data to a temporary file, and returns its path.
+func writeTemp(data []byte) (string, error) {
+	f, err := ioutil.TempFile("", "ufmt")
+	if err != nil {
+		return "", errutil.Err(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		os.Remove(f.Name())
+		return "", errutil.Err(err)
+	}
+	return f.Name(), nil
+}
@@ -7,6 +7,10 @@
 //
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -j int
+//        number of input files processed in parallel (default number of CPUs)
+//   -k
+//        keep going after failed input files, and summarise failures
 //   -n int
 //        number of tokens to lex
 package main
@@ -14,14 +18,16 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"math"
 	"os"
+	"runtime"
 
 	"github.com/mewkiz/pkg/errutil"
-	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
 	gocctoken "github.com/mewmew/uc/gocc/token"
-	"github.com/mewmew/uc/hand/lexer"
 	"github.com/mewmew/uc/token"
 )
 
@@ -43,8 +49,14 @@ func main() {
 		goccLexer bool
 		// n specifies the number of tokens to lex.
 		n int
+		// jobs specifies the number of input files processed in parallel.
+		jobs int
+		// keepGoing specifies whether to keep going after failed input files.
+		keepGoing bool
 	)
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "number of input files processed in parallel")
+	flag.BoolVar(&keepGoing, "k", false, "keep going after failed input files, and summarise failures")
 	flag.IntVar(&n, "n", 0, "number of tokens to lex")
 	flag.Usage = usage
 	flag.Parse()
@@ -54,32 +66,52 @@ func main() {
 	}
 
 	// Lex input.
-	lexFile := lexFileHand
+	opts := &driver.Options{Output: driver.Tokens}
 	if goccLexer {
-		lexFile = lexFileGocc
+		opts.Lexer = driver.GoccLexer
 	}
-	for _, path := range flag.Args() {
-		if err := lexFile(path, n); err != nil {
-			log.Print(err)
-		}
+	r := &run.Runner{
+		Process: func(path string, stdout, stderr io.Writer) error {
+			return lexFile(path, n, stdout, stderr, opts)
+		},
+		KeepGoing: keepGoing,
+		Jobs:      jobs,
 	}
+	os.Exit(r.Run(flag.Args()))
 }
 
-// lexFileHand lexes the given file and pretty-prints the n first tokens to
-// standard output, using the hand-written lexer.
-func lexFileHand(path string, n int) (err error) {
-	var toks []token.Token
+// lexFile lexes the given file and pretty-prints the n first tokens to stdout.
+// Lexical errors are reported as syntax errors.
+func lexFile(path string, n int, stdout, stderr io.Writer, opts *driver.Options) error {
+	name := path
 	if path == "-" {
-		fmt.Fprintln(os.Stderr, "Lexing from standard input")
-		toks, err = lexer.Parse(os.Stdin)
+		name = "<stdin>"
+		fmt.Fprintln(stderr, "Lexing from standard input")
 	} else {
-		fmt.Fprintf(os.Stderr, "Lexing %q\n", path)
-		toks, err = lexer.ParseFile(path)
+		fmt.Fprintf(stderr, "Lexing %q\n", path)
 	}
+	result, err := driver.Compile(path, opts)
 	if err != nil {
-		return errutil.Err(err)
+		return err
+	}
+	var nerrs int
+	if opts.Lexer == driver.GoccLexer {
+		nerrs = printGoccTokens(stdout, stderr, result.GoccTokens, n)
+	} else {
+		nerrs = printTokens(stdout, stderr, result.Tokens, n)
 	}
+	fmt.Fprintln(stderr)
+	if nerrs > 0 {
+		return &run.Error{Stage: run.StageParse, Err: errutil.Newf("%s: %d lexical errors", name, nerrs)}
+	}
+	return nil
+}
 
+// printTokens pretty-prints the n first tokens of the hand-written lexer to
+// stdout, and returns the number of error tokens.
+func printTokens(stdout, stderr io.Writer, toks []token.Token, n int) int {
+	elog := log.New(stderr, "", 0)
+	nerrs := 0
 	ntoks := len(toks)
 	if n > ntoks {
 		ntoks = n
@@ -91,49 +123,31 @@ func lexFileHand(path string, n int) (err error) {
 		}
 		if tok.Kind == token.Error {
 			elog.Printf("ERROR %*d:   %v\n", pad, i, tok)
+			nerrs++
 		} else {
-			fmt.Printf("token %*d:   %v\n", pad, i, tok)
+			fmt.Fprintf(stdout, "token %*d:   %v\n", pad, i, tok)
 		}
 	}
-	fmt.Fprintln(os.Stderr)
-	return nil
+	return nerrs
 }
 
-// lexFileGocc lexes the given file and pretty-prints the n first tokens to
-// standard output, using the Gocc generated lexer.
-func lexFileGocc(path string, n int) (err error) {
-	var s scanner.Scanner
-	if path == "-" {
-		fmt.Fprintln(os.Stderr, "Lexing from standard input")
-		s, err = scanner.New(os.Stdin)
-	} else {
-		fmt.Fprintf(os.Stderr, "Lexing %q\n", path)
-		s, err = scanner.Open(path)
-	}
-	if err != nil {
-		return errutil.Err(err)
-	}
-
-	for i := 0; ; i++ {
+// printGoccTokens pretty-prints the n first tokens of the Gocc generated lexer
+// to stdout, and returns the number of invalid tokens.
+func printGoccTokens(stdout, stderr io.Writer, toks []*gocctoken.Token, n int) int {
+	elog := log.New(stderr, "", 0)
+	nerrs := 0
+	for i, tok := range toks {
 		if n != 0 && i == n {
 			break
 		}
-		tok := s.Scan()
 		if tok.Type == gocctoken.INVALID {
 			elog.Printf("ERROR %d:   %#v\n", i, tok)
-			fmt.Printf("   lit: %q\n", string(tok.Lit))
+			fmt.Fprintf(stdout, "   lit: %q\n", string(tok.Lit))
+			nerrs++
 		} else {
-			fmt.Printf("token %d:    %#v\n", i, tok)
-			fmt.Printf("   lit: %q\n", string(tok.Lit))
-		}
-		if tok.Type == gocctoken.EOF {
-			break
+			fmt.Fprintf(stdout, "token %d:    %#v\n", i, tok)
+			fmt.Fprintf(stdout, "   lit: %q\n", string(tok.Lit))
 		}
 	}
-	fmt.Fprintln(os.Stderr)
-	return nil
+	return nerrs
 }
-
-// elog represents a logger with no prefix or flags, which logs errors to
-// standard error.
-var elog = log.New(os.Stderr, "", 0)
@@ -0,0 +1,135 @@
+// ulint is a static analyzer for the µC language which reports suspicious
+// constructs of the input to standard output.
+//
+// Usage: ulint [OPTION]... FILE...
+//
+// If FILE is -, read standard input.
+//
+//   -checks string
+//        comma-separated list of checks to run (default all checks)
+//   -gocc-lexer
+//        use Gocc generated lexer
+//   -j int
+//        number of input files processed in parallel (default number of CPUs)
+//   -k
+//        keep going after failed input files, and summarise failures
+//   -list
+//        list checks and exit
+//   -no-colors
+//        disable colors in output
+//   -no-nested-functions
+//        disable support for nested functions
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
+	"github.com/mewmew/uc/lint"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func usage() {
+	const use = `
+Usage: ulint [OPTION]... FILE...
+
+If FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// checks specifies the comma-separated list of checks to run.
+		checks string
+		// goccLexer specifies whether to use the Gocc generated lexer, instead of
+		// the hand-written lexer.
+		goccLexer bool
+		// list specifies whether to list checks and exit.
+		list bool
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// jobs specifies the number of input files processed in parallel.
+		jobs int
+		// keepGoing specifies whether to keep going after failed input files.
+		keepGoing bool
+		// opts specifies the compiler options.
+		opts = &driver.Options{Output: driver.Sem}
+	)
+	flag.StringVar(&checks, "checks", "", "comma-separated list of checks to run (default all checks)")
+	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "number of input files processed in parallel")
+	flag.BoolVar(&keepGoing, "k", false, "keep going after failed input files, and summarise failures")
+	flag.BoolVar(&list, "list", false, "list checks and exit")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.BoolVar(&opts.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if list {
+		for _, a := range lint.Analyzers {
+			fmt.Printf("%-12s %s\n", a.Name, a.Doc)
+		}
+		return
+	}
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if goccLexer {
+		opts.Lexer = driver.GoccLexer
+	}
+	analyzers := lint.Analyzers
+	if len(checks) > 0 {
+		analyzers = nil
+		for _, name := range strings.Split(checks, ",") {
+			a, ok := lint.Lookup(strings.TrimSpace(name))
+			if !ok {
+				log.Fatalf("invalid check %q; see -list for the available checks", name)
+			}
+			analyzers = append(analyzers, a)
+		}
+	}
+
+	// Analyze input.
+	r := &run.Runner{
+		Process: func(path string, stdout, stderr io.Writer) error {
+			return lintFile(path, stdout, stderr, analyzers, opts)
+		},
+		KeepGoing: keepGoing,
+		Jobs:      jobs,
+	}
+	os.Exit(r.Run(flag.Args()))
+}
+
+// lintFile runs the given checks on the given file, and prints the reported
+// problems to stdout. Files with problems are reported as failed.
+func lintFile(path string, stdout, stderr io.Writer, analyzers []*lint.Analyzer, opts *driver.Options) error {
+	name := path
+	if path == "-" {
+		name = "<stdin>"
+	}
+	fmt.Fprintf(stderr, "Analyzing %q\n", name)
+	result, err := driver.Compile(path, opts)
+	if err != nil {
+		return err
+	}
+	diags := lint.Run(result.File, result.Info, analyzers)
+	for _, diag := range diags {
+		line, col := result.Src.Position(diag.Pos)
+		fmt.Fprintf(stdout, "%s:%d:%d: %s (%s)\n", name, line, col, diag.Message, diag.Check)
+	}
+	if len(diags) > 0 {
+		return errutil.Newf("%s: %d problems", name, len(diags))
+	}
+	return nil
+}
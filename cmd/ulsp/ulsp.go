@@ -0,0 +1,41 @@
+// ulsp is a Language Server Protocol server for the µC language, which
+// communicates with the client (e.g. an editor) over standard input and
+// standard output.
+//
+// Usage: ulsp
+//
+// The server reports syntax and semantic errors as diagnostics, and supports go
+// to definition, hover, document symbols and find references.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mewmew/uc/lsp"
+)
+
+func usage() {
+	const use = `
+Usage: ulsp
+
+Serve the Language Server Protocol over standard input and standard output.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	s := lsp.NewServer(os.Stdin, os.Stdout, os.Stderr)
+	if err := s.Serve(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -5,25 +5,34 @@
 //
 // If FILE is -, read standard input.
 //
+//   -dot
+//        print syntax trees as DOT graphs
+//   -format string
+//        output format (pretty or json) (default "pretty")
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -j int
+//        number of input files processed in parallel (default number of CPUs)
+//   -k
+//        keep going after failed input files, and summarise failures
 package main
 
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"runtime"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/kr/pretty"
 	"github.com/mewkiz/pkg/errutil"
-	"github.com/mewkiz/pkg/ioutilx"
 	"github.com/mewmew/uc/ast"
-	"github.com/mewmew/uc/gocc/errors"
-	"github.com/mewmew/uc/gocc/parser"
-	goccscanner "github.com/mewmew/uc/gocc/scanner"
-	handscanner "github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/ast/astjson"
+	"github.com/mewmew/uc/dot"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
 )
 
 func usage() {
@@ -38,71 +47,128 @@ If FILE is -, read standard input.
 
 func main() {
 	var (
+		// dotAST specifies whether to print syntax trees as DOT graphs.
+		dotAST bool
+		// format specifies the output format.
+		format string
 		// goccLexer specifies whether to use the Gocc generated lexer, instead of
 		// the hand-written lexer.
 		goccLexer bool
+		// jobs specifies the number of input files processed in parallel.
+		jobs int
+		// keepGoing specifies whether to keep going after failed input files.
+		keepGoing bool
+		// opts specifies the compiler options.
+		opts = &driver.Options{Output: driver.AST}
 	)
+	flag.BoolVar(&dotAST, "dot", false, "print syntax trees as DOT graphs")
+	flag.StringVar(&format, "format", "pretty", "output format (pretty or json)")
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "number of input files processed in parallel")
+	flag.BoolVar(&keepGoing, "k", false, "keep going after failed input files, and summarise failures")
 	flag.Usage = usage
 	flag.Parse()
 	if flag.NArg() == 0 {
 		flag.Usage()
 		os.Exit(1)
 	}
+	if goccLexer {
+		opts.Lexer = driver.GoccLexer
+	}
+	switch format {
+	case "pretty":
+	case "json":
+		// Resolve identifiers, to record their declarations in the JSON
+		// encoding.
+		opts.Output = driver.Sem
+	default:
+		log.Fatalf("invalid output format %q; expected pretty or json", format)
+	}
+	if dotAST {
+		if format != "pretty" {
+			log.Fatalf("invalid output format %q; the -dot flag requires the pretty output format", format)
+		}
+		format = "dot"
+	}
 
 	// Parse input.
-	for _, path := range flag.Args() {
-		err := parseFile(path, goccLexer)
-		if err != nil {
-			log.Print(err)
-		}
+	r := &run.Runner{
+		Process: func(path string, stdout, stderr io.Writer) error {
+			return parseFile(path, stdout, stderr, format, opts)
+		},
+		KeepGoing: keepGoing,
+		Jobs:      jobs,
 	}
+	os.Exit(r.Run(flag.Args()))
 }
 
-// parseFile parses the given file and pretty-prints its abstract syntax tree to
-// standard output, optionally using the Gocc generated lexer.
-func parseFile(path string, goccLexer bool) error {
-	// Create lexer for the input.
-	buf, err := ioutilx.ReadFile(path)
-	if err != nil {
-		return errutil.Err(err)
-	}
+// parseFile parses the given file and prints its abstract syntax tree to stdout,
+// in the specified output format.
+func parseFile(path string, stdout, stderr io.Writer, format string, opts *driver.Options) error {
 	if path == "-" {
-		fmt.Fprintln(os.Stderr, "Parsing from standard input")
-	} else {
-		fmt.Fprintf(os.Stderr, "Parsing %q\n", path)
-	}
-	var s parser.Scanner
-	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
+		fmt.Fprintln(stderr, "Parsing from standard input")
 	} else {
-		s = handscanner.NewFromBytes(buf)
+		fmt.Fprintf(stderr, "Parsing %q\n", path)
 	}
-
-	// Parse input.
-	p := parser.NewParser()
-	file, err := p.Parse(s)
+	result, err := driver.Compile(path, opts)
 	if err != nil {
-		if err, ok := err.(*errors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
+		return err
+	}
+	switch format {
+	case "dot":
+		if err := dot.AST(stdout, result.File); err != nil {
+			return errutil.Err(err)
+		}
+		return nil
+	case "json":
+		buf, err := astjson.Marshal(result.File)
+		if err != nil {
+			return errutil.Err(err)
 		}
-		return errutil.Err(err)
+		if _, err := stdout.Write(buf); err != nil {
+			return errutil.Err(err)
+		}
+		return nil
 	}
-	f := file.(*ast.File)
-	for _, decl := range f.Decls {
-		fmt.Println("=== [ Top-level declaration ] ===")
-		fmt.Println()
-		fmt.Printf("decl type: %T\n", decl)
-		fmt.Println()
-		fmt.Println("decl:", decl)
-		fmt.Println()
-		pretty.Print(decl)
-		fmt.Println()
-		spew.Print(decl)
-		fmt.Println()
-		fmt.Println()
+	for _, decl := range result.File.Decls {
+		fmt.Fprintln(stdout, "=== [ Top-level declaration ] ===")
+		fmt.Fprintln(stdout)
+		fmt.Fprintf(stdout, "decl type: %T\n", decl)
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout, "decl:", decl)
+		fmt.Fprintln(stdout)
+		if doc, comment := declComments(decl); doc != nil || comment != nil {
+			if doc != nil {
+				fmt.Fprintf(stdout, "decl doc:\n%s", doc.Text())
+			}
+			if comment != nil {
+				fmt.Fprintf(stdout, "decl comment:\n%s", comment.Text())
+			}
+			fmt.Fprintln(stdout)
+		}
+		pretty.Fprintf(stdout, "%# v", decl)
+		fmt.Fprintln(stdout)
+		spew.Fprint(stdout, decl)
+		fmt.Fprintln(stdout)
+		fmt.Fprintln(stdout)
 	}
 
 	return nil
 }
+
+// declComments returns the documentation and line comment of the given
+// declaration.
+func declComments(decl ast.Decl) (doc, comment *ast.CommentGroup) {
+	switch decl := decl.(type) {
+	case *ast.EnumDecl:
+		return decl.Doc, decl.Comment
+	case *ast.FuncDecl:
+		return decl.Doc, decl.Comment
+	case *ast.TypeDef:
+		return decl.Doc, decl.Comment
+	case *ast.VarDecl:
+		return decl.Doc, decl.Comment
+	default:
+		panic(fmt.Sprintf("support for declaration %T not yet implemented", decl))
+	}
+}
@@ -0,0 +1,87 @@
+// urun is an interpreter for the µC language which validates the input, and
+// executes the program; reading from standard input and writing to standard
+// output. The exit status is the return value of main.
+//
+// Usage: urun [OPTION]... FILE
+//
+// If FILE is -, read the program from standard input.
+//
+//   -gocc-lexer
+//        use Gocc generated lexer
+//   -no-colors
+//        disable colors in output
+//   -no-nested-functions
+//        disable support for nested functions
+//   -ssa
+//        map scalar local variables to SSA values
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
+	"github.com/mewmew/uc/interp"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func usage() {
+	const use = `
+Usage: urun [OPTION]... FILE
+
+If FILE is -, read the program from standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		// goccLexer specifies whether to use the Gocc generated lexer, instead of
+		// the hand-written lexer.
+		goccLexer bool
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// opts specifies the compiler options.
+		opts = &driver.Options{Output: driver.IR}
+	)
+	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.BoolVar(&opts.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.BoolVar(&opts.SSA, "ssa", false, "map scalar local variables to SSA values")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if goccLexer {
+		opts.Lexer = driver.GoccLexer
+	}
+	status, err := runFile(flag.Arg(0), opts)
+	if err != nil {
+		log.Print(err)
+		os.Exit(run.ExitStatus(err))
+	}
+	os.Exit(status)
+}
+
+// runFile executes the given file, and returns the exit status of the program.
+func runFile(path string, opts *driver.Options) (int, error) {
+	result, err := driver.Compile(path, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Execute the program.
+	status, err := interp.Run(result.Module, os.Stdin, os.Stdout)
+	if err != nil {
+		return 0, errutil.Err(err)
+	}
+	return status, nil
+}
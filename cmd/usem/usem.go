@@ -1,12 +1,18 @@
 // usem is a static semantic checker for the µC language which validates the
-// input and reports errors to standard error.
+// input and reports errors and warnings to standard error.
 //
 // Usage: usem [OPTION]... FILE...
 //
 // If FILE is -, read standard input.
 //
+//   -dot-scopes
+//        print lexical scopes as DOT graphs
 //   -gocc-lexer
 //        use Gocc generated lexer
+//   -j int
+//        number of input files processed in parallel (default number of CPUs)
+//   -k
+//        keep going after failed input files, and summarise failures
 //   -no-colors
 //        disable colors in output
 //   -no-nested-functions
@@ -16,19 +22,15 @@ package main
 import (
 	"flag"
 	"fmt"
-	"log"
+	"io"
 	"os"
+	"runtime"
 
 	"github.com/mewkiz/pkg/errutil"
-	"github.com/mewkiz/pkg/ioutilx"
-	"github.com/mewmew/uc/ast"
-	goccerrors "github.com/mewmew/uc/gocc/errors"
-	"github.com/mewmew/uc/gocc/parser"
-	goccscanner "github.com/mewmew/uc/gocc/scanner"
-	handscanner "github.com/mewmew/uc/hand/scanner"
-	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/dot"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
 	semerrors "github.com/mewmew/uc/sem/errors"
-	"github.com/mewmew/uc/sem/semcheck"
 )
 
 func usage() {
@@ -43,15 +45,26 @@ If FILE is -, read standard input.
 
 func main() {
 	var (
+		// dotScopes specifies whether to print lexical scopes as DOT graphs.
+		dotScopes bool
 		// goccLexer specifies whether to use the Gocc generated lexer, instead of
 		// the hand-written lexer.
 		goccLexer bool
 		// noColors specifies whether to disable colors in output.
 		noColors bool
+		// jobs specifies the number of input files processed in parallel.
+		jobs int
+		// keepGoing specifies whether to keep going after failed input files.
+		keepGoing bool
+		// opts specifies the compiler options.
+		opts = &driver.Options{Output: driver.Sem}
 	)
+	flag.BoolVar(&dotScopes, "dot-scopes", false, "print lexical scopes as DOT graphs")
 	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "number of input files processed in parallel")
+	flag.BoolVar(&keepGoing, "k", false, "keep going after failed input files, and summarise failures")
 	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
-	flag.BoolVar(&semcheck.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.BoolVar(&opts.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
 	flag.Usage = usage
 	flag.Parse()
 	semerrors.UseColor = !noColors
@@ -59,22 +72,25 @@ func main() {
 		flag.Usage()
 		os.Exit(1)
 	}
+	if goccLexer {
+		opts.Lexer = driver.GoccLexer
+	}
 
 	// Parse input.
-	for _, path := range flag.Args() {
-		err := checkFile(path, goccLexer)
-		if err != nil {
-			if _, ok := err.(*semerrors.Error); ok {
-				elog.Print(err)
-			} else {
-				log.Print(err)
-			}
-		}
+	r := &run.Runner{
+		Process: func(path string, stdout, stderr io.Writer) error {
+			return checkFile(path, stdout, stderr, dotScopes, opts)
+		},
+		KeepGoing: keepGoing,
+		Jobs:      jobs,
 	}
+	os.Exit(r.Run(flag.Args()))
 }
 
-// checkFile performs a static semantic analysis check on the given file.
-func checkFile(path string, goccLexer bool) error {
+// checkFile performs a static semantic analysis check on the given file, reports
+// its warnings to stderr, and optionally prints its lexical scopes to stdout as
+// a DOT graph.
+func checkFile(path string, stdout, stderr io.Writer, dotScopes bool, opts *driver.Options) error {
 	// Lexical analysis
 	// Syntactic analysis (skip function bodies)
 	// Top-level declarations; used for forward-declarations.
@@ -87,50 +103,22 @@ func checkFile(path string, goccLexer bool) error {
 
 	// Semantic analysis
 
-	// Create lexer for the input.
-	buf, err := ioutilx.ReadFile(path)
-	if err != nil {
-		return errutil.Err(err)
-	}
+	name := path
 	if path == "-" {
-		path = "<stdin>"
-	}
-	fmt.Fprintf(os.Stderr, "Checking %q\n", path)
-	var s parser.Scanner
-	if goccLexer {
-		s = goccscanner.NewFromBytes(buf)
-	} else {
-		s = handscanner.NewFromBytes(buf)
+		name = "<stdin>"
 	}
-
-	// Parse input.
-	p := parser.NewParser()
-	f, err := p.Parse(s)
+	fmt.Fprintf(stderr, "Checking %q\n", name)
+	result, err := driver.Compile(path, opts)
 	if err != nil {
-		if err, ok := err.(*goccerrors.Error); ok {
-			// Unwrap Gocc error.
-			return parser.NewError(err)
-		}
-		return errutil.Err(err)
+		return err
 	}
-	file := f.(*ast.File)
-	input := string(buf)
-	src := semerrors.NewSource(path, input)
-	if _, err := sem.Check(file); err != nil {
-		if err, ok := err.(*errutil.ErrInfo); ok {
-			// Unwrap errutil error.
-			if err, ok := err.Err.(*semerrors.Error); ok {
-				// Unwrap semantic analysis error, and add input source information.
-				err.Src = src
-				return err
-			}
+	for _, warning := range result.Info.Warnings {
+		fmt.Fprintln(stderr, warning)
+	}
+	if dotScopes {
+		if err := dot.Scopes(stdout, result.File, result.Info); err != nil {
+			return errutil.Err(err)
 		}
-		return errutil.Err(err)
 	}
-
 	return nil
 }
-
-// elog represents a logger with no prefix or flags, which logs errors to
-// standard error.
-var elog = log.New(os.Stderr, "", 0)
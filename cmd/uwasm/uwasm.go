@@ -0,0 +1,132 @@
+// uwasm is compiler for the µC language which validates the input, and prints
+// a corresponding WebAssembly text format module to standard output.
+//
+// Usage: uwasm [OPTION]... FILE...
+//
+// If FILE is -, read standard input.
+//
+//   -debug
+//        enable debug output
+//   -gocc-lexer
+//        use Gocc generated lexer
+//   -j int
+//        number of input files processed in parallel (default number of CPUs)
+//   -k
+//        keep going after failed input files, and summarise failures
+//   -no-colors
+//        disable colors in output
+//   -no-nested-functions
+//        disable support for nested functions
+//   -o string
+//        output path
+//   -ssa
+//        map scalar local variables to SSA values
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime"
+
+	"github.com/kr/pretty"
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func usage() {
+	const use = `
+Usage: uwasm [OPTION]... FILE...
+
+If FILE is -, read standard input.
+`
+	fmt.Fprintln(os.Stderr, use[1:])
+	flag.PrintDefaults()
+}
+
+// debug specifies whether to enable debug output.
+var debug bool
+
+func main() {
+	var (
+		// goccLexer specifies whether to use the Gocc generated lexer, instead of
+		// the hand-written lexer.
+		goccLexer bool
+		// noColors specifies whether to disable colors in output.
+		noColors bool
+		// jobs specifies the number of input files processed in parallel.
+		jobs int
+		// keepGoing specifies whether to keep going after failed input files.
+		keepGoing bool
+		// outputPath specifies the output path for the generated WebAssembly module.
+		outputPath string
+		// opts specifies the compiler options.
+		opts = &driver.Options{Output: driver.WebAssembly}
+	)
+	flag.BoolVar(&debug, "debug", false, "enable debug output")
+	flag.BoolVar(&goccLexer, "gocc-lexer", false, "use Gocc generated lexer")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "number of input files processed in parallel")
+	flag.BoolVar(&keepGoing, "k", false, "keep going after failed input files, and summarise failures")
+	flag.BoolVar(&noColors, "no-colors", false, "disable colors in output")
+	flag.BoolVar(&opts.NoNestedFunctions, "no-nested-functions", false, "disable support for nested functions")
+	flag.StringVar(&outputPath, "o", "", "output path")
+	flag.BoolVar(&opts.SSA, "ssa", false, "map scalar local variables to SSA values")
+	flag.Usage = usage
+	flag.Parse()
+	semerrors.UseColor = !noColors
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if goccLexer {
+		opts.Lexer = driver.GoccLexer
+	}
+	// Parse input.
+	r := &run.Runner{
+		Process: func(path string, stdout, stderr io.Writer) error {
+			return compileFile(path, stdout, stderr, opts)
+		},
+		KeepGoing: keepGoing,
+		Jobs:      jobs,
+	}
+	if len(outputPath) > 0 {
+		output, err := os.Create(outputPath)
+		if err != nil {
+			log.Print(errutil.Err(err))
+			os.Exit(run.ExitIO)
+		}
+		r.Stdout = output
+		status := r.Run(flag.Args())
+		if err := output.Close(); err != nil && status == run.ExitSuccess {
+			log.Print(errutil.Err(err))
+			status = run.ExitIO
+		}
+		os.Exit(status)
+	}
+	os.Exit(r.Run(flag.Args()))
+}
+
+// compileFile compiles the given file, and writes the corresponding WebAssembly
+// text format module to stdout.
+func compileFile(path string, stdout, stderr io.Writer, opts *driver.Options) error {
+	name := path
+	if path == "-" {
+		name = "<stdin>"
+	}
+	fmt.Fprintf(stderr, "Compiling %q\n", name)
+	result, err := driver.Compile(path, opts)
+	if err != nil {
+		return err
+	}
+	if debug {
+		pretty.Fprintf(stdout, "%# v\n", result.Module)
+	}
+	if _, err := fmt.Fprint(stdout, result.Output); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+// Package debuginfo implements the generation of DWARF debug metadata for LLVM
+// IR modules.
+//
+// Debug metadata is attached to the values of the module as it is generated by
+// irgen.GenDebug; DISubprogram and DIGlobalVariableExpression metadata to
+// function and global variable definitions, DILocation metadata to
+// instructions, and DILocalVariable metadata to calls to llvm.dbg.declare for
+// local variables allocated in memory. The compile unit and the module flags
+// are recorded in the !llvm.dbg.cu and !llvm.module.flags named metadata.
+//
+// Specialized metadata nodes (e.g. DILocation) are not yet supported by the ir
+// package of llir/llvm. Metadata attached to values therefore refers to such
+// nodes by metadata ID, and their definitions are written after the LLVM IR
+// assembly of the module by Builder.Write.
+//
+// Local variables mapped directly to SSA values (see irgen.Config) are not
+// described by the debug metadata.
+package debuginfo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
+	irtypes "github.com/llir/llvm/ir/types"
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	uctypes "github.com/mewmew/uc/types"
+)
+
+// A Builder generates the debug metadata of an LLVM IR module.
+type Builder struct {
+	// Module being generated.
+	module *ir.Module
+	// Input source.
+	src *semerrors.Source
+	// Definitions of metadata nodes not supported by the ir package, indexed by
+	// metadata ID; or empty for metadata defined by the module.
+	defs []string
+	// Maps from the definitions of uniqued metadata nodes to their metadata.
+	uniq map[string]*metadata.Metadata
+	// Compile unit of the module.
+	cu *metadata.Metadata
+	// Source file of the compile unit.
+	file *metadata.Metadata
+	// Global variable expressions of the compile unit.
+	globals *metadata.Metadata
+	// Declaration of llvm.dbg.declare; or nil if not yet declared.
+	declare *ir.Function
+}
+
+// NewBuilder returns a new builder of the debug metadata of the given module,
+// based on the line tables of the input source.
+func NewBuilder(module *ir.Module, src *semerrors.Source) *Builder {
+	b := &Builder{
+		module: module,
+		src:    src,
+		uniq:   make(map[string]*metadata.Metadata),
+	}
+
+	// Module flags.
+	module.NamedMetadata["llvm.module.flags"] = &metadata.Named{
+		Name: "llvm.module.flags",
+		Metadata: []*metadata.Metadata{
+			b.moduleFlag("Dwarf Version", 4),
+			b.moduleFlag("Debug Info Version", 3),
+		},
+	}
+
+	// Compile unit.
+	dir, name := ".", src.Path
+	if abs, err := filepath.Abs(src.Path); err == nil && src.Path != "<stdin>" {
+		dir, name = filepath.Dir(abs), filepath.Base(abs)
+	}
+	b.cu = b.newMetadata()
+	b.file = b.node(fmt.Sprintf("!DIFile(filename: %q, directory: %q)", name, dir))
+	b.globals = b.newMetadata()
+	module.Metadata[b.globals.ID] = b.globals
+	b.setDef(b.cu, fmt.Sprintf(`distinct !DICompileUnit(language: DW_LANG_C99, file: %s, producer: "uclang", isOptimized: false, runtimeVersion: 0, emissionKind: FullDebug, globals: %s)`, b.file.Ident(), b.globals.Ident()))
+	module.NamedMetadata["llvm.dbg.cu"] = &metadata.Named{
+		Name:     "llvm.dbg.cu",
+		Metadata: []*metadata.Metadata{b.cu},
+	}
+	return b
+}
+
+// moduleFlag returns the metadata of the module flag with the given name and
+// value. Conflicting values of the module flag are reported as warnings when
+// linking.
+func (b *Builder) moduleFlag(name string, val int64) *metadata.Metadata {
+	const warning = 2
+	flag := b.newMetadata()
+	flag.Nodes = []metadata.Node{
+		&metadata.Value{X: constant.NewInt(warning, irtypes.I32)},
+		&metadata.String{Val: name},
+		&metadata.Value{X: constant.NewInt(val, irtypes.I32)},
+	}
+	b.module.Metadata[flag.ID] = flag
+	return flag
+}
+
+// Func attaches debug metadata to the given function definition, based on its
+// declaration. The subprogram of the function is returned.
+func (b *Builder) Func(f *ir.Function, decl *ast.FuncDecl) *metadata.Metadata {
+	line, _ := b.src.Position(decl.Start())
+	linkageName := ""
+	if name := decl.Name().Name; name != f.Name {
+		// Lambda lifted nested function.
+		linkageName = fmt.Sprintf(" linkageName: %q,", f.Name)
+	}
+	sp := b.distinct(fmt.Sprintf("!DISubprogram(name: %q,%s scope: %s, file: %s, line: %d, type: %s, scopeLine: %d, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: %s)", decl.Name().Name, linkageName, b.file.Ident(), b.file.Ident(), line, b.funcType(decl.Type().(*uctypes.Func)).Ident(), line, b.cu.Ident()))
+	f.Metadata["dbg"] = sp
+	return sp
+}
+
+// Global attaches debug metadata to the given global variable definition, based
+// on its declaration.
+func (b *Builder) Global(global *ir.Global, decl *ast.VarDecl) {
+	line, _ := b.src.Position(decl.Start())
+	v := b.distinct(fmt.Sprintf("!DIGlobalVariable(name: %q, scope: %s, file: %s, line: %d, type: %s, isLocal: false, isDefinition: true)", decl.Name().Name, b.cu.Ident(), b.file.Ident(), line, b.typ(decl.Type())))
+	expr := b.node(fmt.Sprintf("!DIGlobalVariableExpression(var: %s, expr: !DIExpression())", v.Ident()))
+	b.globals.Nodes = append(b.globals.Nodes, expr)
+	global.Metadata["dbg"] = expr
+}
+
+// Declare emits to block a call to llvm.dbg.declare, describing the given local
+// variable of subprogram sp, allocated in memory by alloca. Function parameters
+// are identified by their 1-based argument index arg, which is 0 for local
+// variables.
+func (b *Builder) Declare(block *ir.BasicBlock, alloca *ir.InstAlloca, decl *ast.VarDecl, arg int, sp *metadata.Metadata) *ir.InstCall {
+	line, _ := b.src.Position(decl.Start())
+	argField := ""
+	if arg != 0 {
+		argField = fmt.Sprintf(" arg: %d,", arg)
+	}
+	v := b.node(fmt.Sprintf("!DILocalVariable(name: %q,%s scope: %s, file: %s, line: %d, type: %s)", decl.Name().Name, argField, sp.Ident(), b.file.Ident(), line, b.typ(decl.Type())))
+	call := block.NewCall(b.declareFunc(), &metadata.Value{X: alloca}, v, b.node("!DIExpression()"))
+	AttachLocation(call, b.Location(decl.Start(), sp))
+	return call
+}
+
+// declareFunc returns the declaration of llvm.dbg.declare, adding it to the
+// module on first use.
+func (b *Builder) declareFunc() *ir.Function {
+	if b.declare == nil {
+		param := func() *irtypes.Param {
+			return irtypes.NewParam("", irtypes.Metadata)
+		}
+		b.declare = ir.NewFunction("llvm.dbg.declare", irtypes.Void, param(), param(), param())
+		b.module.AppendFunction(b.declare)
+	}
+	return b.declare
+}
+
+// Location returns the location of the given source code position within the
+// scope of subprogram sp.
+func (b *Builder) Location(pos int, sp *metadata.Metadata) *metadata.Metadata {
+	line, col := b.src.Position(pos)
+	return b.node(fmt.Sprintf("!DILocation(line: %d, column: %d, scope: %s)", line, col, sp.Ident()))
+}
+
+// Def returns the definition of the given metadata node, which is written after
+// the LLVM IR assembly of the module; or an empty string if the metadata node
+// is defined by the module.
+func (b *Builder) Def(md *metadata.Metadata) string {
+	id, err := strconv.Atoi(md.ID)
+	if err != nil || id < 0 || id >= len(b.defs) {
+		return ""
+	}
+	return b.defs[id]
+}
+
+// Write writes the LLVM IR assembly of the module to w, followed by the
+// definitions of the metadata nodes not supported by the ir package.
+func (b *Builder) Write(w io.Writer) error {
+	buf := &bytes.Buffer{}
+	buf.WriteString(b.module.String())
+	for id, def := range b.defs {
+		if len(def) == 0 {
+			// Defined by the module.
+			continue
+		}
+		fmt.Fprintf(buf, "!%d = %s\n", id, def)
+	}
+	if _, err := io.Copy(w, buf); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// funcType returns the debug metadata of the given function type.
+func (b *Builder) funcType(t *uctypes.Func) *metadata.Metadata {
+	types := []string{b.typ(t.Result)}
+	for _, param := range t.Params {
+		if uctypes.IsVoid(param.Type) {
+			break
+		}
+		types = append(types, b.typ(param.Type))
+	}
+	list := b.node(fmt.Sprintf("!{%s}", strings.Join(types, ", ")))
+	return b.node(fmt.Sprintf("!DISubroutineType(types: %s)", list.Ident()))
+}
+
+// typ returns a reference to the debug metadata of the given type; or null for
+// void.
+func (b *Builder) typ(t uctypes.Type) string {
+	switch t := t.(type) {
+	case *uctypes.Basic:
+		switch t.Kind {
+		case uctypes.Int:
+			return b.node(`!DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)`).Ident()
+		case uctypes.Char:
+			return b.node(`!DIBasicType(name: "char", size: 8, encoding: DW_ATE_signed_char)`).Ident()
+		case uctypes.Void:
+			return "null"
+		}
+	case *uctypes.Array:
+		elem := b.typ(t.Elem)
+		if t.Len == 0 {
+			// Array parameters are passed as pointers.
+			return b.node(fmt.Sprintf("!DIDerivedType(tag: DW_TAG_pointer_type, baseType: %s, size: 64)", elem)).Ident()
+		}
+		subrange := b.node(fmt.Sprintf("!DISubrange(count: %d)", t.Len))
+		elems := b.node(fmt.Sprintf("!{%s}", subrange.Ident()))
+		return b.node(fmt.Sprintf("!DICompositeType(tag: DW_TAG_array_type, baseType: %s, size: %d, elements: %s)", elem, sizeInBits(t), elems.Ident())).Ident()
+	case *uctypes.Func:
+		return b.funcType(t).Ident()
+	}
+	panic(fmt.Sprintf("support for type %v not yet implemented", t))
+}
+
+// sizeInBits returns the size in bits of the given type.
+func sizeInBits(t uctypes.Type) int {
+	switch t := t.(type) {
+	case *uctypes.Basic:
+		switch t.Kind {
+		case uctypes.Int:
+			return 32
+		case uctypes.Char:
+			return 8
+		}
+	case *uctypes.Array:
+		if t.Len == 0 {
+			return 64
+		}
+		return t.Len * sizeInBits(t.Elem)
+	}
+	panic(fmt.Sprintf("support for type %v not yet implemented", t))
+}
+
+// newMetadata returns a new metadata node with a unique metadata ID.
+func (b *Builder) newMetadata() *metadata.Metadata {
+	md := &metadata.Metadata{ID: strconv.Itoa(len(b.defs))}
+	b.defs = append(b.defs, "")
+	return md
+}
+
+// setDef sets the definition of the given metadata node, which is not supported
+// by the ir package.
+func (b *Builder) setDef(md *metadata.Metadata, def string) {
+	id, _ := strconv.Atoi(md.ID)
+	b.defs[id] = def
+}
+
+// distinct returns a new distinct metadata node with the given definition.
+func (b *Builder) distinct(def string) *metadata.Metadata {
+	md := b.newMetadata()
+	b.setDef(md, "distinct "+def)
+	return md
+}
+
+// node returns the uniqued metadata node with the given definition, adding it
+// if not yet present.
+func (b *Builder) node(def string) *metadata.Metadata {
+	if md, ok := b.uniq[def]; ok {
+		return md
+	}
+	md := b.newMetadata()
+	b.setDef(md, def)
+	b.uniq[def] = md
+	return md
+}
+
+// HasLocation reports whether the given instruction has a location attached.
+func HasLocation(inst ir.Instruction) bool {
+	_, ok := attachments(inst)["dbg"]
+	return ok
+}
+
+// AttachLocation attaches the given location to inst.
+func AttachLocation(inst ir.Instruction, loc *metadata.Metadata) {
+	attachments(inst)["dbg"] = loc
+}
+
+// attachments returns the metadata attachments of the given instruction or
+// terminator.
+func attachments(inst ir.Instruction) map[string]*metadata.Metadata {
+	switch inst := inst.(type) {
+	// Instructions.
+	case *ir.InstAlloca:
+		return inst.Metadata
+	case *ir.InstLoad:
+		return inst.Metadata
+	case *ir.InstStore:
+		return inst.Metadata
+	case *ir.InstGetElementPtr:
+		return inst.Metadata
+	case *ir.InstAdd:
+		return inst.Metadata
+	case *ir.InstSub:
+		return inst.Metadata
+	case *ir.InstMul:
+		return inst.Metadata
+	case *ir.InstSDiv:
+		return inst.Metadata
+	case *ir.InstSRem:
+		return inst.Metadata
+	case *ir.InstAnd:
+		return inst.Metadata
+	case *ir.InstOr:
+		return inst.Metadata
+	case *ir.InstXor:
+		return inst.Metadata
+	case *ir.InstShl:
+		return inst.Metadata
+	case *ir.InstAShr:
+		return inst.Metadata
+	case *ir.InstICmp:
+		return inst.Metadata
+	case *ir.InstTrunc:
+		return inst.Metadata
+	case *ir.InstZExt:
+		return inst.Metadata
+	case *ir.InstSExt:
+		return inst.Metadata
+	case *ir.InstPhi:
+		return inst.Metadata
+	case *ir.InstSelect:
+		return inst.Metadata
+	case *ir.InstCall:
+		return inst.Metadata
+	// Terminators.
+	case *ir.TermRet:
+		return inst.Metadata
+	case *ir.TermBr:
+		return inst.Metadata
+	case *ir.TermCondBr:
+		return inst.Metadata
+	case *ir.TermUnreachable:
+		return inst.Metadata
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+}
@@ -0,0 +1,73 @@
+package debuginfo_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func TestGenDebug(t *testing.T) {
+	golden := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../testdata/extra/debuginfo/debug.c",
+			want: "../testdata/extra/debuginfo/debug.ll",
+		},
+	}
+
+	for _, g := range golden {
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		s := scanner.NewFromBytes(buf)
+		p := parser.NewParser()
+		f, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("%q: parse error: %v", g.path, err)
+			continue
+		}
+		file := f.(*ast.File)
+		info, err := sem.Check(file)
+		if err != nil {
+			t.Errorf("%q: semantic analysis error: %v", g.path, err)
+			continue
+		}
+		src := semerrors.NewSource(g.path, string(buf))
+		_, debug := irgen.GenDebug(file, info, src, irgen.Config{})
+		buf, err = ioutil.ReadFile(g.want)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		// The directory of the source file depends on the location of the
+		// repository, and is therefore omitted from the gold standard.
+		dir, err := filepath.Abs(filepath.Dir(g.path))
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		out := &bytes.Buffer{}
+		if err := debug.Write(out); err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		got := strings.Replace(out.String(), fmt.Sprintf("directory: %q", dir), `directory: "."`, 1)
+		if want := string(buf); got != want {
+			t.Errorf("%q: module mismatch; expected `%v`, got `%v`", g.path, want, got)
+		}
+	}
+}
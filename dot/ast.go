@@ -0,0 +1,97 @@
+package dot
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/mewmew/uc/ast"
+)
+
+// nodeType is the reflection type of syntax tree nodes.
+var nodeType = reflect.TypeOf((*ast.Node)(nil)).Elem()
+
+// AST writes the syntax tree of the given file to w, as a DOT graph. Nodes are
+// labelled by node type, and edges by field name. The resolved declarations of
+// identifiers and comments are omitted.
+func AST(w io.Writer, file *ast.File) error {
+	g := newGraph(w)
+	g.begin("digraph", "ast")
+	g.printf("node [shape=box fontname=monospace]")
+	t := &treeWriter{graph: g}
+	t.node(file)
+	g.end()
+	return g.flush()
+}
+
+// A treeWriter writes the nodes of a syntax tree.
+type treeWriter struct {
+	*graph
+	// Number of nodes written.
+	n int
+}
+
+// node writes the given node and its children, and returns its node ID.
+func (t *treeWriter) node(n ast.Node) string {
+	id := fmt.Sprintf("n%d", t.n)
+	t.n++
+	t.graph.node(id, nodeLabel(n))
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if _, ok := n.(*ast.Ident); ok && field.Name == "Decl" {
+			// Omit resolved declarations of identifiers, to output trees.
+			continue
+		}
+		f := v.Field(i)
+		if f.Kind() == reflect.Slice {
+			for j := 0; j < f.Len(); j++ {
+				if child, ok := childNode(f.Index(j)); ok {
+					t.edge(id, t.node(child), fmt.Sprintf("%s[%d]", field.Name, j))
+				}
+			}
+			continue
+		}
+		if child, ok := childNode(f); ok {
+			t.edge(id, t.node(child), field.Name)
+		}
+	}
+	return id
+}
+
+// childNode returns the child node of the given field value. The boolean
+// return value indicates whether the field value holds a non-nil node.
+func childNode(f reflect.Value) (ast.Node, bool) {
+	if f.Kind() != reflect.Interface && f.Kind() != reflect.Ptr {
+		return nil, false
+	}
+	if f.IsNil() || !f.Type().Implements(nodeType) {
+		return nil, false
+	}
+	if f.Kind() == reflect.Interface && f.Elem().IsNil() {
+		return nil, false
+	}
+	n, ok := f.Interface().(ast.Node)
+	return n, ok
+}
+
+// nodeLabel returns the label of the given node; its node type, followed by
+// its name, value or operator (if any).
+func nodeLabel(n ast.Node) string {
+	label := reflect.TypeOf(n).Elem().Name()
+	switch n := n.(type) {
+	case *ast.BasicLit:
+		return fmt.Sprintf("%s\n%s", label, n.Val)
+	case *ast.BinaryExpr:
+		return fmt.Sprintf("%s\n%v", label, n.Op)
+	case *ast.Ident:
+		return fmt.Sprintf("%s\n%s", label, n.Name)
+	case *ast.UnaryExpr:
+		return fmt.Sprintf("%s\n%v", label, n.Op)
+	case *ast.ArrayType:
+		if n.Len > 0 {
+			return fmt.Sprintf("%s\n[%d]", label, n.Len)
+		}
+	}
+	return label
+}
@@ -0,0 +1,61 @@
+package dot
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/llir/llvm/ir"
+)
+
+// CFG writes the control flow graphs of the functions of the given LLVM IR
+// module to w, as a DOT graph. Each function definition is output as a cluster
+// subgraph, with nodes for basic blocks and edges for branches. The edges of
+// conditional branches are labelled "true" and "false".
+func CFG(w io.Writer, m *ir.Module) error {
+	g := newGraph(w)
+	g.begin("digraph", "cfg")
+	g.printf("node [shape=box fontname=monospace]")
+	for i, f := range m.Funcs {
+		if len(f.Blocks) == 0 {
+			// Skip function declarations.
+			continue
+		}
+		g.begin("subgraph", fmt.Sprintf("cluster_%d", i))
+		g.printf("label=%s", quote("@"+f.Name))
+		ids := make(map[*ir.BasicBlock]string)
+		for j, block := range f.Blocks {
+			id := fmt.Sprintf("f%d_%d", i, j)
+			ids[block] = id
+			g.node(id, blockLabel(block, j))
+		}
+		for _, block := range f.Blocks {
+			switch term := block.Term.(type) {
+			case *ir.TermRet, *ir.TermUnreachable:
+				// No successors.
+			case *ir.TermBr:
+				g.edge(ids[block], ids[term.Target], "")
+			case *ir.TermCondBr:
+				g.edge(ids[block], ids[term.TargetTrue], "true")
+				g.edge(ids[block], ids[term.TargetFalse], "false")
+			default:
+				panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+			}
+		}
+		g.end()
+	}
+	g.end()
+	return g.flush()
+}
+
+// blockLabel returns the label of the given basic block, at the specified index
+// of its function; its name and number of non-terminator instructions.
+func blockLabel(block *ir.BasicBlock, index int) string {
+	name := block.Name
+	if len(name) == 0 {
+		// Unnamed basic blocks are assigned IDs when printed.
+		name = fmt.Sprintf("block %d", index)
+	} else {
+		name = "%" + name
+	}
+	return fmt.Sprintf("%s\ninsts: %d", name, len(block.Insts))
+}
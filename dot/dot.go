@@ -0,0 +1,79 @@
+// Package dot implements output of µC syntax trees, lexical scopes and LLVM IR
+// control flow graphs in the DOT graph description language of Graphviz.
+//
+// The output may be rendered using the dot tool of Graphviz.
+//
+//    dot -Tpng -o foo.png foo.dot
+package dot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// A graph is a DOT graph writer.
+type graph struct {
+	// Output writer.
+	w *bufio.Writer
+	// Indentation level.
+	indent int
+}
+
+// newGraph returns a new DOT graph writer, writing to w.
+func newGraph(w io.Writer) *graph {
+	return &graph{w: bufio.NewWriter(w)}
+}
+
+// printf writes the given formatted line, preceded by indentation.
+func (g *graph) printf(format string, a ...interface{}) {
+	g.w.WriteString(strings.Repeat("\t", g.indent))
+	fmt.Fprintf(g.w, format, a...)
+	g.w.WriteString("\n")
+}
+
+// begin writes the start of a graph or subgraph, preceded by the given
+// keyword (e.g. "digraph").
+func (g *graph) begin(keyword, name string) {
+	g.printf("%s %s {", keyword, quote(name))
+	g.indent++
+}
+
+// end writes the end of a graph or subgraph.
+func (g *graph) end() {
+	g.indent--
+	g.printf("}")
+}
+
+// node writes the node of the given ID, label and additional attributes.
+func (g *graph) node(id, label string, attrs ...string) {
+	g.printf("%s [%s]", quote(id), strings.Join(append([]string{"label=" + quote(label)}, attrs...), " "))
+}
+
+// edge writes an edge between the nodes of the given IDs, with the given label
+// (if any).
+func (g *graph) edge(from, to, label string) {
+	if len(label) == 0 {
+		g.printf("%s -> %s", quote(from), quote(to))
+		return
+	}
+	g.printf("%s -> %s [label=%s]", quote(from), quote(to), quote(label))
+}
+
+// flush flushes the output of the graph writer.
+func (g *graph) flush() error {
+	if err := g.w.Flush(); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// quote returns s as a double-quoted DOT string. Newlines are converted to
+// centered line breaks.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
@@ -0,0 +1,115 @@
+package dot_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mewmew/uc/dot"
+	"github.com/mewmew/uc/driver"
+)
+
+func TestAST(t *testing.T) {
+	const src = "int x;\nint f(void) { return -x + 1; }\n"
+	const want = `digraph "ast" {
+	node [shape=box fontname=monospace]
+	"n0" [label="File"]
+	"n1" [label="VarDecl"]
+	"n2" [label="Ident\nint"]
+	"n1" -> "n2" [label="VarType"]
+	"n3" [label="Ident\nx"]
+	"n1" -> "n3" [label="VarName"]
+	"n0" -> "n1" [label="Decls[0]"]
+	"n4" [label="FuncDecl"]
+	"n5" [label="FuncType"]
+	"n6" [label="Ident\nint"]
+	"n5" -> "n6" [label="Result"]
+	"n7" [label="VarDecl"]
+	"n8" [label="Ident\nvoid"]
+	"n7" -> "n8" [label="VarType"]
+	"n5" -> "n7" [label="Params[0]"]
+	"n4" -> "n5" [label="FuncType"]
+	"n9" [label="Ident\nf"]
+	"n4" -> "n9" [label="FuncName"]
+	"n10" [label="BlockStmt"]
+	"n11" [label="ReturnStmt"]
+	"n12" [label="BinaryExpr\n+"]
+	"n13" [label="UnaryExpr\n-"]
+	"n14" [label="Ident\nx"]
+	"n13" -> "n14" [label="X"]
+	"n12" -> "n13" [label="X"]
+	"n15" [label="BasicLit\n1"]
+	"n12" -> "n15" [label="Y"]
+	"n11" -> "n12" [label="Result"]
+	"n10" -> "n11" [label="Items[0]"]
+	"n4" -> "n10" [label="Body"]
+	"n0" -> "n4" [label="Decls[1]"]
+}
+`
+	result, err := driver.CompileSource("foo.c", []byte(src), &driver.Options{Output: driver.AST})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := dot.AST(buf, result.File); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected `%s`, got `%s`", want, got)
+	}
+}
+
+func TestScopes(t *testing.T) {
+	const src = "enum e { A };\nint f(int x) { { char y; } return x; }\n"
+	const want = `digraph "scopes" {
+	node [shape=box fontname=monospace]
+	"s0" [label="universe\nchar: char\nint: int\nvoid: void"]
+	"s1" [label="file\nA: int\nf: int(int x)\nenum e"]
+	"s0" -> "s1"
+	"s2" [label="function f\nx: int"]
+	"s1" -> "s2"
+	"s3" [label="block at offset 29\ny: char"]
+	"s2" -> "s3"
+}
+`
+	result, err := driver.CompileSource("foo.c", []byte(src), &driver.Options{Output: driver.Sem})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := dot.Scopes(buf, result.File, result.Info); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected `%s`, got `%s`", want, got)
+	}
+}
+
+func TestCFG(t *testing.T) {
+	const src = "int f(int x) { while (x) x = x - 1; return x; }\n"
+	const want = `digraph "cfg" {
+	node [shape=box fontname=monospace]
+	subgraph "cluster_0" {
+		label="@f"
+		"f0_0" [label="%0\ninsts: 2"]
+		"f0_1" [label="%2\ninsts: 2"]
+		"f0_2" [label="%5\ninsts: 3"]
+		"f0_3" [label="%8\ninsts: 1"]
+		"f0_0" -> "f0_1"
+		"f0_1" -> "f0_2" [label="true"]
+		"f0_1" -> "f0_3" [label="false"]
+		"f0_2" -> "f0_1"
+	}
+}
+`
+	result, err := driver.CompileSource("foo.c", []byte(src), &driver.Options{Output: driver.LLVM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := dot.CFG(buf, result.Module); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("output mismatch; expected `%s`, got `%s`", want, got)
+	}
+}
@@ -0,0 +1,104 @@
+package dot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem"
+)
+
+// Scopes writes the lexical scopes of the given file to w, as a DOT graph.
+// Nodes list the declarations of scopes, and edges lead from outer scopes to
+// their immediately nested scopes. The universe scope holds the predeclared
+// types.
+func Scopes(w io.Writer, file *ast.File, info *sem.Info) error {
+	// Sort scopes by the start position of their defining nodes, so that
+	// outer scopes precede nested scopes.
+	var nodes []ast.Node
+	for n := range info.Scopes {
+		nodes = append(nodes, n)
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if _, ok := nodes[i].(*ast.File); ok {
+			return true
+		}
+		if _, ok := nodes[j].(*ast.File); ok {
+			return false
+		}
+		return nodes[i].Start() < nodes[j].Start()
+	})
+	g := newGraph(w)
+	g.begin("digraph", "scopes")
+	g.printf("node [shape=box fontname=monospace]")
+	ids := make(map[*sem.Scope]string)
+	// scopeID returns the node ID of the given scope, writing the node of the
+	// scope the first time it is encountered.
+	var scopeID func(scope *sem.Scope, title string) string
+	scopeID = func(scope *sem.Scope, title string) string {
+		if id, ok := ids[scope]; ok {
+			return id
+		}
+		id := fmt.Sprintf("s%d", len(ids))
+		ids[scope] = id
+		g.node(id, scopeLabel(title, scope))
+		return id
+	}
+	for _, n := range nodes {
+		scope := info.Scopes[n]
+		var outer string
+		if scope.Outer != nil {
+			title := "universe"
+			if scope.Outer.Outer != nil {
+				title = "scope"
+			}
+			outer = scopeID(scope.Outer, title)
+		}
+		id := scopeID(scope, scopeTitle(n))
+		if len(outer) > 0 {
+			g.edge(outer, id, "")
+		}
+	}
+	g.end()
+	return g.flush()
+}
+
+// scopeTitle returns the title of the scope defined by the given node.
+func scopeTitle(n ast.Node) string {
+	switch n := n.(type) {
+	case *ast.File:
+		return "file"
+	case *ast.FuncDecl:
+		return fmt.Sprintf("function %s", n.Name())
+	case *ast.BlockStmt:
+		return fmt.Sprintf("block at offset %d", n.Lbrace)
+	default:
+		panic(fmt.Sprintf("support for scope of node %T not yet implemented", n))
+	}
+}
+
+// scopeLabel returns the label of the given scope; its title, followed by its
+// declarations and enumeration tags, sorted by name.
+func scopeLabel(title string, scope *sem.Scope) string {
+	buf := &bytes.Buffer{}
+	buf.WriteString(title)
+	var names []string
+	for name := range scope.Decls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(buf, "\n%s: %v", name, scope.Decls[name].Type())
+	}
+	var tags []string
+	for tag := range scope.Tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		fmt.Fprintf(buf, "\nenum %s", tag)
+	}
+	return buf.String()
+}
@@ -0,0 +1,277 @@
+// Package driver implements a compiler driver for the µC language, which runs
+// the stages of compilation (lexical analysis, syntactic analysis, semantic
+// analysis, LLVM IR generation, optimization and code generation) on a source
+// file.
+//
+// The driver is shared by the command line tools, and may be used to embed the
+// compiler in other tools.
+//
+//    result, err := driver.Compile("foo.c", &driver.Options{Output: driver.LLVM})
+//    if err != nil {
+//       // handle error.
+//    }
+//    fmt.Print(result.Output)
+package driver
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"log"
+
+	"github.com/llir/llvm/ir"
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/ioutilx"
+	"github.com/mewkiz/pkg/term"
+	"github.com/mewmew/uc/amd64"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/debuginfo"
+	"github.com/mewmew/uc/driver/run"
+	goccerrors "github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/gocc/parser"
+	goccscanner "github.com/mewmew/uc/gocc/scanner"
+	gocctoken "github.com/mewmew/uc/gocc/token"
+	"github.com/mewmew/uc/hand/lexer"
+	handscanner "github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/mips"
+	"github.com/mewmew/uc/opt"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/wasm"
+)
+
+// TODO: Remove debug output.
+
+// dbg is a logger which prefixes debug messages with "driver:".
+var dbg = log.New(ioutil.Discard, term.WhiteBold("driver:"), log.Lshortfile)
+
+// Lexer specifies the lexer used for lexical analysis.
+type Lexer int
+
+// Lexers.
+const (
+	// Hand-written lexer.
+	HandLexer Lexer = iota
+	// Gocc generated lexer.
+	GoccLexer
+)
+
+// Output specifies the output kind of compilation; compilation stops after the
+// stage producing the output.
+type Output int
+
+// Output kinds.
+const (
+	// Tokens of the input; stops after lexical analysis.
+	Tokens Output = iota
+	// Abstract syntax tree; stops after syntactic analysis.
+	AST
+	// Type information; stops after semantic analysis.
+	Sem
+	// LLVM IR module; stops after optimization.
+	IR
+	// LLVM IR assembly.
+	LLVM
+	// MIPS assembly.
+	MIPS
+	// WebAssembly text format module.
+	WebAssembly
+	// x86-64 assembly.
+	AMD64
+)
+
+// MaxOptLevel specifies the highest supported optimization level.
+const MaxOptLevel = 1
+
+// Options specifies the options of compilation.
+type Options struct {
+	// Lexer used for lexical analysis.
+	Lexer Lexer
+	// Reject nested function definitions during semantic analysis.
+	NoNestedFunctions bool
+	// Map scalar local variables to SSA values.
+	SSA bool
+	// Generate debug information; only supported for LLVM IR output kinds.
+	DebugInfo bool
+	// Optimization level, between 0 and MaxOptLevel.
+	OptLevel int
+	// PrintAfter, if non-nil, receives the LLVM IR of the module after each
+	// optimization pass.
+	PrintAfter io.Writer
+	// Output kind.
+	Output Output
+}
+
+// A Result represents the results of the stages of compilation. The results
+// of stages after the output stage are left unset.
+type Result struct {
+	// Input source.
+	Src *semerrors.Source
+	// Tokens of the input; set if the output kind is Tokens and the hand-written
+	// lexer is used.
+	Tokens []token.Token
+	// Tokens of the input; set if the output kind is Tokens and the Gocc
+	// generated lexer is used. The last token is the end of file token.
+	GoccTokens []*gocctoken.Token
+	// Abstract syntax tree of the input.
+	File *ast.File
+	// Type information of the input.
+	Info *sem.Info
+	// LLVM IR module of the input.
+	Module *ir.Module
+	// Debug metadata of the LLVM IR module; set if debug information is
+	// generated.
+	DebugInfo *debuginfo.Builder
+	// Textual output of code generation; LLVM IR assembly, MIPS assembly,
+	// WebAssembly text format or x86-64 assembly.
+	Output string
+}
+
+// Compile compiles the given source file, as specified by opts. If path is -,
+// read standard input.
+//
+// Errors are reported as *run.Error values, holding the stage of compilation in
+// which the error occurred.
+func Compile(path string, opts *Options) (*Result, error) {
+	buf, err := ioutilx.ReadFile(path)
+	if err != nil {
+		return nil, &run.Error{Stage: run.StageRead, Err: errutil.Err(err)}
+	}
+	if path == "-" {
+		path = "<stdin>"
+	}
+	return CompileSource(path, buf, opts)
+}
+
+// CompileSource compiles the given source code, as specified by opts. The path
+// of the source is used in error messages.
+//
+// Errors are reported as *run.Error values, holding the stage of compilation in
+// which the error occurred.
+func CompileSource(path string, buf []byte, opts *Options) (*Result, error) {
+	dbg.Printf("compile %q", path)
+	if opts.OptLevel < 0 || opts.OptLevel > MaxOptLevel {
+		return nil, errutil.Newf("invalid optimization level %d; expected 0 to %d", opts.OptLevel, MaxOptLevel)
+	}
+	if opts.DebugInfo && opts.Output != IR && opts.Output != LLVM {
+		return nil, errutil.Newf("support for debug information of output kind %d not yet implemented", opts.Output)
+	}
+	result := &Result{Src: semerrors.NewSource(path, string(buf))}
+
+	// Lexical analysis.
+	if opts.Output == Tokens {
+		if opts.Lexer == GoccLexer {
+			s := goccscanner.NewFromBytes(buf)
+			for {
+				tok := s.Scan()
+				result.GoccTokens = append(result.GoccTokens, tok)
+				if tok.Type == gocctoken.EOF {
+					break
+				}
+			}
+		} else {
+			toks, err := lexer.Parse(bytes.NewReader(buf))
+			if err != nil {
+				return nil, &run.Error{Stage: run.StageRead, Err: errutil.Err(err)}
+			}
+			result.Tokens = toks
+		}
+		return result, nil
+	}
+	var s parser.Scanner
+	if opts.Lexer == GoccLexer {
+		s = goccscanner.NewFromBytes(buf)
+	} else {
+		s = handscanner.NewFromBytes(buf)
+	}
+
+	// Syntactic analysis.
+	p := parser.NewParser()
+	f, err := p.Parse(s)
+	if err != nil {
+		if err, ok := err.(*goccerrors.Error); ok {
+			// Unwrap Gocc error.
+			return nil, &run.Error{Stage: run.StageParse, Err: parser.NewError(err)}
+		}
+		return nil, &run.Error{Stage: run.StageParse, Err: errutil.Err(err)}
+	}
+	result.File = f.(*ast.File)
+	if cs, ok := s.(astutil.CommentScanner); ok {
+		astutil.AddComments(result.File, string(buf), cs.Comments(), cs.Semicolons())
+	}
+	if opts.Output == AST {
+		return result, nil
+	}
+
+	// Semantic analysis.
+	info, err := sem.CheckWith(result.File, sem.Config{NoNestedFunctions: opts.NoNestedFunctions})
+	if err != nil {
+		if err, ok := err.(*errutil.ErrInfo); ok {
+			// Unwrap errutil error.
+			if err, ok := err.Err.(*semerrors.Error); ok {
+				// Unwrap semantic analysis error, and add input source information.
+				err.Src = result.Src
+				return nil, &run.Error{Stage: run.StageSem, Err: err}
+			}
+		}
+		return nil, &run.Error{Stage: run.StageSem, Err: errutil.Err(err)}
+	}
+	result.Info = info
+	for _, warning := range info.Warnings {
+		// Add input source information to warnings of semantic analysis.
+		warning.Src = result.Src
+	}
+	if opts.Output == Sem {
+		return result, nil
+	}
+
+	// LLVM IR generation.
+	config := irgen.Config{SSA: opts.SSA}
+	if opts.DebugInfo {
+		result.Module, result.DebugInfo = irgen.GenDebug(result.File, info, result.Src, config)
+	} else {
+		result.Module = irgen.GenWith(result.File, info, config)
+	}
+
+	// Optimization.
+	pm := opt.NewManager(opt.Pipeline(opts.OptLevel)...)
+	pm.PrintAfter = opts.PrintAfter
+	if err := pm.Run(result.Module); err != nil {
+		return nil, &run.Error{Stage: run.StageOpt, Err: errutil.Err(err)}
+	}
+
+	if opts.Output == IR {
+		return result, nil
+	}
+
+	// Code generation.
+	var output string
+	switch opts.Output {
+	case LLVM:
+		if result.DebugInfo != nil {
+			buf := &bytes.Buffer{}
+			if err := result.DebugInfo.Write(buf); err != nil {
+				return nil, &run.Error{Stage: run.StageCodegen, Err: errutil.Err(err)}
+			}
+			output = buf.String()
+		} else {
+			output = result.Module.String()
+		}
+	case MIPS:
+		output, err = mips.Gen(result.Module)
+	case WebAssembly:
+		output, err = wasm.Gen(result.Module)
+	case AMD64:
+		output, err = amd64.Gen(result.Module)
+	default:
+		return nil, errutil.Newf("support for output kind %d not yet implemented", opts.Output)
+	}
+	if err != nil {
+		return nil, &run.Error{Stage: run.StageCodegen, Err: errutil.Err(err)}
+	}
+	result.Output = output
+	return result, nil
+}
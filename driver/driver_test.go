@@ -0,0 +1,118 @@
+package driver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func TestCompile(t *testing.T) {
+	var golden = []struct {
+		path string
+		opts *driver.Options
+		// Substring of the output.
+		want string
+	}{
+		{path: "../testdata/noisy/simple/sim01.c", opts: &driver.Options{Output: driver.LLVM}, want: "define i32 @main()"},
+		{path: "../testdata/noisy/simple/sim01.c", opts: &driver.Options{Output: driver.LLVM, SSA: true, OptLevel: 1}, want: "define i32 @main()"},
+		{path: "../testdata/noisy/simple/sim01.c", opts: &driver.Options{Output: driver.LLVM, DebugInfo: true}, want: "!DICompileUnit"},
+		{path: "../testdata/noisy/simple/sim01.c", opts: &driver.Options{Output: driver.MIPS}, want: "main:"},
+		{path: "../testdata/noisy/simple/sim01.c", opts: &driver.Options{Output: driver.WebAssembly}, want: "(module"},
+		{path: "../testdata/noisy/simple/sim01.c", opts: &driver.Options{Output: driver.AMD64}, want: "main:"},
+	}
+
+	for _, g := range golden {
+		result, err := driver.Compile(g.path, g.opts)
+		if err != nil {
+			t.Errorf("%q: unable to compile file; %v", g.path, err)
+			continue
+		}
+		if !strings.Contains(result.Output, g.want) {
+			t.Errorf("%q: output mismatch; expected output containing %q, got %q", g.path, g.want, result.Output)
+		}
+	}
+}
+
+func TestCompileStages(t *testing.T) {
+	var golden = []struct {
+		path   string
+		output driver.Output
+	}{
+		{path: "../testdata/noisy/simple/sim01.c", output: driver.Tokens},
+		{path: "../testdata/noisy/simple/sim01.c", output: driver.AST},
+		{path: "../testdata/noisy/simple/sim01.c", output: driver.Sem},
+		{path: "../testdata/noisy/simple/sim01.c", output: driver.IR},
+		// Input without trailing newline.
+		{path: "../testdata/incorrect/lexer/good.c", output: driver.Tokens},
+	}
+
+	for _, g := range golden {
+		for _, lexer := range []driver.Lexer{driver.HandLexer, driver.GoccLexer} {
+			opts := &driver.Options{Lexer: lexer, Output: g.output}
+			result, err := driver.Compile(g.path, opts)
+			if err != nil {
+				t.Errorf("%q: unable to compile file; %v", g.path, err)
+				continue
+			}
+			var done bool
+			switch g.output {
+			case driver.Tokens:
+				done = len(result.Tokens) > 0 || len(result.GoccTokens) > 0
+			case driver.AST:
+				done = result.File != nil && result.Info == nil
+			case driver.Sem:
+				done = result.Info != nil && result.Module == nil
+			case driver.IR:
+				done = result.Module != nil && len(result.Output) == 0
+			}
+			if !done {
+				t.Errorf("%q: results mismatch for output kind %d; got %#v", g.path, g.output, result)
+			}
+		}
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	var golden = []struct {
+		path  string
+		stage run.Stage
+	}{
+		{path: "../testdata/does-not-exist.c", stage: run.StageRead},
+		{path: "../testdata/incorrect/parser/pe01.c", stage: run.StageParse},
+		{path: "../testdata/incorrect/parser/pe05.c", stage: run.StageParse},
+		{path: "../testdata/incorrect/semantic/se01.c", stage: run.StageSem},
+		{path: "../testdata/incorrect/semantic/se05.c", stage: run.StageSem},
+	}
+
+	semerrors.UseColor = false
+
+	for _, g := range golden {
+		_, err := driver.Compile(g.path, &driver.Options{Output: driver.LLVM})
+		if err == nil {
+			t.Errorf("%q: expected error, got nil", g.path)
+			continue
+		}
+		e, ok := err.(*run.Error)
+		if !ok {
+			t.Errorf("%q: error type mismatch; expected *run.Error, got %T", g.path, err)
+			continue
+		}
+		if e.Stage != g.stage {
+			t.Errorf("%q: stage mismatch; expected %v, got %v", g.path, g.stage, e.Stage)
+			continue
+		}
+		if g.stage == run.StageSem {
+			serr, ok := e.Err.(*semerrors.Error)
+			if !ok {
+				t.Errorf("%q: error type mismatch; expected *semerrors.Error, got %T", g.path, e.Err)
+				continue
+			}
+			if serr.Src == nil {
+				t.Errorf("%q: missing input source of semantic analysis error", g.path)
+			}
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// Package run implements the processing of input files shared by the command
+// line tools; it reports failed input files, and maps errors of compilation to
+// exit statuses.
+//
+// The package depends on no stage of compilation, so that tools which only use
+// some of the stages (e.g. ufmt) are built without the others.
+package run
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// Exit statuses of the command line tools.
+const (
+	// All input files were processed successfully.
+	ExitSuccess = 0
+	// Invalid usage, or failure not covered by another exit status; e.g.
+	// optimization or code generation errors.
+	ExitFailure = 1
+	// I/O error; e.g. unable to read an input file. Exit status 2 is used by
+	// package flag for invalid command line flags.
+	ExitIO = 3
+	// Syntax error; lexical or syntactic analysis failed.
+	ExitSyntax = 4
+	// Semantic error; semantic analysis failed.
+	ExitSemantic = 5
+)
+
+// Stage specifies a stage of compilation.
+type Stage int
+
+// Stages of compilation.
+const (
+	// Reading of the input.
+	StageRead Stage = iota
+	// Lexical and syntactic analysis.
+	StageParse
+	// Semantic analysis.
+	StageSem
+	// Optimization.
+	StageOpt
+	// Code generation.
+	StageCodegen
+)
+
+// String returns the string representation of the stage.
+func (stage Stage) String() string {
+	switch stage {
+	case StageRead:
+		return "read"
+	case StageParse:
+		return "syntactic analysis"
+	case StageSem:
+		return "semantic analysis"
+	case StageOpt:
+		return "optimization"
+	case StageCodegen:
+		return "code generation"
+	default:
+		panic(fmt.Sprintf("support for stage %d not yet implemented", int(stage)))
+	}
+}
+
+// An Error represents an error which occurred during a stage of compilation.
+type Error struct {
+	// Stage of compilation.
+	Stage Stage
+	// Underlying error; e.g. a *semerrors.Error of semantic analysis.
+	Err error
+}
+
+// Error returns the error message of the underlying error.
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// ExitStatus returns the exit status corresponding to the given error.
+func ExitStatus(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+	if err, ok := err.(*Error); ok {
+		switch err.Stage {
+		case StageRead:
+			return ExitIO
+		case StageParse:
+			return ExitSyntax
+		case StageSem:
+			return ExitSemantic
+		}
+	}
+	return ExitFailure
+}
+
+// A Runner processes the input files of a command line tool, and reports
+// failed input files.
+type Runner struct {
+	// Process processes the given input file, writing output to stdout and
+	// diagnostics to stderr.
+	Process func(path string, stdout, stderr io.Writer) error
+	// Keep going after an input file has failed, and summarise the failed input
+	// files; otherwise, stop at the first failed input file.
+	KeepGoing bool
+	// Maximum number of input files processed in parallel. If Jobs is at most
+	// 1, input files are processed sequentially and output is written directly
+	// to Stdout and Stderr; otherwise, the output of each input file is
+	// buffered, and written in the order of the input files.
+	Jobs int
+	// Output destinations; os.Stdout and os.Stderr if nil.
+	Stdout, Stderr io.Writer
+}
+
+// A job represents the processing of an input file.
+type job struct {
+	// Input file path.
+	path string
+	// Buffered output.
+	stdout, stderr bytes.Buffer
+	// Error of processing; or nil if successful.
+	err error
+}
+
+// Run processes the given input files, and returns the exit status of the
+// command line tool. The exit status of the first failed input file is
+// returned, or ExitSuccess if all input files were processed successfully.
+func (r *Runner) Run(paths []string) int {
+	stdout, stderr := r.Stdout, r.Stderr
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+	elog := log.New(stderr, "", 0)
+	var failed []*job
+	report := func(j *job) {
+		if j.err == nil {
+			return
+		}
+		elog.Print(j.err)
+		failed = append(failed, j)
+	}
+
+	if r.Jobs <= 1 {
+		for _, path := range paths {
+			j := &job{path: path}
+			j.err = r.process(path, stdout, stderr)
+			report(j)
+			if j.err != nil && !r.KeepGoing {
+				break
+			}
+		}
+	} else {
+		r.runParallel(paths, func(j *job) bool {
+			stdout.Write(j.stdout.Bytes())
+			stderr.Write(j.stderr.Bytes())
+			report(j)
+			return j.err == nil || r.KeepGoing
+		})
+	}
+
+	if len(failed) == 0 {
+		return ExitSuccess
+	}
+	if r.KeepGoing {
+		elog.Printf("%d of %d files failed:", len(failed), len(paths))
+		for _, j := range failed {
+			name := j.path
+			if name == "-" {
+				name = "<stdin>"
+			}
+			elog.Printf("   %s: %s", name, summary(j.err))
+		}
+	}
+	return ExitStatus(failed[0].err)
+}
+
+// process processes the given input file, writing output to stdout and
+// diagnostics to stderr. Panics of Process (e.g. support for a language
+// construct not yet implemented by a backend) are reported as errors, so that
+// other input files may still be processed.
+func (r *Runner) process(path string, stdout, stderr io.Writer) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if path == "-" {
+				path = "<stdin>"
+			}
+			err = errutil.Newf("%s: internal compiler error: %v", path, e)
+		}
+	}()
+	return r.Process(path, stdout, stderr)
+}
+
+// runParallel processes the given input files in parallel, and invokes done on
+// each processed input file in the order of the input files. Processing stops
+// when done returns false.
+func (r *Runner) runParallel(paths []string, done func(j *job) bool) {
+	results := make([]chan *job, len(paths))
+	for i := range results {
+		results[i] = make(chan *job, 1)
+	}
+	next := make(chan int)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for n := 0; n < r.Jobs; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				j := &job{path: paths[i]}
+				j.err = r.process(j.path, &j.stdout, &j.stderr)
+				results[i] <- j
+			}
+		}()
+	}
+	go func() {
+		defer close(next)
+		for i := range paths {
+			select {
+			case next <- i:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	for i := range paths {
+		if !done(<-results[i]) {
+			break
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
+
+// summary returns a one-line summary of the given error.
+func summary(err error) string {
+	if err, ok := err.(*Error); ok {
+		return fmt.Sprintf("%v error", err.Stage)
+	}
+	return "error"
+}
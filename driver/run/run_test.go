@@ -0,0 +1,102 @@
+package run_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/driver"
+	"github.com/mewmew/uc/driver/run"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func TestRun(t *testing.T) {
+	var golden = []struct {
+		paths     []string
+		keepGoing bool
+		// Input file for which Process panics.
+		panics string
+		// Exit status.
+		want int
+		// Input files processed, in order.
+		processed []string
+	}{
+		{
+			paths:     []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/noisy/simple/sim02.c"},
+			want:      run.ExitSuccess,
+			processed: []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/noisy/simple/sim02.c"},
+		},
+		// Stop at the first failed input file.
+		{
+			paths:     []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/incorrect/parser/pe01.c", "../../testdata/incorrect/semantic/se01.c"},
+			want:      run.ExitSyntax,
+			processed: []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/incorrect/parser/pe01.c"},
+		},
+		// Keep going; the exit status of the first failed input file is used.
+		{
+			paths:     []string{"../../testdata/incorrect/semantic/se01.c", "../../testdata/noisy/simple/sim01.c", "../../testdata/incorrect/parser/pe01.c"},
+			keepGoing: true,
+			want:      run.ExitSemantic,
+			processed: []string{"../../testdata/incorrect/semantic/se01.c", "../../testdata/noisy/simple/sim01.c", "../../testdata/incorrect/parser/pe01.c"},
+		},
+		// Panics are reported as failed input files.
+		{
+			paths:     []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/noisy/simple/sim02.c", "../../testdata/noisy/simple/sim03.c"},
+			panics:    "../../testdata/noisy/simple/sim02.c",
+			want:      run.ExitFailure,
+			processed: []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/noisy/simple/sim02.c"},
+		},
+		{
+			paths:     []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/noisy/simple/sim02.c", "../../testdata/noisy/simple/sim03.c"},
+			keepGoing: true,
+			panics:    "../../testdata/noisy/simple/sim02.c",
+			want:      run.ExitFailure,
+			processed: []string{"../../testdata/noisy/simple/sim01.c", "../../testdata/noisy/simple/sim02.c", "../../testdata/noisy/simple/sim03.c"},
+		},
+		{
+			paths:     []string{"../../testdata/does-not-exist.c", "../../testdata/noisy/simple/sim01.c"},
+			keepGoing: true,
+			want:      run.ExitIO,
+			processed: []string{"../../testdata/does-not-exist.c", "../../testdata/noisy/simple/sim01.c"},
+		},
+	}
+
+	semerrors.UseColor = false
+
+	for _, g := range golden {
+		for _, jobs := range []int{1, 4} {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			r := &run.Runner{
+				Process: func(path string, stdout, stderr io.Writer) error {
+					fmt.Fprintln(stdout, path)
+					if path == g.panics {
+						panic("support for input file not yet implemented")
+					}
+					_, err := driver.Compile(path, &driver.Options{Output: driver.LLVM})
+					return err
+				},
+				KeepGoing: g.keepGoing,
+				Jobs:      jobs,
+				Stdout:    stdout,
+				Stderr:    stderr,
+			}
+			status := r.Run(g.paths)
+			if status != g.want {
+				t.Errorf("%q (jobs %d): exit status mismatch; expected %d, got %d", g.paths, jobs, g.want, status)
+			}
+			processed := strings.Fields(stdout.String())
+			if strings.Join(processed, " ") != strings.Join(g.processed, " ") {
+				t.Errorf("%q (jobs %d): processed input files mismatch; expected %q, got %q", g.paths, jobs, g.processed, processed)
+			}
+			if g.panics != "" && !strings.Contains(stderr.String(), "internal compiler error") {
+				t.Errorf("%q (jobs %d): missing panic error; got %q", g.paths, jobs, stderr)
+			}
+			summary := strings.Contains(stderr.String(), "files failed:")
+			if want := g.keepGoing && g.want != run.ExitSuccess; summary != want {
+				t.Errorf("%q (jobs %d): summary mismatch; expected summary %v, got %q", g.paths, jobs, want, stderr)
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Package format implements canonical formatting of µC source code.
+//
+// Declarations and statements are placed on separate lines, indented by tabs.
+// Braces follow the K&R style, with the opening brace on the line of the
+// declaration or statement; and the bodies of if and while statements which
+// are not block statements are placed on separate, indented lines. Binary
+// operators are surrounded by spaces.
+//
+// Comments are preserved. A comment on the same line as the preceding token
+// remains on that line; other comments are placed on separate lines. Blank
+// lines between declarations, statements and comments are preserved, though
+// consecutive blank lines are collapsed into one.
+package format
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	goccerrors "github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+)
+
+// Source formats the given µC source code, and returns the result in canonical
+// format. Comments are preserved.
+func Source(src []byte) ([]byte, error) {
+	// Parse input.
+	s := scanner.NewFromBytes(src)
+	p := parser.NewParser()
+	f, err := p.Parse(s)
+	if err != nil {
+		if err, ok := err.(*goccerrors.Error); ok {
+			// Unwrap Gocc error.
+			return nil, parser.NewError(err)
+		}
+		return nil, errutil.Err(err)
+	}
+	file := f.(*ast.File)
+	if cs, ok := s.(astutil.CommentScanner); ok {
+		astutil.AddComments(file, string(src), cs.Comments(), cs.Semicolons())
+	}
+
+	// Locate comments.
+	pr := newPrinter()
+	pr.src = string(src)
+	for _, g := range file.Comments {
+		pr.comments = append(pr.comments, g.List...)
+	}
+	pr.file(file)
+	return pr.buf.Bytes(), nil
+}
+
+// Node pretty-prints the given node in canonical format to w. The node is
+// either a *ast.File, a declaration, a statement or an expression.
+func Node(w io.Writer, node ast.Node) error {
+	pr := newPrinter()
+	switch node := node.(type) {
+	case *ast.File:
+		pr.file(node)
+	case ast.Decl:
+		pr.decl(node)
+	case ast.Stmt:
+		pr.stmt(node)
+	case ast.Expr:
+		pr.expr(node)
+	default:
+		return errutil.Newf("support for node %T not yet implemented", node)
+	}
+	if _, err := w.Write(pr.buf.Bytes()); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// newPrinter returns a new printer.
+func newPrinter() *printer {
+	return &printer{buf: &bytes.Buffer{}}
+}
@@ -0,0 +1,159 @@
+package format_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/format"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/lexer"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/token"
+)
+
+func TestSource(t *testing.T) {
+	golden := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../testdata/extra/format/format.c",
+			want: "../testdata/extra/format/format.golden",
+		},
+	}
+	for _, g := range golden {
+		src, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		got, err := format.Source(src)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		want, err := ioutil.ReadFile(g.want)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%q: formatted source mismatch; expected `%s`, got `%s`", g.path, want, got)
+		}
+	}
+}
+
+// TestRoundTrip verifies that formatting preserves the syntax tree and the
+// comments of the source, and that formatting the formatted source again
+// yields the same output.
+func TestRoundTrip(t *testing.T) {
+	var paths []string
+	for _, pattern := range []string{"../testdata/quiet/*/*.c", "../testdata/noisy/*/*.c", "../testdata/extra/*/*.c"} {
+		ps, err := filepath.Glob(pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, ps...)
+	}
+	for _, path := range paths {
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		want, err := parse(src)
+		if err != nil {
+			// Skip input which fails to parse; e.g. the preprocessor directives
+			// of quiet/rtl/r06.c.
+			continue
+		}
+		got, err := format.Source(src)
+		if err != nil {
+			t.Errorf("%q: unable to format source; %v", path, err)
+			continue
+		}
+		file, err := parse(got)
+		if err != nil {
+			t.Errorf("%q: unable to parse formatted source; %v", path, err)
+			continue
+		}
+		if file.String() != want.String() {
+			t.Errorf("%q: syntax tree mismatch; expected `%v`, got `%v`", path, want, file)
+		}
+		if !equalComments(src, got) {
+			t.Errorf("%q: comments not preserved in formatted source `%s`", path, got)
+		}
+		again, err := format.Source(got)
+		if err != nil {
+			t.Errorf("%q: unable to format formatted source; %v", path, err)
+			continue
+		}
+		if !bytes.Equal(again, got) {
+			t.Errorf("%q: formatting not idempotent; expected `%s`, got `%s`", path, got, again)
+		}
+	}
+}
+
+func TestNode(t *testing.T) {
+	golden := []struct {
+		src  string
+		want string
+	}{
+		{
+			src:  "int f(int x){if(x)return 1;return 0;}",
+			want: "int f(int x) {\n\tif (x)\n\t\treturn 1;\n\treturn 0;\n}",
+		},
+	}
+	for _, g := range golden {
+		file, err := parse([]byte(g.src))
+		if err != nil {
+			t.Errorf("%q: %v", g.src, err)
+			continue
+		}
+		buf := &bytes.Buffer{}
+		if err := format.Node(buf, file.Decls[0]); err != nil {
+			t.Errorf("%q: %v", g.src, err)
+			continue
+		}
+		if got := buf.String(); got != g.want {
+			t.Errorf("%q: output mismatch; expected %q, got %q", g.src, g.want, got)
+		}
+	}
+}
+
+// parse parses the given source code.
+func parse(src []byte) (*ast.File, error) {
+	p := parser.NewParser()
+	file, err := p.Parse(scanner.NewFromBytes(src))
+	if err != nil {
+		return nil, err
+	}
+	return file.(*ast.File), nil
+}
+
+// equalComments reports whether the given source code contain the same
+// comments.
+func equalComments(a, b []byte) bool {
+	comments := func(src []byte) []string {
+		var cs []string
+		for _, tok := range lexer.ParseString(string(src)) {
+			if tok.Kind == token.Comment {
+				cs = append(cs, tok.Val)
+			}
+		}
+		return cs
+	}
+	ca, cb := comments(a), comments(b)
+	if len(ca) != len(cb) {
+		return false
+	}
+	for i := range ca {
+		if ca[i] != cb[i] {
+			return false
+		}
+	}
+	return true
+}
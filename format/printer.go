@@ -0,0 +1,411 @@
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/token"
+)
+
+// whitespace specifies the white-space characters separating tokens and
+// comments of the input source.
+const whitespace = " \t\n\v\f\r"
+
+// A printer keeps track of the state required to pretty-print syntax trees.
+type printer struct {
+	// Output buffer.
+	buf *bytes.Buffer
+	// Input source; or empty if not formatting source code.
+	src string
+	// Comments of the input source not yet printed, sorted by position.
+	comments []*ast.Comment
+	// Indentation level.
+	indent int
+	// Number of pending newlines before the next token.
+	nl int
+	// Specifies whether a space is pending before the next token.
+	sp bool
+	// Specifies whether a block was just opened; blank lines are not preserved
+	// before the first item of a block.
+	open bool
+}
+
+// write writes the given text, preceded by pending whitespace.
+func (p *printer) write(s string) {
+	switch {
+	case p.nl > 0:
+		// Omit leading newlines.
+		if p.buf.Len() > 0 {
+			p.buf.WriteString(strings.Repeat("\n", p.nl))
+			p.buf.WriteString(strings.Repeat("\t", p.indent))
+		}
+	case p.sp:
+		p.buf.WriteString(" ")
+	}
+	p.nl, p.sp = 0, false
+	p.buf.WriteString(s)
+}
+
+// token writes the given token, located at pos in the input source. Comments
+// preceding the token are written first.
+func (p *printer) token(s string, pos int) {
+	p.flush(pos)
+	p.write(s)
+}
+
+// flush writes the comments located before pos in the input source.
+func (p *printer) flush(pos int) {
+	for len(p.comments) > 0 && p.comments[0].Slash < pos {
+		comment := p.comments[0]
+		p.comments = p.comments[1:]
+		p.comment(comment)
+	}
+}
+
+// comment writes the given comment.
+func (p *printer) comment(comment *ast.Comment) {
+	lines, ok := p.linesBefore(comment.Slash)
+	trailing := ok && lines == 0 && p.buf.Len() > 0
+	if trailing {
+		// Keep trailing comments on the line of the preceding token.
+		p.nl, p.sp = 0, true
+	} else {
+		p.nl = 1
+		if lines > 1 && !p.open {
+			p.nl = 2
+		}
+	}
+	p.open = false
+	p.write(comment.Text)
+	if !trailing || strings.HasPrefix(comment.Text, "//") {
+		p.nl = 1
+	} else {
+		p.sp = true
+	}
+}
+
+// item prepares for the declaration or statement located at pos in the input
+// source to be written on a new line. Comments preceding the item are written
+// first, and a blank line preceding the item in the input source is preserved.
+func (p *printer) item(pos int) {
+	p.flush(pos)
+	n := 1
+	if lines, ok := p.linesBefore(pos); ok && lines > 1 && !p.open {
+		n = 2
+	}
+	if p.nl < n {
+		p.nl = n
+	}
+	p.open = false
+}
+
+// linesBefore returns the number of newlines in the input source between the
+// token (or comment) preceding pos and pos. The boolean return value indicates
+// success; it is false if no token precedes pos.
+func (p *printer) linesBefore(pos int) (int, bool) {
+	if pos > len(p.src) {
+		// Not formatting source code.
+		return 0, false
+	}
+	// Tokens and comments are separated by white-space characters only, and
+	// never end with a white-space character.
+	end := len(strings.TrimRight(p.src[:pos], whitespace))
+	if end == 0 {
+		return 0, false
+	}
+	return strings.Count(p.src[end:pos], "\n"), true
+}
+
+// file writes the given file.
+func (p *printer) file(file *ast.File) {
+	for _, decl := range file.Decls {
+		p.item(decl.Start())
+		p.decl(decl)
+	}
+	// Write comments at the end of the file.
+	p.flush(len(p.src) + 1)
+	if p.buf.Len() > 0 {
+		p.buf.WriteString("\n")
+	}
+}
+
+// decl writes the given declaration.
+func (p *printer) decl(decl ast.Decl) {
+	switch decl := decl.(type) {
+	case *ast.EnumDecl:
+		p.token("enum", decl.Enum)
+		if decl.Tag != nil {
+			p.sp = true
+			p.ident(decl.Tag)
+		}
+		p.sp = true
+		p.token("{", decl.Lbrace)
+		for i, enumerator := range decl.Enumerators {
+			if i != 0 {
+				p.write(",")
+			}
+			p.sp = true
+			p.ident(enumerator.ConstName)
+			if enumerator.ValExpr != nil {
+				p.sp = true
+				p.write("=")
+				p.sp = true
+				p.expr(enumerator.ValExpr)
+			}
+		}
+		p.sp = true
+		p.token("}", decl.Rbrace)
+		p.write(";")
+	case *ast.FuncDecl:
+		p.typ(decl.FuncType.Result)
+		p.sp = true
+		p.ident(decl.FuncName)
+		p.token("(", decl.FuncType.Lparen)
+		for i, param := range decl.FuncType.Params {
+			if i != 0 {
+				p.write(",")
+				p.sp = true
+			}
+			p.varDecl(param)
+		}
+		p.token(")", decl.FuncType.Rparen)
+		if decl.Body == nil {
+			p.write(";")
+			return
+		}
+		p.sp = true
+		p.block(decl.Body)
+	case *ast.TypeDef:
+		p.token("typedef", decl.Typedef)
+		p.sp = true
+		p.typ(decl.DeclType)
+		p.sp = true
+		p.ident(decl.TypeName)
+		p.write(";")
+	case *ast.VarDecl:
+		p.varDecl(decl)
+		p.write(";")
+	default:
+		panic(fmt.Sprintf("support for declaration %T not yet implemented", decl))
+	}
+}
+
+// varDecl writes the given variable declaration, excluding the terminating
+// semicolon.
+func (p *printer) varDecl(decl *ast.VarDecl) {
+	if typ, ok := decl.VarType.(*ast.ArrayType); ok {
+		p.typ(typ.Elem)
+		if decl.VarName != nil {
+			p.sp = true
+			p.ident(decl.VarName)
+		}
+		p.token("[", typ.Lbracket)
+		switch {
+		case typ.LenExpr != nil:
+			p.expr(typ.LenExpr)
+		case typ.Len > 0:
+			p.write(strconv.Itoa(typ.Len))
+		}
+		p.token("]", typ.Rbracket)
+		return
+	}
+	p.typ(decl.VarType)
+	if decl.VarName != nil {
+		p.sp = true
+		p.ident(decl.VarName)
+	}
+	if decl.Val != nil {
+		p.sp = true
+		p.write("=")
+		p.sp = true
+		p.expr(decl.Val)
+	}
+}
+
+// typ writes the given type.
+func (p *printer) typ(typ ast.Type) {
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		p.ident(typ)
+	case *ast.EnumType:
+		p.token("enum", typ.Enum)
+		p.sp = true
+		p.ident(typ.Tag)
+	default:
+		panic(fmt.Sprintf("support for type %T not yet implemented", typ))
+	}
+}
+
+// stmt writes the given statement.
+func (p *printer) stmt(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStmt:
+		p.block(stmt)
+	case *ast.EmptyStmt:
+		p.token(";", stmt.Semicolon)
+	case *ast.ExprStmt:
+		p.expr(stmt.X)
+		p.write(";")
+	case *ast.IfStmt:
+		p.token("if", stmt.If)
+		p.sp = true
+		p.write("(")
+		p.expr(stmt.Cond)
+		p.write(")")
+		p.body(stmt.Body)
+		if stmt.Else == nil {
+			return
+		}
+		// Comments between the true branch and the false branch are written
+		// before the else keyword.
+		p.flush(stmt.Else.Start())
+		if _, ok := stmt.Body.(*ast.BlockStmt); ok {
+			p.sp = true
+		} else if p.nl == 0 {
+			p.nl = 1
+		}
+		p.write("else")
+		if els, ok := stmt.Else.(*ast.IfStmt); ok {
+			p.sp = true
+			p.stmt(els)
+			return
+		}
+		p.body(stmt.Else)
+	case *ast.ReturnStmt:
+		p.token("return", stmt.Return)
+		if stmt.Result != nil {
+			p.sp = true
+			p.expr(stmt.Result)
+		}
+		p.write(";")
+	case *ast.WhileStmt:
+		p.token("while", stmt.While)
+		p.sp = true
+		p.write("(")
+		p.expr(stmt.Cond)
+		p.write(")")
+		p.body(stmt.Body)
+	default:
+		panic(fmt.Sprintf("support for statement %T not yet implemented", stmt))
+	}
+}
+
+// body writes the given body of an if or while statement; block statements are
+// written on the same line, other statements on a separate, indented line.
+func (p *printer) body(body ast.Stmt) {
+	if block, ok := body.(*ast.BlockStmt); ok {
+		p.sp = true
+		p.block(block)
+		return
+	}
+	p.indent++
+	p.open = true
+	p.item(body.Start())
+	p.stmt(body)
+	p.indent--
+}
+
+// block writes the given block statement.
+func (p *printer) block(block *ast.BlockStmt) {
+	p.token("{", block.Lbrace)
+	if len(block.Items) == 0 && (len(p.comments) == 0 || p.comments[0].Slash > block.Rbrace) {
+		p.token("}", block.Rbrace)
+		return
+	}
+	p.indent++
+	p.open = true
+	for _, item := range block.Items {
+		p.item(item.Start())
+		switch item := item.(type) {
+		case ast.Decl:
+			p.decl(item)
+		case ast.Stmt:
+			p.stmt(item)
+		default:
+			panic(fmt.Sprintf("support for block item %T not yet implemented", item))
+		}
+	}
+	// Write comments at the end of the block.
+	p.flush(block.Rbrace)
+	p.indent--
+	p.open = false
+	p.nl = 1
+	p.token("}", block.Rbrace)
+}
+
+// expr writes the given expression.
+func (p *printer) expr(expr ast.Expr) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		p.token(expr.Val, expr.ValPos)
+	case *ast.BinaryExpr:
+		p.expr(expr.X)
+		if expr.Op != token.Comma {
+			p.sp = true
+		}
+		p.token(expr.Op.String(), expr.OpPos)
+		p.sp = true
+		p.expr(expr.Y)
+	case *ast.CallExpr:
+		p.ident(expr.Name)
+		p.token("(", expr.Lparen)
+		for i, arg := range expr.Args {
+			if i != 0 {
+				p.write(",")
+				p.sp = true
+			}
+			p.expr(arg)
+		}
+		p.token(")", expr.Rparen)
+	case *ast.CastExpr:
+		p.token("(", expr.Lparen)
+		p.typ(expr.Type)
+		p.token(")", expr.Rparen)
+		p.expr(expr.X)
+	case *ast.CondExpr:
+		p.expr(expr.Cond)
+		p.sp = true
+		p.token("?", expr.Question)
+		p.sp = true
+		p.expr(expr.X)
+		p.sp = true
+		p.token(":", expr.Colon)
+		p.sp = true
+		p.expr(expr.Y)
+	case *ast.Ident:
+		p.ident(expr)
+	case *ast.IndexExpr:
+		p.ident(expr.Name)
+		p.token("[", expr.Lbracket)
+		p.expr(expr.Index)
+		p.token("]", expr.Rbracket)
+	case *ast.ParenExpr:
+		p.token("(", expr.Lparen)
+		p.expr(expr.X)
+		p.token(")", expr.Rparen)
+	case *ast.SizeofExpr:
+		p.token("sizeof", expr.Sizeof)
+		if _, ok := expr.X.(*ast.ParenExpr); !ok {
+			p.sp = true
+		}
+		p.expr(expr.X)
+	case *ast.UnaryExpr:
+		p.token(expr.Op.String(), expr.OpPos)
+		// Separate consecutive minus signs, to avoid confusion with the C
+		// decrement operator.
+		if x, ok := expr.X.(*ast.UnaryExpr); ok && expr.Op == token.Sub && x.Op == token.Sub {
+			p.sp = true
+		}
+		p.expr(expr.X)
+	default:
+		panic(fmt.Sprintf("support for expression %T not yet implemented", expr))
+	}
+}
+
+// ident writes the given identifier.
+func (p *printer) ident(ident *ast.Ident) {
+	p.token(ident.Name, ident.NamePos)
+}
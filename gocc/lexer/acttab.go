@@ -29,7 +29,7 @@ var ActTab = ActionTable{
 		Ignore: "!whitespace",
 	},
 	ActionRow{ // S2
-		Accept: 31,
+		Accept: 32,
 		Ignore: "",
 	},
 	ActionRow{ // S3
@@ -53,63 +53,63 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S8
-		Accept: 29,
+		Accept: 30,
 		Ignore: "",
 	},
 	ActionRow{ // S9
-		Accept: 27,
+		Accept: 28,
 		Ignore: "",
 	},
 	ActionRow{ // S10
-		Accept: 12,
+		Accept: 14,
 		Ignore: "",
 	},
 	ActionRow{ // S11
-		Accept: 28,
+		Accept: 29,
 		Ignore: "",
 	},
 	ActionRow{ // S12
-		Accept: 30,
+		Accept: 31,
 		Ignore: "",
 	},
 	ActionRow{ // S13
-		Accept: 9,
+		Accept: 34,
 		Ignore: "",
 	},
 	ActionRow{ // S14
-		Accept: 3,
+		Accept: 20,
 		Ignore: "",
 	},
 	ActionRow{ // S15
-		Accept: 23,
+		Accept: 3,
 		Ignore: "",
 	},
 	ActionRow{ // S16
-		Accept: 19,
+		Accept: 24,
 		Ignore: "",
 	},
 	ActionRow{ // S17
-		Accept: 24,
+		Accept: 9,
 		Ignore: "",
 	},
 	ActionRow{ // S18
-		Accept: 4,
+		Accept: 25,
 		Ignore: "",
 	},
 	ActionRow{ // S19
-		Accept: 7,
+		Accept: 19,
 		Ignore: "",
 	},
 	ActionRow{ // S20
-		Accept: 8,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S21
-		Accept: 4,
+		Accept: 7,
 		Ignore: "",
 	},
 	ActionRow{ // S22
-		Accept: 4,
+		Accept: 8,
 		Ignore: "",
 	},
 	ActionRow{ // S23
@@ -129,35 +129,35 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S27
-		Accept: 14,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S28
-		Accept: 15,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S29
-		Accept: 22,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S30
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 12,
+		Ignore: "",
 	},
 	ActionRow{ // S31
-		Accept: 20,
+		Accept: 13,
 		Ignore: "",
 	},
 	ActionRow{ // S32
-		Accept: 0,
+		Accept: 23,
 		Ignore: "",
 	},
 	ActionRow{ // S33
-		Accept: 0,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!comment",
 	},
 	ActionRow{ // S34
-		Accept: 0,
+		Accept: 21,
 		Ignore: "",
 	},
 	ActionRow{ // S35
@@ -169,27 +169,27 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S37
-		Accept: 25,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S38
-		Accept: 21,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S39
-		Accept: 26,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S40
-		Accept: 4,
+		Accept: 26,
 		Ignore: "",
 	},
 	ActionRow{ // S41
-		Accept: 4,
+		Accept: 22,
 		Ignore: "",
 	},
 	ActionRow{ // S42
-		Accept: 16,
+		Accept: 27,
 		Ignore: "",
 	},
 	ActionRow{ // S43
@@ -205,15 +205,15 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S46
-		Accept: 10,
+		Accept: 16,
 		Ignore: "",
 	},
 	ActionRow{ // S47
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S48
-		Accept: 0,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S49
@@ -225,19 +225,19 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S51
-		Accept: 4,
+		Accept: 35,
 		Ignore: "",
 	},
 	ActionRow{ // S52
-		Accept: 4,
+		Accept: 0,
 		Ignore: "",
 	},
 	ActionRow{ // S53
-		Accept: -1,
-		Ignore: "!comment",
+		Accept: 0,
+		Ignore: "",
 	},
 	ActionRow{ // S54
-		Accept: 17,
+		Accept: 4,
 		Ignore: "",
 	},
 	ActionRow{ // S55
@@ -261,19 +261,63 @@ var ActTab = ActionTable{
 		Ignore: "",
 	},
 	ActionRow{ // S60
-		Accept: 18,
-		Ignore: "",
+		Accept: -1,
+		Ignore: "!comment",
 	},
 	ActionRow{ // S61
-		Accept: 13,
+		Accept: 17,
 		Ignore: "",
 	},
 	ActionRow{ // S62
-		Accept: 4,
+		Accept: 11,
 		Ignore: "",
 	},
 	ActionRow{ // S63
-		Accept: 11,
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S64
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S65
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S66
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S67
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S68
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S69
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S70
+		Accept: 18,
+		Ignore: "",
+	},
+	ActionRow{ // S71
+		Accept: 15,
+		Ignore: "",
+	},
+	ActionRow{ // S72
+		Accept: 33,
+		Ignore: "",
+	},
+	ActionRow{ // S73
+		Accept: 4,
+		Ignore: "",
+	},
+	ActionRow{ // S74
+		Accept: 10,
 		Ignore: "",
 	},
 }
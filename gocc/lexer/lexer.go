@@ -13,8 +13,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 64
-	NumSymbols = 82
+	NumStates  = 75
+	NumSymbols = 94
 )
 
 type Lexer struct {
@@ -148,79 +148,91 @@ Lexer symbols:
 5: ')'
 6: '['
 7: ']'
-8: 't'
-9: 'y'
-10: 'p'
-11: 'e'
-12: 'd'
-13: 'e'
-14: 'f'
-15: ','
-16: 'r'
-17: 'e'
-18: 't'
-19: 'u'
-20: 'r'
-21: 'n'
-22: '{'
-23: '}'
-24: 'i'
-25: 'f'
-26: 'e'
-27: 'l'
-28: 's'
-29: 'e'
-30: 'w'
-31: 'h'
-32: 'i'
-33: 'l'
+8: '='
+9: 't'
+10: 'y'
+11: 'p'
+12: 'e'
+13: 'd'
+14: 'e'
+15: 'f'
+16: 'e'
+17: 'n'
+18: 'u'
+19: 'm'
+20: '{'
+21: '}'
+22: ','
+23: 'r'
+24: 'e'
+25: 't'
+26: 'u'
+27: 'r'
+28: 'n'
+29: 'i'
+30: 'f'
+31: 'e'
+32: 'l'
+33: 's'
 34: 'e'
-35: '='
-36: '&'
-37: '&'
-38: '='
-39: '='
-40: '!'
-41: '='
-42: '<'
-43: '>'
-44: '<'
+35: 'w'
+36: 'h'
+37: 'i'
+38: 'l'
+39: 'e'
+40: '?'
+41: ':'
+42: '&'
+43: '&'
+44: '='
 45: '='
-46: '>'
+46: '!'
 47: '='
-48: '+'
-49: '-'
-50: '*'
-51: '/'
-52: '!'
-53: '_'
-54: '/'
-55: '/'
-56: '\n'
-57: '#'
-58: '\n'
-59: '/'
-60: '*'
-61: '*'
-62: '*'
-63: '/'
-64: '\'
-65: 'n'
-66: ' '
-67: '\t'
-68: '\v'
-69: '\f'
-70: '\r'
-71: '\n'
-72: \u0001-'\t'
-73: '\v'-'\f'
-74: \u000e-'!'
-75: '#'-'&'
-76: '('-'['
-77: ']'-\u007f
-78: 'a'-'z'
-79: 'A'-'Z'
-80: '0'-'9'
-81: .
+48: '<'
+49: '>'
+50: '<'
+51: '='
+52: '>'
+53: '='
+54: '+'
+55: '-'
+56: '*'
+57: '/'
+58: '!'
+59: 's'
+60: 'i'
+61: 'z'
+62: 'e'
+63: 'o'
+64: 'f'
+65: '_'
+66: '/'
+67: '/'
+68: '\n'
+69: '#'
+70: '\n'
+71: '/'
+72: '*'
+73: '*'
+74: '*'
+75: '/'
+76: '\'
+77: 'n'
+78: ' '
+79: '\t'
+80: '\v'
+81: '\f'
+82: '\r'
+83: '\n'
+84: \u0001-'\t'
+85: '\v'-'\f'
+86: \u000e-'!'
+87: '#'-'&'
+88: '('-'['
+89: ']'-\u007f
+90: 'a'-'z'
+91: 'A'-'Z'
+92: '0'-'9'
+93: .
 
 */
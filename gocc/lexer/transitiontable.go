@@ -50,48 +50,52 @@ var TransTab = TransitionTable{
 			return 12
 		case 48 <= r && r <= 57: // ['0','9']
 			return 13
-		case r == 59: // [';',';']
+		case r == 58: // [':',':']
 			return 14
-		case r == 60: // ['<','<']
+		case r == 59: // [';',';']
 			return 15
-		case r == 61: // ['=','=']
+		case r == 60: // ['<','<']
 			return 16
-		case r == 62: // ['>','>']
+		case r == 61: // ['=','=']
 			return 17
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 62: // ['>','>']
 			return 18
-		case r == 91: // ['[','[']
+		case r == 63: // ['?','?']
 			return 19
-		case r == 93: // [']',']']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 20
-		case r == 95: // ['_','_']
+		case r == 91: // ['[','[']
 			return 21
+		case r == 93: // [']',']']
+			return 22
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 20
 		case r == 101: // ['e','e']
-			return 22
+			return 24
 		case 102 <= r && r <= 104: // ['f','h']
-			return 18
+			return 20
 		case r == 105: // ['i','i']
-			return 23
+			return 25
 		case 106 <= r && r <= 113: // ['j','q']
-			return 18
+			return 20
 		case r == 114: // ['r','r']
-			return 24
+			return 26
 		case r == 115: // ['s','s']
-			return 18
+			return 27
 		case r == 116: // ['t','t']
-			return 25
+			return 28
 		case 117 <= r && r <= 118: // ['u','v']
-			return 18
+			return 20
 		case r == 119: // ['w','w']
-			return 26
+			return 29
 		case 120 <= r && r <= 122: // ['x','z']
-			return 18
+			return 20
 		case r == 123: // ['{','{']
-			return 27
+			return 30
 		case r == 125: // ['}','}']
-			return 28
+			return 31
 
 		}
 		return NoState
@@ -109,7 +113,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 29
+			return 32
 
 		}
 		return NoState
@@ -119,7 +123,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 30
+			return 33
 
 		default:
 			return 3
@@ -131,7 +135,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 38: // ['&','&']
-			return 31
+			return 34
 
 		}
 		return NoState
@@ -141,21 +145,21 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 1 <= r && r <= 9: // [\u0001,'\t']
-			return 32
+			return 35
 		case 11 <= r && r <= 12: // ['\v','\f']
-			return 32
+			return 35
 		case 14 <= r && r <= 33: // [\u000e,'!']
-			return 32
+			return 35
 		case r == 34: // ['"','"']
-			return 33
+			return 36
 		case 35 <= r && r <= 38: // ['#','&']
-			return 32
+			return 35
 		case 40 <= r && r <= 91: // ['(','[']
-			return 32
+			return 35
 		case r == 92: // ['\','\']
-			return 34
+			return 37
 		case 93 <= r && r <= 127: // [']',\u007f]
-			return 32
+			return 35
 
 		}
 		return NoState
@@ -213,9 +217,9 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 35
+			return 38
 		case r == 47: // ['/','/']
-			return 36
+			return 39
 
 		}
 		return NoState
@@ -242,8 +246,6 @@ var TransTab = TransitionTable{
 	// S15
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 37
 
 		}
 		return NoState
@@ -253,7 +255,7 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 38
+			return 40
 
 		}
 		return NoState
@@ -263,29 +265,47 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case r == 61: // ['=','=']
-			return 39
+			return 41
 
 		}
 		return NoState
 	},
 
 	// S18
+	func(r rune) int {
+		switch {
+		case r == 61: // ['=','=']
+			return 42
+
+		}
+		return NoState
+	},
+
+	// S19
+	func(r rune) int {
+		switch {
+
+		}
+		return NoState
+	},
+
+	// S20
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S19
+	// S21
 	func(r rune) int {
 		switch {
 
@@ -293,7 +313,7 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S20
+	// S22
 	func(r rune) int {
 		switch {
 
@@ -301,123 +321,147 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S21
+	// S23
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S22
+	// S24
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 107: // ['a','k']
-			return 18
+			return 20
 		case r == 108: // ['l','l']
-			return 41
-		case 109 <= r && r <= 122: // ['m','z']
-			return 18
+			return 44
+		case r == 109: // ['m','m']
+			return 20
+		case r == 110: // ['n','n']
+			return 45
+		case 111 <= r && r <= 122: // ['o','z']
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S23
+	// S25
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 101: // ['a','e']
-			return 18
+			return 20
 		case r == 102: // ['f','f']
-			return 42
+			return 46
 		case 103 <= r && r <= 122: // ['g','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S24
+	// S26
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 20
 		case r == 101: // ['e','e']
-			return 43
+			return 47
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S25
+	// S27
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 104: // ['a','h']
+			return 20
+		case r == 105: // ['i','i']
+			return 48
+		case 106 <= r && r <= 122: // ['j','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S28
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 120: // ['a','x']
-			return 18
+			return 20
 		case r == 121: // ['y','y']
-			return 44
+			return 49
 		case r == 122: // ['z','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S26
+	// S29
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 103: // ['a','g']
-			return 18
+			return 20
 		case r == 104: // ['h','h']
-			return 45
+			return 50
 		case 105 <= r && r <= 122: // ['i','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S27
+	// S30
 	func(r rune) int {
 		switch {
 
@@ -425,7 +469,7 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S28
+	// S31
 	func(r rune) int {
 		switch {
 
@@ -433,7 +477,7 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S29
+	// S32
 	func(r rune) int {
 		switch {
 
@@ -441,7 +485,7 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S30
+	// S33
 	func(r rune) int {
 		switch {
 
@@ -449,7 +493,7 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S31
+	// S34
 	func(r rune) int {
 		switch {
 
@@ -457,61 +501,61 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S32
+	// S35
 	func(r rune) int {
 		switch {
 		case r == 39: // [''',''']
-			return 46
+			return 51
 
 		}
 		return NoState
 	},
 
-	// S33
+	// S36
 	func(r rune) int {
 		switch {
 		case r == 39: // [''',''']
-			return 46
+			return 51
 
 		}
 		return NoState
 	},
 
-	// S34
+	// S37
 	func(r rune) int {
 		switch {
 		case r == 110: // ['n','n']
-			return 47
+			return 52
 
 		}
 		return NoState
 	},
 
-	// S35
+	// S38
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 48
+			return 53
 
 		default:
-			return 35
+			return 38
 		}
 
 	},
 
-	// S36
+	// S39
 	func(r rune) int {
 		switch {
 		case r == 10: // ['\n','\n']
-			return 30
+			return 33
 
 		default:
-			return 36
+			return 39
 		}
 
 	},
 
-	// S37
+	// S40
 	func(r rune) int {
 		switch {
 
@@ -519,7 +563,7 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S38
+	// S41
 	func(r rune) int {
 		switch {
 
@@ -527,7 +571,7 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S39
+	// S42
 	func(r rune) int {
 		switch {
 
@@ -535,119 +579,157 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S40
+	// S43
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S41
+	// S44
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 114: // ['a','r']
-			return 18
+			return 20
 		case r == 115: // ['s','s']
-			return 49
+			return 54
 		case 116 <= r && r <= 122: // ['t','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S42
+	// S45
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 116: // ['a','t']
+			return 20
+		case r == 117: // ['u','u']
+			return 55
+		case 118 <= r && r <= 122: // ['v','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S46
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S43
+	// S47
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 115: // ['a','s']
-			return 18
+			return 20
 		case r == 116: // ['t','t']
-			return 50
+			return 56
 		case 117 <= r && r <= 122: // ['u','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S44
+	// S48
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 121: // ['a','y']
+			return 20
+		case r == 122: // ['z','z']
+			return 57
+
+		}
+		return NoState
+	},
+
+	// S49
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 111: // ['a','o']
-			return 18
+			return 20
 		case r == 112: // ['p','p']
-			return 51
+			return 58
 		case 113 <= r && r <= 122: // ['q','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S45
+	// S50
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 104: // ['a','h']
-			return 18
+			return 20
 		case r == 105: // ['i','i']
-			return 52
+			return 59
 		case 106 <= r && r <= 122: // ['j','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S46
+	// S51
 	func(r rune) int {
 		switch {
 
@@ -655,111 +737,151 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S47
+	// S52
 	func(r rune) int {
 		switch {
 		case r == 39: // [''',''']
-			return 46
+			return 51
 
 		}
 		return NoState
 	},
 
-	// S48
+	// S53
 	func(r rune) int {
 		switch {
 		case r == 42: // ['*','*']
-			return 48
-		case r == 47: // ['/','/']
 			return 53
+		case r == 47: // ['/','/']
+			return 60
 
 		default:
-			return 35
+			return 38
 		}
 
 	},
 
-	// S49
+	// S54
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 20
 		case r == 101: // ['e','e']
-			return 54
+			return 61
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S50
+	// S55
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 108: // ['a','l']
+			return 20
+		case r == 109: // ['m','m']
+			return 62
+		case 110 <= r && r <= 122: // ['n','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S56
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 116: // ['a','t']
-			return 18
+			return 20
 		case r == 117: // ['u','u']
-			return 55
+			return 63
 		case 118 <= r && r <= 122: // ['v','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S51
+	// S57
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 20
 		case r == 101: // ['e','e']
-			return 56
+			return 64
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S52
+	// S58
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 100: // ['a','d']
+			return 20
+		case r == 101: // ['e','e']
+			return 65
+		case 102 <= r && r <= 122: // ['f','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S59
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 107: // ['a','k']
-			return 18
+			return 20
 		case r == 108: // ['l','l']
-			return 57
+			return 66
 		case 109 <= r && r <= 122: // ['m','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S53
+	// S60
 	func(r rune) int {
 		switch {
 
@@ -767,185 +889,257 @@ var TransTab = TransitionTable{
 		return NoState
 	},
 
-	// S54
+	// S61
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S55
+	// S62
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S63
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 113: // ['a','q']
-			return 18
+			return 20
 		case r == 114: // ['r','r']
-			return 58
+			return 67
 		case 115 <= r && r <= 122: // ['s','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S56
+	// S64
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 110: // ['a','n']
+			return 20
+		case r == 111: // ['o','o']
+			return 68
+		case 112 <= r && r <= 122: // ['p','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S65
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 99: // ['a','c']
-			return 18
+			return 20
 		case r == 100: // ['d','d']
-			return 59
+			return 69
 		case 101 <= r && r <= 122: // ['e','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S57
+	// S66
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 20
 		case r == 101: // ['e','e']
-			return 60
+			return 70
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S58
+	// S67
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 109: // ['a','m']
-			return 18
+			return 20
 		case r == 110: // ['n','n']
-			return 61
+			return 71
 		case 111 <= r && r <= 122: // ['o','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S59
+	// S68
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 101: // ['a','e']
+			return 20
+		case r == 102: // ['f','f']
+			return 72
+		case 103 <= r && r <= 122: // ['g','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S69
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 100: // ['a','d']
-			return 18
+			return 20
 		case r == 101: // ['e','e']
-			return 62
+			return 73
 		case 102 <= r && r <= 122: // ['f','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S60
+	// S70
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S61
+	// S71
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S62
+	// S72
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
+		case 97 <= r && r <= 122: // ['a','z']
+			return 20
+
+		}
+		return NoState
+	},
+
+	// S73
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 43
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 20
+		case r == 95: // ['_','_']
+			return 23
 		case 97 <= r && r <= 101: // ['a','e']
-			return 18
+			return 20
 		case r == 102: // ['f','f']
-			return 63
+			return 74
 		case 103 <= r && r <= 122: // ['g','z']
-			return 18
+			return 20
 
 		}
 		return NoState
 	},
 
-	// S63
+	// S74
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 40
+			return 43
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 18
+			return 20
 		case r == 95: // ['_','_']
-			return 21
+			return 23
 		case 97 <= r && r <= 122: // ['a','z']
-			return 18
+			return 20
 
 		}
 		return NoState
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 40
+const numNTSymbols = 49
 
 type (
 	gotoTable [numStates]gotoRow
@@ -16,15 +16,19 @@ var gotoTab = gotoTable{
 		2,  // Decls
 		3,  // DeclList
 		4,  // Decl
-		6,  // FuncDecl
-		9,  // FuncHeader
-		7,  // FuncDef
+		7,  // FuncDecl
+		11, // FuncHeader
+		8,  // FuncDef
 		5,  // VarDecl
-		12, // ScalarDecl
-		13, // ArrayDecl
-		-1, // IntLit
-		8,  // TypeDef
-		10, // BasicType
+		14, // ScalarDecl
+		15, // ArrayDecl
+		6,  // VarDef
+		9,  // TypeDef
+		12, // BasicType
+		10, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -39,13 +43,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -65,9 +74,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -82,13 +95,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -108,9 +126,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -125,13 +147,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -144,16 +171,20 @@ var gotoTab = gotoTable{
 		-1, // File
 		-1, // Decls
 		-1, // DeclList
-		15, // Decl
-		6,  // FuncDecl
-		9,  // FuncHeader
-		7,  // FuncDef
+		18, // Decl
+		7,  // FuncDecl
+		11, // FuncHeader
+		8,  // FuncDef
 		5,  // VarDecl
-		12, // ScalarDecl
-		13, // ArrayDecl
-		-1, // IntLit
-		8,  // TypeDef
-		10, // BasicType
+		14, // ScalarDecl
+		15, // ArrayDecl
+		6,  // VarDef
+		9,  // TypeDef
+		12, // BasicType
+		10, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -168,13 +199,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -194,9 +230,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -211,13 +251,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -237,9 +282,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -254,13 +303,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -280,9 +334,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -297,13 +355,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -323,9 +386,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -340,13 +407,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -366,9 +438,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -383,13 +459,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -409,16 +490,20 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
 		-1, // Type
 		-1, // Stmt
 		-1, // OtherStmt
-		19, // BlockStmt
+		-1, // BlockStmt
 		-1, // MatchedStmt
 		-1, // OpenStmt
 		-1, // Condition
@@ -426,13 +511,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -452,9 +542,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -469,13 +563,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -495,16 +594,20 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
 		-1, // Type
 		-1, // Stmt
 		-1, // OtherStmt
-		-1, // BlockStmt
+		24, // BlockStmt
 		-1, // MatchedStmt
 		-1, // OpenStmt
 		-1, // Condition
@@ -512,13 +615,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -538,9 +646,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -555,13 +667,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -581,9 +698,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -598,13 +719,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -624,13 +750,17 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
-		22, // BasicType
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
-		23, // Type
+		-1, // Type
 		-1, // Stmt
 		-1, // OtherStmt
 		-1, // BlockStmt
@@ -641,13 +771,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -667,9 +802,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -684,13 +823,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -710,13 +854,17 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
-		-1, // BasicType
+		28, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
-		-1, // Type
+		29, // Type
 		-1, // Stmt
 		-1, // OtherStmt
 		-1, // BlockStmt
@@ -727,13 +875,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -753,9 +906,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -770,13 +927,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -796,9 +958,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -813,13 +979,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -839,9 +1010,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -856,13 +1031,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -871,49 +1051,6 @@ var gotoTab = gotoTable{
 
 	},
 	gotoRow{ // S20
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		24, // Decl
-		27, // FuncDecl
-		30, // FuncHeader
-		28, // FuncDef
-		25, // VarDecl
-		12, // ScalarDecl
-		13, // ArrayDecl
-		-1, // IntLit
-		29, // TypeDef
-		10, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		36, // Stmt
-		39, // OtherStmt
-		33, // BlockStmt
-		37, // MatchedStmt
-		38, // OpenStmt
-		-1, // Condition
-		43, // BlockItems
-		46, // BlockItemList
-		47, // BlockItem
-		40, // Expr
-		48, // Expr2R
-		49, // Expr5L
-		50, // Expr9L
-		51, // Expr10L
-		52, // Expr12L
-		53, // Expr13L
-		55, // Expr14
-		56, // Expr15
-		58, // PrimaryExpr
-		59, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S21
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -925,9 +1062,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -942,13 +1083,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -956,7 +1102,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S22
+	gotoRow{ // S21
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -968,9 +1114,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -985,13 +1135,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -999,7 +1154,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S23
+	gotoRow{ // S22
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -1011,9 +1166,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1028,13 +1187,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1042,7 +1206,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S24
+	gotoRow{ // S23
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -1054,9 +1218,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1071,13 +1239,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1085,7 +1258,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S25
+	gotoRow{ // S24
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -1097,9 +1270,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1114,19 +1291,76 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
 		-1, // Args
 		-1, // ExprList
 
+	},
+	gotoRow{ // S25
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		33, // Decl
+		37, // FuncDecl
+		41, // FuncHeader
+		38, // FuncDef
+		34, // VarDecl
+		14, // ScalarDecl
+		15, // ArrayDecl
+		36, // VarDef
+		39, // TypeDef
+		12, // BasicType
+		40, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		48, // Stmt
+		51, // OtherStmt
+		44, // BlockStmt
+		49, // MatchedStmt
+		50, // OpenStmt
+		-1, // Condition
+		54, // BlockItems
+		57, // BlockItemList
+		58, // BlockItem
+		52, // Expr
+		59, // Expr1L
+		46, // Expr2R
+		45, // Expr3R
+		60, // Expr5L
+		61, // Expr9L
+		62, // Expr10L
+		63, // Expr12L
+		64, // Expr13L
+		66, // Expr14
+		70, // SizeofExpr
+		69, // CastExpr
+		-1, // CastOperand
+		67, // Expr15
+		73, // PrimaryExpr
+		72, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
 	},
 	gotoRow{ // S26
 		-1, // S'
@@ -1140,9 +1374,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1157,13 +1395,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1183,9 +1426,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1200,16 +1447,21 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
+		-1, // Expr1L
+		81, // Expr2R
+		80, // Expr3R
+		82, // Expr5L
+		83, // Expr9L
+		84, // Expr10L
+		85, // Expr12L
+		86, // Expr13L
+		88, // Expr14
+		92, // SizeofExpr
+		91, // CastExpr
+		-1, // CastOperand
+		89, // Expr15
+		95, // PrimaryExpr
+		94, // ParenExpr
 		-1, // Args
 		-1, // ExprList
 
@@ -1226,9 +1478,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1243,13 +1499,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1269,9 +1530,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1286,13 +1551,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1312,16 +1582,20 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
 		-1, // Type
 		-1, // Stmt
 		-1, // OtherStmt
-		66, // BlockStmt
+		-1, // BlockStmt
 		-1, // MatchedStmt
 		-1, // OpenStmt
 		-1, // Condition
@@ -1329,13 +1603,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1355,9 +1634,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1372,13 +1655,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1387,46 +1675,55 @@ var gotoTab = gotoTable{
 
 	},
 	gotoRow{ // S32
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		73, // Expr
-		74, // Expr2R
-		75, // Expr5L
-		76, // Expr9L
-		77, // Expr10L
-		78, // Expr12L
-		79, // Expr13L
-		81, // Expr14
-		82, // Expr15
-		84, // PrimaryExpr
-		85, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		102, // Enumerators
+		103, // EnumeratorList
+		104, // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
 	gotoRow{ // S33
@@ -1441,9 +1738,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1458,13 +1759,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1484,9 +1790,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1501,13 +1811,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1527,9 +1842,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1544,13 +1863,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1570,9 +1894,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1587,13 +1915,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1613,9 +1946,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1630,13 +1967,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1656,9 +1998,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1673,13 +2019,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1699,9 +2050,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1716,13 +2071,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1742,9 +2102,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1759,13 +2123,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1774,6 +2143,58 @@ var gotoTab = gotoTable{
 
 	},
 	gotoRow{ // S41
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		110, // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S42
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -1785,9 +2206,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1801,65 +2226,79 @@ var gotoTab = gotoTable{
 		-1, // BlockItems
 		-1, // BlockItemList
 		-1, // BlockItem
-		89, // Expr
-		48, // Expr2R
-		49, // Expr5L
-		50, // Expr9L
-		51, // Expr10L
-		52, // Expr12L
-		53, // Expr13L
-		55, // Expr14
-		56, // Expr15
-		58, // PrimaryExpr
-		59, // ParenExpr
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
 		-1, // Args
 		-1, // ExprList
 
 	},
-	gotoRow{ // S42
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		24, // Decl
-		27, // FuncDecl
-		30, // FuncHeader
-		28, // FuncDef
-		25, // VarDecl
-		12, // ScalarDecl
-		13, // ArrayDecl
-		-1, // IntLit
-		29, // TypeDef
-		10, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		36, // Stmt
-		39, // OtherStmt
-		33, // BlockStmt
-		37, // MatchedStmt
-		38, // OpenStmt
-		-1, // Condition
-		90, // BlockItems
-		46, // BlockItemList
-		47, // BlockItem
-		40, // Expr
-		48, // Expr2R
-		49, // Expr5L
-		50, // Expr9L
-		51, // Expr10L
-		52, // Expr12L
-		53, // Expr13L
-		55, // Expr14
-		56, // Expr15
-		58, // PrimaryExpr
-		59, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S43
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		117, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S43
+	gotoRow{ // S44
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -1871,9 +2310,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1888,13 +2331,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1902,7 +2350,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S44
+	gotoRow{ // S45
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -1914,9 +2362,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1926,18 +2378,23 @@ var gotoTab = gotoTable{
 		-1, // BlockStmt
 		-1, // MatchedStmt
 		-1, // OpenStmt
-		93, // Condition
+		-1, // Condition
 		-1, // BlockItems
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1945,7 +2402,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S45
+	gotoRow{ // S46
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -1957,9 +2414,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -1969,18 +2430,23 @@ var gotoTab = gotoTable{
 		-1, // BlockStmt
 		-1, // MatchedStmt
 		-1, // OpenStmt
-		94, // Condition
+		-1, // Condition
 		-1, // BlockItems
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -1988,50 +2454,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S46
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		24, // Decl
-		27, // FuncDecl
-		30, // FuncHeader
-		28, // FuncDef
-		25, // VarDecl
-		12, // ScalarDecl
-		13, // ArrayDecl
-		-1, // IntLit
-		29, // TypeDef
-		10, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		36, // Stmt
-		39, // OtherStmt
-		33, // BlockStmt
-		37, // MatchedStmt
-		38, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		95, // BlockItem
-		40, // Expr
-		48, // Expr2R
-		49, // Expr5L
-		50, // Expr9L
-		51, // Expr10L
-		52, // Expr12L
-		53, // Expr13L
-		55, // Expr14
-		56, // Expr15
-		58, // PrimaryExpr
-		59, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S47
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		33,  // Decl
+		37,  // FuncDecl
+		41,  // FuncHeader
+		38,  // FuncDef
+		34,  // VarDecl
+		14,  // ScalarDecl
+		15,  // ArrayDecl
+		36,  // VarDef
+		39,  // TypeDef
+		12,  // BasicType
+		40,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		48,  // Stmt
+		51,  // OtherStmt
+		44,  // BlockStmt
+		49,  // MatchedStmt
+		50,  // OpenStmt
+		-1,  // Condition
+		135, // BlockItems
+		57,  // BlockItemList
+		58,  // BlockItem
+		52,  // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S47
+	gotoRow{ // S48
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2043,9 +2518,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2060,13 +2539,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2074,7 +2558,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S48
+	gotoRow{ // S49
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2086,9 +2570,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2103,13 +2591,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2117,7 +2610,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S49
+	gotoRow{ // S50
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2129,9 +2622,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2146,13 +2643,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2160,7 +2662,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S50
+	gotoRow{ // S51
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2172,9 +2674,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2189,13 +2695,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2203,7 +2714,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S51
+	gotoRow{ // S52
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2215,9 +2726,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2232,13 +2747,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2246,7 +2766,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S52
+	gotoRow{ // S53
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		139, // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S54
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2258,9 +2830,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2275,13 +2851,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2289,7 +2870,163 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S53
+	gotoRow{ // S55
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		142, // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S56
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		143, // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S57
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		33,  // Decl
+		37,  // FuncDecl
+		41,  // FuncHeader
+		38,  // FuncDef
+		34,  // VarDecl
+		14,  // ScalarDecl
+		15,  // ArrayDecl
+		36,  // VarDef
+		39,  // TypeDef
+		12,  // BasicType
+		40,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		48,  // Stmt
+		51,  // OtherStmt
+		44,  // BlockStmt
+		49,  // MatchedStmt
+		50,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		144, // BlockItem
+		52,  // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S58
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2301,9 +3038,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2318,13 +3059,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2332,50 +3078,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S54
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		108, // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S55
+	gotoRow{ // S59
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2387,9 +3090,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2404,13 +3111,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2418,7 +3130,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S56
+	gotoRow{ // S60
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2430,9 +3142,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2447,13 +3163,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2461,50 +3182,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S57
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		109, // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S61
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S58
+	gotoRow{ // S62
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2516,9 +3246,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2533,13 +3267,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2547,7 +3286,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S59
+	gotoRow{ // S63
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2559,9 +3298,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2576,13 +3319,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2590,93 +3338,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S60
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		110, // VarDecl
-		114, // ScalarDecl
-		115, // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		111, // BasicType
-		113, // Params
-		117, // ParamList
-		118, // Param
-		116, // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		-1,  // Expr14
-		-1,  // Expr15
-		-1,  // PrimaryExpr
-		-1,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S61
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		119, // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		-1,  // Expr14
-		-1,  // Expr15
-		-1,  // PrimaryExpr
-		-1,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S62
+	gotoRow{ // S64
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2688,9 +3350,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2705,13 +3371,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2719,7 +3390,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S63
+	gotoRow{ // S65
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		159, // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S66
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2731,9 +3454,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2748,13 +3475,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2762,7 +3494,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S64
+	gotoRow{ // S67
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2774,9 +3506,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2791,13 +3527,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2805,7 +3546,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S65
+	gotoRow{ // S68
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		160, // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S69
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2817,9 +3610,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2834,13 +3631,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2848,7 +3650,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S66
+	gotoRow{ // S70
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2860,9 +3662,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -2877,13 +3683,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -2891,7 +3702,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S67
+	gotoRow{ // S71
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -2903,9 +3714,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -2919,22 +3734,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		127, // Expr
-		128, // Expr2R
-		129, // Expr5L
-		130, // Expr9L
-		131, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		139, // Args
-		141, // ExprList
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		161, // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S68
+	gotoRow{ // S72
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -2946,9 +3766,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -2962,22 +3786,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		146, // Expr
-		147, // Expr2R
-		148, // Expr5L
-		149, // Expr9L
-		150, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		165, // SizeofExpr
+		164, // CastExpr
+		166, // CastOperand
+		162, // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S69
+	gotoRow{ // S73
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -2989,9 +3818,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3006,13 +3839,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3020,50 +3858,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S70
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		161, // Expr
-		74,  // Expr2R
-		75,  // Expr5L
-		76,  // Expr9L
-		77,  // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S71
+	gotoRow{ // S74
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3075,9 +3870,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3092,13 +3891,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3106,7 +3910,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S72
+	gotoRow{ // S75
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3118,9 +3922,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3135,13 +3943,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3149,50 +3962,111 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S73
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S76
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		167, // VarDecl
+		171, // ScalarDecl
+		172, // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		168, // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		170, // Params
+		175, // ParamList
+		176, // Param
+		173, // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S74
+	gotoRow{ // S77
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		179, // Expr3R
+		181, // Expr5L
+		182, // Expr9L
+		183, // Expr10L
+		184, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S78
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3204,9 +4078,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3221,13 +4099,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3235,7 +4118,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S75
+	gotoRow{ // S79
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		199, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S80
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3247,9 +4182,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3264,13 +4203,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3278,7 +4222,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S76
+	gotoRow{ // S81
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3290,9 +4234,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3307,13 +4255,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3321,7 +4274,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S77
+	gotoRow{ // S82
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3333,9 +4286,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3350,13 +4307,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3364,7 +4326,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S78
+	gotoRow{ // S83
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3376,9 +4338,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3393,13 +4359,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3407,7 +4378,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S79
+	gotoRow{ // S84
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3419,9 +4390,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3436,13 +4411,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3450,50 +4430,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S80
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		175, // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S81
+	gotoRow{ // S85
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3505,9 +4442,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3522,13 +4463,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3536,7 +4482,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S82
+	gotoRow{ // S86
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3548,9 +4494,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3565,13 +4515,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3579,7 +4534,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S83
+	gotoRow{ // S87
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -3591,9 +4546,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -3608,21 +4567,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
 		-1,  // Expr13L
-		176, // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		213, // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S84
+	gotoRow{ // S88
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3634,9 +4598,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3651,13 +4619,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3665,7 +4638,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S85
+	gotoRow{ // S89
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3677,9 +4650,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3694,13 +4671,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3708,50 +4690,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S86
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S90
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		214, // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S87
+	gotoRow{ // S91
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3763,9 +4754,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3780,13 +4775,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3794,7 +4794,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S88
+	gotoRow{ // S92
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3806,9 +4806,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3823,13 +4827,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3837,23 +4846,131 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S89
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
+	gotoRow{ // S93
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		215, // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S94
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		219, // SizeofExpr
+		218, // CastExpr
+		220, // CastOperand
+		216, // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S95
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
 		-1, // Param
 		-1, // Type
 		-1, // Stmt
@@ -3866,13 +4983,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3880,7 +5002,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S90
+	gotoRow{ // S96
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3892,9 +5014,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3909,13 +5035,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3923,7 +5054,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S91
+	gotoRow{ // S97
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -3935,9 +5066,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -3952,13 +5087,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -3966,136 +5106,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S92
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		179, // Expr
-		74,  // Expr2R
-		75,  // Expr5L
-		76,  // Expr9L
-		77,  // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S93
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		182, // Stmt
-		184, // OtherStmt
-		181, // BlockStmt
-		183, // MatchedStmt
-		38,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		185, // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S94
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		39,  // OtherStmt
-		33,  // BlockStmt
-		190, // MatchedStmt
-		191, // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		40,  // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S98
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S95
+	gotoRow{ // S99
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -4107,9 +5170,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -4124,13 +5191,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -4138,7 +5210,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S96
+	gotoRow{ // S100
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4150,9 +5222,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		221, // Enumerators
+		103, // EnumeratorList
+		104, // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4167,64 +5243,28470 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
-		192, // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S97
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
+	gotoRow{ // S101
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S102
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S103
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S105
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S106
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S108
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S109
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S110
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S111
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		226, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S112
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		232, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S113
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S114
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		252, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S115
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S116
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S118
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S119
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S121
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S122
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S123
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S124
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		268, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S125
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S126
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S127
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		269, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S128
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S129
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S130
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		270, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S131
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		274, // SizeofExpr
+		273, // CastExpr
+		275, // CastOperand
+		271, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S133
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S135
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S136
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S137
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S138
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S141
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		278, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S142
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		282, // Stmt
+		284, // OtherStmt
+		280, // BlockStmt
+		283, // MatchedStmt
+		50,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		285, // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S143
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		51,  // OtherStmt
+		44,  // BlockStmt
+		289, // MatchedStmt
+		290, // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		52,  // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S144
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S145
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		291, // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S146
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		292, // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S147
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		297, // Expr
+		298, // Expr1L
+		296, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S148
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		315, // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S149
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		316, // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		317, // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S151
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		318, // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S152
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		319, // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S153
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		320, // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S154
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		321, // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S155
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		322, // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S156
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		323, // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S157
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		324, // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S158
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		325, // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S159
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S161
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S162
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S163
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		326, // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S164
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S165
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S166
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S167
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S168
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S169
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S170
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S171
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S172
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S173
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S174
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S175
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S176
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S178
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		333, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S179
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S180
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S181
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S182
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S183
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S184
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S185
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S186
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		347, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S187
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S188
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S189
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		348, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S190
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S191
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S192
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		349, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S193
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		353, // SizeofExpr
+		352, // CastExpr
+		354, // CastOperand
+		350, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S194
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S195
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S196
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S197
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		355, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S198
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		356, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S199
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S200
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		358, // Expr2R
+		80,  // Expr3R
+		82,  // Expr5L
+		83,  // Expr9L
+		84,  // Expr10L
+		85,  // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S201
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		359, // Expr
+		298, // Expr1L
+		296, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S202
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		360, // Expr9L
+		84,  // Expr10L
+		85,  // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S203
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		361, // Expr10L
+		85,  // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S204
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		362, // Expr10L
+		85,  // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S205
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		363, // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S206
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		364, // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S207
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		365, // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S208
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		366, // Expr12L
+		86,  // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S209
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		367, // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S210
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		368, // Expr13L
+		88,  // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S211
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		369, // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S212
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		370, // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S213
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S214
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S215
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S216
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S217
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		371, // Expr14
+		92,  // SizeofExpr
+		91,  // CastExpr
+		-1,  // CastOperand
+		89,  // Expr15
+		95,  // PrimaryExpr
+		94,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S218
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S219
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S220
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S221
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S222
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		375, // Expr3R
+		376, // Expr5L
+		377, // Expr9L
+		378, // Expr10L
+		379, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S223
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S224
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		392, // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S225
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S226
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S227
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S228
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S229
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		397, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S230
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S231
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S232
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S233
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S234
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S235
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S236
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S237
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S238
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S239
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		413, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S240
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S241
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S242
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		414, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S243
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S244
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S245
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		415, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S246
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		419, // SizeofExpr
+		418, // CastExpr
+		420, // CastOperand
+		416, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S247
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S248
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S249
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S250
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		421, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S251
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		422, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S252
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S253
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S254
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		424, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S255
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		425, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S256
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		426, // Expr
+		298, // Expr1L
+		296, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S257
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		427, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S258
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		428, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S259
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		429, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S260
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		430, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S261
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		431, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S262
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		432, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S263
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		433, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S264
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		434, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S265
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		435, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S266
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		436, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S267
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		437, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S268
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S269
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S270
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S271
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S272
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		438, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S273
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S274
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S275
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S276
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S277
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S278
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S279
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S280
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S281
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		33,  // Decl
+		37,  // FuncDecl
+		41,  // FuncHeader
+		38,  // FuncDef
+		34,  // VarDecl
+		14,  // ScalarDecl
+		15,  // ArrayDecl
+		36,  // VarDef
+		39,  // TypeDef
+		12,  // BasicType
+		40,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		48,  // Stmt
+		51,  // OtherStmt
+		44,  // BlockStmt
+		49,  // MatchedStmt
+		50,  // OpenStmt
+		-1,  // Condition
+		440, // BlockItems
+		57,  // BlockItemList
+		58,  // BlockItem
+		52,  // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S282
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S283
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S284
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S285
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S286
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		444, // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S287
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		445, // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S288
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		446, // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S289
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S290
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S291
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S292
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S293
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S294
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		449, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S295
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S296
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S297
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S298
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S299
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S300
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S301
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S302
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S303
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S304
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		465, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S305
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S306
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S307
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		466, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S308
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S309
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S310
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		467, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S311
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		471, // SizeofExpr
+		470, // CastExpr
+		472, // CastOperand
+		468, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S312
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S313
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S314
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S315
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S316
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S317
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S318
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S319
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S320
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S321
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S322
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S323
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S324
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S325
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S326
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S327
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S328
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S329
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S330
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		167, // VarDecl
+		171, // ScalarDecl
+		172, // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		168, // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		474, // Param
+		173, // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		-1,  // SizeofExpr
+		-1,  // CastExpr
+		-1,  // CastOperand
+		-1,  // Expr15
+		-1,  // PrimaryExpr
+		-1,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S331
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		475, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S332
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		476, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S333
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S334
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S335
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		478, // Expr
+		298, // Expr1L
+		296, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S336
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		479, // Expr9L
+		183, // Expr10L
+		184, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S337
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		480, // Expr10L
+		184, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S338
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		481, // Expr10L
+		184, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S339
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		482, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S340
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		483, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S341
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		484, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S342
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		485, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S343
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		486, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S344
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		487, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S345
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		488, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S346
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		489, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S347
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S348
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S349
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S350
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S351
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		490, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S352
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S353
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S354
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S355
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S356
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S357
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S358
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S359
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S360
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S361
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S362
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S363
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S364
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S365
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S366
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S367
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S368
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S369
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S370
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S371
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S372
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S373
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S374
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		496, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S375
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S376
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S377
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S378
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S379
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S380
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S381
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		509, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S382
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S383
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S384
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		510, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S385
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S386
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S387
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		511, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S388
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		515, // SizeofExpr
+		514, // CastExpr
+		516, // CastOperand
+		512, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S389
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S390
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S391
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S392
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S393
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S394
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		517, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S395
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		518, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S396
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		519, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S397
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S398
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S399
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		521, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S400
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		522, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S401
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		523, // Expr
+		298, // Expr1L
+		296, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S402
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		524, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S403
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		525, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S404
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		526, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S405
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		527, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S406
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		528, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S407
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		529, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S408
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		530, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S409
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		531, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S410
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		532, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S411
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		533, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S412
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		534, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S413
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S414
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S415
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S416
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S417
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		535, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S418
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S419
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S420
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S421
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S422
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S423
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S424
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S425
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S426
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S427
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S428
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S429
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S430
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S431
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S432
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S433
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S434
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S435
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S436
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S437
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S438
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S439
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S440
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S441
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		51,  // OtherStmt
+		44,  // BlockStmt
+		540, // MatchedStmt
+		541, // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		52,  // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S442
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S443
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S444
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S445
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		282, // Stmt
+		284, // OtherStmt
+		280, // BlockStmt
+		543, // MatchedStmt
+		50,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		285, // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S446
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		284, // OtherStmt
+		280, // BlockStmt
+		544, // MatchedStmt
+		290, // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		285, // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S447
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		545, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S448
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		546, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S449
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S450
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		550, // Expr3R
+		551, // Expr5L
+		552, // Expr9L
+		553, // Expr10L
+		554, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S451
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		567, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S452
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		568, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S453
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		569, // Expr
+		298, // Expr1L
+		296, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S454
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		570, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S455
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		571, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S456
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		572, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S457
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		573, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S458
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		574, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S459
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		575, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S460
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		576, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S461
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		577, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S462
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		578, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S463
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		579, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S464
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		580, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S465
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S466
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S467
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S468
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S469
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		581, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S470
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S471
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S472
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S473
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		582, // Expr3R
+		181, // Expr5L
+		182, // Expr9L
+		183, // Expr10L
+		184, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S474
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S475
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S476
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S477
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S478
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S479
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S480
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S481
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S482
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S483
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S484
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S485
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S486
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S487
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S488
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S489
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S490
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S491
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S492
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S493
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		589, // Expr3R
+		590, // Expr5L
+		591, // Expr9L
+		592, // Expr10L
+		593, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S494
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		606, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S495
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		607, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S496
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S497
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		609, // Expr
+		298, // Expr1L
+		296, // Expr2R
+		295, // Expr3R
+		299, // Expr5L
+		300, // Expr9L
+		301, // Expr10L
+		302, // Expr12L
+		303, // Expr13L
+		305, // Expr14
+		309, // SizeofExpr
+		308, // CastExpr
+		-1,  // CastOperand
+		306, // Expr15
+		312, // PrimaryExpr
+		311, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S498
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		610, // Expr9L
+		378, // Expr10L
+		379, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S499
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		611, // Expr10L
+		379, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S500
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		612, // Expr10L
+		379, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S501
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		613, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S502
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		614, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S503
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		615, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S504
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		616, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S505
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		617, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S506
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		618, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S507
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		619, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S508
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		620, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S509
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S510
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S511
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S512
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S513
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		621, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S514
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S515
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S516
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S517
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S518
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S519
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S520
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S521
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S522
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S523
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S524
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S525
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S526
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S527
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S528
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S529
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S530
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S531
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S532
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S533
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S534
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S535
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S536
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S537
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S538
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		627, // Expr3R
+		628, // Expr5L
+		629, // Expr9L
+		630, // Expr10L
+		631, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S539
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S540
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S541
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S542
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S543
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S544
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S545
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S546
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S547
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S548
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S549
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		649, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S550
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S551
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S552
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S553
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S554
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S555
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S556
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		661, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S557
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S558
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S559
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		662, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S560
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S561
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S562
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		663, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S563
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		667, // SizeofExpr
+		666, // CastExpr
+		668, // CastOperand
+		664, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S564
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S565
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S566
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S567
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S568
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S569
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S570
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S571
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S572
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S573
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S574
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S575
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S576
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S577
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S578
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S579
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S580
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S581
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S582
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S583
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S584
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S585
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S586
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		671, // Expr3R
+		181, // Expr5L
+		182, // Expr9L
+		183, // Expr10L
+		184, // Expr12L
+		185, // Expr13L
+		187, // Expr14
+		191, // SizeofExpr
+		190, // CastExpr
+		-1,  // CastOperand
+		188, // Expr15
+		194, // PrimaryExpr
+		193, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S587
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S588
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		674, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S589
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S590
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S591
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S592
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S593
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S594
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S595
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		686, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S596
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S597
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S598
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		687, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S599
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S600
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S601
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		688, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S602
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		692, // SizeofExpr
+		691, // CastExpr
+		693, // CastOperand
+		689, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S603
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S604
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S605
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S606
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S607
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S608
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S609
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S610
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S611
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S612
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S613
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S614
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S615
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S616
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S617
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S618
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S619
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S620
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S621
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S622
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S623
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S624
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		699, // Expr3R
+		700, // Expr5L
+		701, // Expr9L
+		702, // Expr10L
+		703, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S625
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S626
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		718, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S627
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S628
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S629
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S630
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S631
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S632
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S633
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		730, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S634
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S635
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S636
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		731, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S637
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S638
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S639
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		732, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S640
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		736, // SizeofExpr
+		735, // CastExpr
+		737, // CastOperand
+		733, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S641
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S642
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S643
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S644
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		284, // OtherStmt
+		280, // BlockStmt
+		738, // MatchedStmt
+		541, // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		285, // Expr
+		59,  // Expr1L
+		46,  // Expr2R
+		45,  // Expr3R
+		60,  // Expr5L
+		61,  // Expr9L
+		62,  // Expr10L
+		63,  // Expr12L
+		64,  // Expr13L
+		66,  // Expr14
+		70,  // SizeofExpr
+		69,  // CastExpr
+		-1,  // CastOperand
+		67,  // Expr15
+		73,  // PrimaryExpr
+		72,  // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S645
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S646
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S647
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
 		-1,  // Condition
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		193, // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S98
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		739, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S648
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4236,9 +33718,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4252,22 +33738,79 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		194, // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		740, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S99
+	gotoRow{ // S649
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S650
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4279,9 +33822,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4296,21 +33843,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
-		-1,  // Expr9L
-		195, // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		742, // Expr9L
+		553, // Expr10L
+		554, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S100
+	gotoRow{ // S651
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4322,9 +33874,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4339,21 +33895,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
-		-1,  // Expr10L
-		196, // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		743, // Expr10L
+		554, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S101
+	gotoRow{ // S652
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4365,9 +33926,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4382,21 +33947,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
-		-1,  // Expr10L
-		197, // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		744, // Expr10L
+		554, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S102
+	gotoRow{ // S653
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4408,9 +33978,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4425,21 +33999,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		198, // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		745, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S103
+	gotoRow{ // S654
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4451,9 +34030,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4468,21 +34051,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		199, // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		746, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S104
+	gotoRow{ // S655
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4494,9 +34082,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4511,21 +34103,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		-1,  // Expr12L
-		200, // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		747, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S105
+	gotoRow{ // S656
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4537,9 +34134,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4554,21 +34155,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		-1,  // Expr12L
-		201, // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		748, // Expr12L
+		555, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S106
+	gotoRow{ // S657
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4580,9 +34186,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4597,21 +34207,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
-		-1,  // Expr13L
-		202, // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		749, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S107
+	gotoRow{ // S658
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -4623,9 +34238,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -4640,193 +34259,130 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
-		-1,  // Expr13L
-		203, // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		750, // Expr13L
+		557, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
-	},
-	gotoRow{ // S108
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S109
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S110
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	},
+	gotoRow{ // S659
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		751, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S111
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S660
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		752, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S112
+	gotoRow{ // S661
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -4838,9 +34394,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -4855,13 +34415,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -4869,7 +34434,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S113
+	gotoRow{ // S662
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -4881,9 +34446,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -4898,13 +34467,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -4912,7 +34486,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S114
+	gotoRow{ // S663
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -4924,9 +34498,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -4941,13 +34519,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -4955,7 +34538,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S115
+	gotoRow{ // S664
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -4967,9 +34550,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -4984,13 +34571,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -4998,50 +34590,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S116
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S665
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		753, // Expr14
+		561, // SizeofExpr
+		560, // CastExpr
+		-1,  // CastOperand
+		558, // Expr15
+		564, // PrimaryExpr
+		563, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S117
+	gotoRow{ // S666
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5053,9 +34654,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5070,13 +34675,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5084,7 +34694,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S118
+	gotoRow{ // S667
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5096,9 +34706,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5113,13 +34727,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5127,7 +34746,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S119
+	gotoRow{ // S668
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5139,9 +34758,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5156,13 +34779,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5170,50 +34798,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S120
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S669
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		756, // Expr3R
+		757, // Expr5L
+		758, // Expr9L
+		759, // Expr10L
+		760, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S121
+	gotoRow{ // S670
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5225,9 +34862,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5242,13 +34883,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5256,7 +34902,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S122
+	gotoRow{ // S671
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5268,9 +34914,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5285,13 +34935,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5299,7 +34954,111 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S123
+	gotoRow{ // S672
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		773, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S673
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		774, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S674
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5311,9 +35070,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5328,13 +35091,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5342,7 +35110,319 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S124
+	gotoRow{ // S675
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		776, // Expr9L
+		592, // Expr10L
+		593, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S676
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		777, // Expr10L
+		593, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S677
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		778, // Expr10L
+		593, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S678
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		779, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S679
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		780, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S680
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		781, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S681
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -5354,9 +35434,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -5370,323 +35454,235 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		210, // Expr
-		74,  // Expr2R
-		75,  // Expr5L
-		76,  // Expr9L
-		77,  // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		782, // Expr12L
+		594, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S125
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S126
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S127
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S128
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S129
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S682
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		783, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S130
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S683
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		784, // Expr13L
+		596, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S131
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S684
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		785, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S132
+	gotoRow{ // S685
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		786, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S686
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5698,9 +35694,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5715,13 +35715,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5729,7 +35734,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S133
+	gotoRow{ // S687
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5741,81 +35746,47 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
 		-1, // Type
 		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S134
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		223, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S135
+	gotoRow{ // S688
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5827,9 +35798,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5844,13 +35819,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5858,7 +35838,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S136
+	gotoRow{ // S689
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5870,9 +35850,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5887,13 +35871,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5901,7 +35890,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S137
+	gotoRow{ // S690
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -5913,9 +35902,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -5930,21 +35923,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
 		-1,  // Expr13L
-		224, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
+		787, // Expr14
+		600, // SizeofExpr
+		599, // CastExpr
+		-1,  // CastOperand
+		597, // Expr15
+		603, // PrimaryExpr
+		602, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S138
+	gotoRow{ // S691
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5956,9 +35954,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -5973,13 +35975,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -5987,7 +35994,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S139
+	gotoRow{ // S692
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -5999,9 +36006,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6016,13 +36027,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6030,7 +36046,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S140
+	gotoRow{ // S693
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6042,9 +36058,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6059,13 +36079,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6073,7 +36098,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S141
+	gotoRow{ // S694
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6085,9 +36110,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6102,13 +36131,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6116,7 +36150,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S142
+	gotoRow{ // S695
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6128,9 +36162,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6145,13 +36183,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6159,7 +36202,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S143
+	gotoRow{ // S696
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -6171,9 +36214,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -6187,22 +36234,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		229, // Expr
-		74,  // Expr2R
-		75,  // Expr5L
-		76,  // Expr9L
-		77,  // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		788, // Expr3R
+		376, // Expr5L
+		377, // Expr9L
+		378, // Expr10L
+		379, // Expr12L
+		380, // Expr13L
+		382, // Expr14
+		386, // SizeofExpr
+		385, // CastExpr
+		-1,  // CastOperand
+		383, // Expr15
+		389, // PrimaryExpr
+		388, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S144
+	gotoRow{ // S697
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6214,9 +36266,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6231,13 +36287,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6245,7 +36306,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S145
+	gotoRow{ // S698
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		791, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S699
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6257,9 +36370,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6274,13 +36391,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6288,7 +36410,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S146
+	gotoRow{ // S700
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6300,9 +36422,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6317,13 +36443,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6331,7 +36462,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S147
+	gotoRow{ // S701
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6343,9 +36474,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6360,13 +36495,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6374,7 +36514,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S148
+	gotoRow{ // S702
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6386,9 +36526,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6403,13 +36547,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6417,7 +36566,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S149
+	gotoRow{ // S703
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6429,9 +36578,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6446,13 +36599,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6460,7 +36618,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S150
+	gotoRow{ // S704
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6472,9 +36630,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6489,13 +36651,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6503,7 +36670,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S151
+	gotoRow{ // S705
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		803, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S706
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6515,9 +36734,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6532,13 +36755,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6546,7 +36774,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S152
+	gotoRow{ // S707
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6558,9 +36786,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6575,13 +36807,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6589,7 +36826,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S153
+	gotoRow{ // S708
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -6601,9 +36838,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -6618,21 +36859,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
 		-1,  // Expr13L
-		243, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		804, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S154
+	gotoRow{ // S709
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6644,9 +36890,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6661,13 +36911,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6675,7 +36930,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S155
+	gotoRow{ // S710
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6687,9 +36942,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6704,13 +36963,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6718,7 +36982,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S156
+	gotoRow{ // S711
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -6730,9 +36994,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -6747,21 +37015,78 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
 		-1,  // Expr13L
-		244, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		805, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S157
+	gotoRow{ // S712
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		809, // SizeofExpr
+		808, // CastExpr
+		810, // CastOperand
+		806, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S713
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6773,9 +37098,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6790,13 +37119,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6804,7 +37138,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S158
+	gotoRow{ // S714
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6816,9 +37150,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -6833,13 +37171,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -6847,7 +37190,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S159
+	gotoRow{ // S715
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S716
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -6859,9 +37254,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -6875,22 +37274,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		127, // Expr
-		128, // Expr2R
-		129, // Expr5L
-		130, // Expr9L
-		131, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		245, // Args
-		141, // ExprList
-
-	},
-	gotoRow{ // S160
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		811, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S717
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -6902,9 +37306,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -6918,65 +37326,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		246, // Expr
-		147, // Expr2R
-		148, // Expr5L
-		149, // Expr9L
-		150, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		812, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S161
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S162
+	gotoRow{ // S718
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -6988,9 +37358,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7005,13 +37379,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7019,7 +37398,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S163
+	gotoRow{ // S719
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7031,9 +37410,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7048,21 +37431,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
-		248, // Expr2R
-		75,  // Expr5L
-		76,  // Expr9L
-		77,  // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		814, // Expr9L
+		630, // Expr10L
+		631, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S164
+	gotoRow{ // S720
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7074,9 +37462,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7091,21 +37483,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
-		249, // Expr9L
-		77,  // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		-1,  // Expr9L
+		815, // Expr10L
+		631, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S165
+	gotoRow{ // S721
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7117,9 +37514,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7134,21 +37535,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
-		250, // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		816, // Expr10L
+		631, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S166
+	gotoRow{ // S722
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7160,9 +37566,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7177,21 +37587,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
-		251, // Expr10L
-		78,  // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		-1,  // Expr10L
+		817, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S167
+	gotoRow{ // S723
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7203,9 +37618,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7220,21 +37639,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		252, // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		818, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S168
+	gotoRow{ // S724
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7246,9 +37670,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7263,21 +37691,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		253, // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		819, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S169
+	gotoRow{ // S725
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7289,9 +37722,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7306,21 +37743,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		254, // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		820, // Expr12L
+		632, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S170
+	gotoRow{ // S726
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7332,9 +37774,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7349,21 +37795,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		255, // Expr12L
-		79,  // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		-1,  // Expr12L
+		821, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S171
+	gotoRow{ // S727
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7375,9 +37826,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7392,21 +37847,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
-		256, // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		822, // Expr13L
+		634, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S172
+	gotoRow{ // S728
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7418,9 +37878,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7435,21 +37899,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
-		257, // Expr13L
-		81,  // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		-1,  // Expr13L
+		823, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S173
+	gotoRow{ // S729
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7461,9 +37930,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7478,21 +37951,234 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
 		-1,  // Expr13L
-		258, // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		824, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S174
+	gotoRow{ // S730
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S731
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S732
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S733
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S734
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -7504,9 +38190,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -7521,21 +38211,182 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
 		-1,  // Expr13L
-		259, // Expr14
-		82,  // Expr15
-		84,  // PrimaryExpr
-		85,  // ParenExpr
+		825, // Expr14
+		638, // SizeofExpr
+		637, // CastExpr
+		-1,  // CastOperand
+		635, // Expr15
+		641, // PrimaryExpr
+		640, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S175
+	gotoRow{ // S735
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S736
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S737
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S738
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7547,9 +38398,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7564,13 +38419,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7578,7 +38438,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S176
+	gotoRow{ // S739
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7590,9 +38450,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7607,13 +38471,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7621,7 +38490,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S177
+	gotoRow{ // S740
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7633,9 +38502,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7650,13 +38523,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7664,7 +38542,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S178
+	gotoRow{ // S741
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7676,9 +38554,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7693,13 +38575,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7707,7 +38594,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S179
+	gotoRow{ // S742
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7719,9 +38606,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7736,13 +38627,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7750,7 +38646,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S180
+	gotoRow{ // S743
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7762,9 +38658,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7779,13 +38679,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7793,7 +38698,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S181
+	gotoRow{ // S744
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7805,9 +38710,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7822,13 +38731,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7836,7 +38750,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S182
+	gotoRow{ // S745
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7848,9 +38762,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7865,13 +38783,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7879,7 +38802,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S183
+	gotoRow{ // S746
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7891,9 +38814,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7908,13 +38835,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7922,7 +38854,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S184
+	gotoRow{ // S747
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7934,9 +38866,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7951,13 +38887,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -7965,7 +38906,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S185
+	gotoRow{ // S748
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -7977,9 +38918,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -7994,13 +38939,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8008,179 +38958,163 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S186
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		264, // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S187
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		24,  // Decl
-		27,  // FuncDecl
-		30,  // FuncHeader
-		28,  // FuncDef
-		25,  // VarDecl
-		12,  // ScalarDecl
-		13,  // ArrayDecl
-		-1,  // IntLit
-		29,  // TypeDef
-		10,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		36,  // Stmt
-		39,  // OtherStmt
-		33,  // BlockStmt
-		37,  // MatchedStmt
-		38,  // OpenStmt
-		-1,  // Condition
-		265, // BlockItems
-		46,  // BlockItemList
-		47,  // BlockItem
-		40,  // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S749
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S188
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		266, // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		-1,  // Expr14
-		-1,  // Expr15
-		-1,  // PrimaryExpr
-		-1,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S750
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S189
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		267, // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		-1,  // Expr14
-		-1,  // Expr15
-		-1,  // PrimaryExpr
-		-1,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S751
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S190
+	gotoRow{ // S752
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8192,9 +39126,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8209,13 +39147,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8223,7 +39166,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S191
+	gotoRow{ // S753
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8235,9 +39178,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8252,13 +39199,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8266,7 +39218,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S192
+	gotoRow{ // S754
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8278,9 +39230,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8295,13 +39251,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8309,7 +39270,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S193
+	gotoRow{ // S755
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		830, // Expr
+		118, // Expr1L
+		116, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S756
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8321,9 +39334,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8338,13 +39355,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8352,7 +39374,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S194
+	gotoRow{ // S757
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8364,9 +39386,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8381,13 +39407,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8395,7 +39426,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S195
+	gotoRow{ // S758
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8407,9 +39438,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8424,13 +39459,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8438,7 +39478,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S196
+	gotoRow{ // S759
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8450,9 +39490,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8467,13 +39511,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8481,7 +39530,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S197
+	gotoRow{ // S760
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8493,9 +39542,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8510,13 +39563,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8524,7 +39582,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S198
+	gotoRow{ // S761
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8536,9 +39594,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8553,13 +39615,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8567,7 +39634,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S199
+	gotoRow{ // S762
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		842, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S763
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8579,9 +39698,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8596,13 +39719,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8610,7 +39738,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S200
+	gotoRow{ // S764
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8622,9 +39750,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8639,13 +39771,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8653,7 +39790,111 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S201
+	gotoRow{ // S765
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		843, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S766
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S767
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8665,9 +39906,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8682,13 +39927,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8696,7 +39946,111 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S202
+	gotoRow{ // S768
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		844, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S769
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		-1,  // Expr14
+		848, // SizeofExpr
+		847, // CastExpr
+		849, // CastOperand
+		845, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S770
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8708,9 +40062,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8725,13 +40083,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8739,7 +40102,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S203
+	gotoRow{ // S771
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8751,9 +40114,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8768,13 +40135,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8782,7 +40154,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S204
+	gotoRow{ // S772
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8794,9 +40166,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8811,13 +40187,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8825,7 +40206,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S205
+	gotoRow{ // S773
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8837,9 +40218,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8854,13 +40239,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8868,50 +40258,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S206
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		110, // VarDecl
-		114, // ScalarDecl
-		115, // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		111, // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		269, // Param
-		116, // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		-1,  // Expr14
-		-1,  // Expr15
-		-1,  // PrimaryExpr
-		-1,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S207
+	gotoRow{ // S774
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -8923,9 +40270,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -8940,13 +40291,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -8954,93 +40310,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S208
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		127, // Expr
-		128, // Expr2R
-		129, // Expr5L
-		130, // Expr9L
-		131, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		270, // Args
-		141, // ExprList
-
-	},
-	gotoRow{ // S209
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		271, // Expr
-		147, // Expr2R
-		148, // Expr5L
-		149, // Expr9L
-		150, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S210
+	gotoRow{ // S775
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -9052,9 +40322,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -9066,540 +40340,549 @@ var gotoTab = gotoTable{
 		-1, // OpenStmt
 		-1, // Condition
 		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S211
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		273, // Expr2R
-		129, // Expr5L
-		130, // Expr9L
-		131, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S212
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		274, // Expr9L
-		131, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S213
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		275, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S214
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		276, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S215
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		277, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S776
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S216
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		278, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S777
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S217
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		279, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S778
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S779
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S218
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		280, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S780
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S219
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		281, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S781
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S220
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		282, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S782
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S783
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S221
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		283, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S784
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S222
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		284, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S785
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S223
+	gotoRow{ // S786
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -9611,9 +40894,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -9628,13 +40915,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -9642,7 +40934,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S224
+	gotoRow{ // S787
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S788
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -9654,9 +40998,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -9671,13 +41019,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -9685,7 +41038,111 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S225
+	gotoRow{ // S789
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		852, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S790
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		853, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S791
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -9697,9 +41154,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -9714,13 +41175,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -9728,7 +41194,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S226
+	gotoRow{ // S792
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -9740,9 +41206,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -9756,22 +41226,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		285, // Expr
-		128, // Expr2R
-		129, // Expr5L
-		130, // Expr9L
-		131, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		855, // Expr9L
+		702, // Expr10L
+		703, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S227
+	gotoRow{ // S793
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -9783,9 +41258,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -9799,22 +41278,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		127, // Expr
-		128, // Expr2R
-		129, // Expr5L
-		130, // Expr9L
-		131, // Expr10L
-		132, // Expr12L
-		133, // Expr13L
-		135, // Expr14
-		136, // Expr15
-		138, // PrimaryExpr
-		140, // ParenExpr
-		286, // Args
-		141, // ExprList
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		856, // Expr10L
+		703, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S228
+	gotoRow{ // S794
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -9826,9 +41310,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -9842,108 +41330,27 @@ var gotoTab = gotoTable{
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		287, // Expr
-		147, // Expr2R
-		148, // Expr5L
-		149, // Expr9L
-		150, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		857, // Expr10L
+		703, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S229
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S230
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S231
+	gotoRow{ // S795
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -9955,9 +41362,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -9972,21 +41383,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
-		289, // Expr2R
-		148, // Expr5L
-		149, // Expr9L
-		150, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		858, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S232
+	gotoRow{ // S796
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -9998,9 +41414,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10015,21 +41435,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
-		290, // Expr9L
-		150, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr9L
+		-1,  // Expr10L
+		859, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S233
+	gotoRow{ // S797
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -10041,9 +41466,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10058,21 +41487,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
-		291, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr10L
+		860, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S234
+	gotoRow{ // S798
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -10084,9 +41518,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10101,21 +41539,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
-		292, // Expr10L
-		151, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr10L
+		861, // Expr12L
+		704, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S235
+	gotoRow{ // S799
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -10127,9 +41570,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10144,21 +41591,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		293, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr12L
+		862, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S236
+	gotoRow{ // S800
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -10170,9 +41622,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10187,21 +41643,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		294, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr12L
+		863, // Expr13L
+		706, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S237
+	gotoRow{ // S801
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -10213,9 +41674,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10230,21 +41695,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		295, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr12L
+		-1,  // Expr13L
+		864, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S238
+	gotoRow{ // S802
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -10256,9 +41726,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10273,64 +41747,234 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
-		296, // Expr12L
-		152, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr12L
+		-1,  // Expr13L
+		865, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S239
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		297, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S803
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S804
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S805
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S806
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S240
+	gotoRow{ // S807
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -10342,9 +41986,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -10359,107 +42007,182 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
-		298, // Expr13L
-		154, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
+		-1,  // Expr13L
+		866, // Expr14
+		710, // SizeofExpr
+		709, // CastExpr
+		-1,  // CastOperand
+		707, // Expr15
+		713, // PrimaryExpr
+		712, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S241
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		299, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S808
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S242
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		-1,  // OtherStmt
-		-1,  // BlockStmt
-		-1,  // MatchedStmt
-		-1,  // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		-1,  // Expr
-		-1,  // Expr2R
-		-1,  // Expr5L
-		-1,  // Expr9L
-		-1,  // Expr10L
-		-1,  // Expr12L
-		-1,  // Expr13L
-		300, // Expr14
-		155, // Expr15
-		157, // PrimaryExpr
-		158, // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
+	gotoRow{ // S809
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
+
+	},
+	gotoRow{ // S810
+		-1, // S'
+		-1, // File
+		-1, // Decls
+		-1, // DeclList
+		-1, // Decl
+		-1, // FuncDecl
+		-1, // FuncHeader
+		-1, // FuncDef
+		-1, // VarDecl
+		-1, // ScalarDecl
+		-1, // ArrayDecl
+		-1, // VarDef
+		-1, // TypeDef
+		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
+		-1, // Params
+		-1, // ParamList
+		-1, // Param
+		-1, // Type
+		-1, // Stmt
+		-1, // OtherStmt
+		-1, // BlockStmt
+		-1, // MatchedStmt
+		-1, // OpenStmt
+		-1, // Condition
+		-1, // BlockItems
+		-1, // BlockItemList
+		-1, // BlockItem
+		-1, // Expr
+		-1, // Expr1L
+		-1, // Expr2R
+		-1, // Expr3R
+		-1, // Expr5L
+		-1, // Expr9L
+		-1, // Expr10L
+		-1, // Expr12L
+		-1, // Expr13L
+		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
+		-1, // Expr15
+		-1, // PrimaryExpr
+		-1, // ParenExpr
+		-1, // Args
+		-1, // ExprList
 
 	},
-	gotoRow{ // S243
+	gotoRow{ // S811
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10471,9 +42194,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10488,13 +42215,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10502,7 +42234,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S244
+	gotoRow{ // S812
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10514,9 +42246,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10531,13 +42267,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10545,7 +42286,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S245
+	gotoRow{ // S813
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10557,9 +42298,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10574,13 +42319,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10588,7 +42338,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S246
+	gotoRow{ // S814
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10600,9 +42350,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10617,13 +42371,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10631,7 +42390,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S247
+	gotoRow{ // S815
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10643,9 +42402,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10660,13 +42423,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10674,7 +42442,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S248
+	gotoRow{ // S816
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10686,9 +42454,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10703,13 +42475,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10717,7 +42494,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S249
+	gotoRow{ // S817
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10729,9 +42506,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10746,13 +42527,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10760,7 +42546,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S250
+	gotoRow{ // S818
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10772,9 +42558,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10789,13 +42579,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10803,7 +42598,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S251
+	gotoRow{ // S819
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10815,9 +42610,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10832,13 +42631,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10846,7 +42650,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S252
+	gotoRow{ // S820
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10858,9 +42662,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10875,13 +42683,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10889,7 +42702,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S253
+	gotoRow{ // S821
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10901,9 +42714,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10918,13 +42735,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10932,7 +42754,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S254
+	gotoRow{ // S822
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10944,9 +42766,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -10961,13 +42787,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -10975,7 +42806,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S255
+	gotoRow{ // S823
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -10987,9 +42818,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11004,13 +42839,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11018,7 +42858,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S256
+	gotoRow{ // S824
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11030,9 +42870,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11047,13 +42891,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11061,7 +42910,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S257
+	gotoRow{ // S825
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11073,9 +42922,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11090,13 +42943,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11104,7 +42962,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S258
+	gotoRow{ // S826
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11116,9 +42974,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11133,13 +42995,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11147,7 +43014,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S259
+	gotoRow{ // S827
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11159,9 +43026,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11176,13 +43047,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11190,7 +43066,111 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S260
+	gotoRow{ // S828
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		225, // Expr2R
+		115, // Expr3R
+		119, // Expr5L
+		120, // Expr9L
+		121, // Expr10L
+		122, // Expr12L
+		123, // Expr13L
+		125, // Expr14
+		129, // SizeofExpr
+		128, // CastExpr
+		-1,  // CastOperand
+		126, // Expr15
+		132, // PrimaryExpr
+		131, // ParenExpr
+		869, // Args
+		227, // ExprList
+
+	},
+	gotoRow{ // S829
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		870, // Expr
+		233, // Expr1L
+		231, // Expr2R
+		230, // Expr3R
+		234, // Expr5L
+		235, // Expr9L
+		236, // Expr10L
+		237, // Expr12L
+		238, // Expr13L
+		240, // Expr14
+		244, // SizeofExpr
+		243, // CastExpr
+		-1,  // CastOperand
+		241, // Expr15
+		247, // PrimaryExpr
+		246, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S830
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11202,9 +43182,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11219,13 +43203,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11233,7 +43222,267 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S261
+	gotoRow{ // S831
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		872, // Expr9L
+		759, // Expr10L
+		760, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S832
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		873, // Expr10L
+		760, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S833
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		874, // Expr10L
+		760, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S834
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		875, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S835
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		876, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
+
+	},
+	gotoRow{ // S836
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -11245,210 +43494,151 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
 		-1,  // Type
 		-1,  // Stmt
-		39,  // OtherStmt
-		33,  // BlockStmt
-		303, // MatchedStmt
-		304, // OpenStmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
 		-1,  // Condition
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		40,  // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		877, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S262
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S263
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S264
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S837
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		878, // Expr12L
+		761, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S265
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S838
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		879, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S266
+	gotoRow{ // S839
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -11460,38 +43650,47 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
 		-1,  // Type
-		182, // Stmt
-		184, // OtherStmt
-		181, // BlockStmt
-		307, // MatchedStmt
-		38,  // OpenStmt
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
 		-1,  // Condition
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		185, // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		880, // Expr13L
+		763, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S267
+	gotoRow{ // S840
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -11503,38 +43702,47 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		-1,  // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
 		-1,  // Type
 		-1,  // Stmt
-		184, // OtherStmt
-		181, // BlockStmt
-		308, // MatchedStmt
-		191, // OpenStmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
 		-1,  // Condition
 		-1,  // BlockItems
 		-1,  // BlockItemList
 		-1,  // BlockItem
-		185, // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		881, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S268
+	gotoRow{ // S841
 		-1,  // S'
 		-1,  // File
 		-1,  // Decls
@@ -11546,9 +43754,13 @@ var gotoTab = gotoTable{
 		-1,  // VarDecl
 		-1,  // ScalarDecl
 		-1,  // ArrayDecl
-		309, // IntLit
+		-1,  // VarDef
 		-1,  // TypeDef
 		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
 		-1,  // Params
 		-1,  // ParamList
 		-1,  // Param
@@ -11563,107 +43775,26 @@ var gotoTab = gotoTable{
 		-1,  // BlockItemList
 		-1,  // BlockItem
 		-1,  // Expr
+		-1,  // Expr1L
 		-1,  // Expr2R
+		-1,  // Expr3R
 		-1,  // Expr5L
 		-1,  // Expr9L
 		-1,  // Expr10L
 		-1,  // Expr12L
 		-1,  // Expr13L
-		-1,  // Expr14
-		-1,  // Expr15
-		-1,  // PrimaryExpr
-		-1,  // ParenExpr
+		882, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
 		-1,  // Args
 		-1,  // ExprList
 
 	},
-	gotoRow{ // S269
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S270
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
-
-	},
-	gotoRow{ // S271
+	gotoRow{ // S842
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11675,9 +43806,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11692,13 +43827,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11706,7 +43846,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S272
+	gotoRow{ // S843
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11718,9 +43858,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11735,13 +43879,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11749,7 +43898,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S273
+	gotoRow{ // S844
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11761,9 +43910,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11778,13 +43931,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11792,7 +43950,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S274
+	gotoRow{ // S845
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11804,9 +43962,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11821,13 +43983,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11835,50 +44002,59 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S275
-		-1, // S'
-		-1, // File
-		-1, // Decls
-		-1, // DeclList
-		-1, // Decl
-		-1, // FuncDecl
-		-1, // FuncHeader
-		-1, // FuncDef
-		-1, // VarDecl
-		-1, // ScalarDecl
-		-1, // ArrayDecl
-		-1, // IntLit
-		-1, // TypeDef
-		-1, // BasicType
-		-1, // Params
-		-1, // ParamList
-		-1, // Param
-		-1, // Type
-		-1, // Stmt
-		-1, // OtherStmt
-		-1, // BlockStmt
-		-1, // MatchedStmt
-		-1, // OpenStmt
-		-1, // Condition
-		-1, // BlockItems
-		-1, // BlockItemList
-		-1, // BlockItem
-		-1, // Expr
-		-1, // Expr2R
-		-1, // Expr5L
-		-1, // Expr9L
-		-1, // Expr10L
-		-1, // Expr12L
-		-1, // Expr13L
-		-1, // Expr14
-		-1, // Expr15
-		-1, // PrimaryExpr
-		-1, // ParenExpr
-		-1, // Args
-		-1, // ExprList
+	gotoRow{ // S846
+		-1,  // S'
+		-1,  // File
+		-1,  // Decls
+		-1,  // DeclList
+		-1,  // Decl
+		-1,  // FuncDecl
+		-1,  // FuncHeader
+		-1,  // FuncDef
+		-1,  // VarDecl
+		-1,  // ScalarDecl
+		-1,  // ArrayDecl
+		-1,  // VarDef
+		-1,  // TypeDef
+		-1,  // BasicType
+		-1,  // EnumDecl
+		-1,  // Enumerators
+		-1,  // EnumeratorList
+		-1,  // Enumerator
+		-1,  // Params
+		-1,  // ParamList
+		-1,  // Param
+		-1,  // Type
+		-1,  // Stmt
+		-1,  // OtherStmt
+		-1,  // BlockStmt
+		-1,  // MatchedStmt
+		-1,  // OpenStmt
+		-1,  // Condition
+		-1,  // BlockItems
+		-1,  // BlockItemList
+		-1,  // BlockItem
+		-1,  // Expr
+		-1,  // Expr1L
+		-1,  // Expr2R
+		-1,  // Expr3R
+		-1,  // Expr5L
+		-1,  // Expr9L
+		-1,  // Expr10L
+		-1,  // Expr12L
+		-1,  // Expr13L
+		883, // Expr14
+		767, // SizeofExpr
+		766, // CastExpr
+		-1,  // CastOperand
+		764, // Expr15
+		770, // PrimaryExpr
+		769, // ParenExpr
+		-1,  // Args
+		-1,  // ExprList
 
 	},
-	gotoRow{ // S276
+	gotoRow{ // S847
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11890,9 +44066,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11907,13 +44087,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11921,7 +44106,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S277
+	gotoRow{ // S848
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11933,9 +44118,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11950,13 +44139,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11964,7 +44158,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S278
+	gotoRow{ // S849
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -11976,9 +44170,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -11993,13 +44191,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12007,7 +44210,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S279
+	gotoRow{ // S850
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12019,9 +44222,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12036,13 +44243,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12050,7 +44262,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S280
+	gotoRow{ // S851
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12062,9 +44274,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12079,13 +44295,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12093,7 +44314,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S281
+	gotoRow{ // S852
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12105,9 +44326,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12122,13 +44347,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12136,7 +44366,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S282
+	gotoRow{ // S853
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12148,9 +44378,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12165,13 +44399,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12179,7 +44418,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S283
+	gotoRow{ // S854
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12191,9 +44430,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12208,13 +44451,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12222,7 +44470,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S284
+	gotoRow{ // S855
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12234,9 +44482,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12251,13 +44503,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12265,7 +44522,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S285
+	gotoRow{ // S856
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12277,9 +44534,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12294,13 +44555,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12308,7 +44574,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S286
+	gotoRow{ // S857
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12320,9 +44586,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12337,13 +44607,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12351,7 +44626,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S287
+	gotoRow{ // S858
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12363,9 +44638,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12380,13 +44659,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12394,7 +44678,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S288
+	gotoRow{ // S859
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12406,9 +44690,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12423,13 +44711,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12437,7 +44730,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S289
+	gotoRow{ // S860
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12449,9 +44742,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12466,13 +44763,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12480,7 +44782,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S290
+	gotoRow{ // S861
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12492,9 +44794,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12509,13 +44815,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12523,7 +44834,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S291
+	gotoRow{ // S862
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12535,9 +44846,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12552,13 +44867,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12566,7 +44886,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S292
+	gotoRow{ // S863
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12578,9 +44898,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12595,13 +44919,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12609,7 +44938,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S293
+	gotoRow{ // S864
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12621,9 +44950,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12638,13 +44971,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12652,7 +44990,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S294
+	gotoRow{ // S865
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12664,9 +45002,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12681,13 +45023,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12695,7 +45042,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S295
+	gotoRow{ // S866
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12707,9 +45054,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12724,13 +45075,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12738,7 +45094,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S296
+	gotoRow{ // S867
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12750,9 +45106,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12767,13 +45127,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12781,7 +45146,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S297
+	gotoRow{ // S868
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12793,9 +45158,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12810,13 +45179,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12824,7 +45198,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S298
+	gotoRow{ // S869
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12836,9 +45210,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12853,13 +45231,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12867,7 +45250,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S299
+	gotoRow{ // S870
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12879,9 +45262,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12896,13 +45283,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12910,7 +45302,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S300
+	gotoRow{ // S871
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12922,9 +45314,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12939,13 +45335,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12953,7 +45354,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S301
+	gotoRow{ // S872
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -12965,9 +45366,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -12982,13 +45387,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -12996,7 +45406,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S302
+	gotoRow{ // S873
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13008,9 +45418,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13025,13 +45439,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13039,7 +45458,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S303
+	gotoRow{ // S874
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13051,9 +45470,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13068,13 +45491,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13082,7 +45510,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S304
+	gotoRow{ // S875
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13094,9 +45522,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13111,13 +45543,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13125,7 +45562,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S305
+	gotoRow{ // S876
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13137,9 +45574,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13154,13 +45595,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13168,7 +45614,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S306
+	gotoRow{ // S877
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13180,9 +45626,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13197,13 +45647,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13211,7 +45666,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S307
+	gotoRow{ // S878
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13223,9 +45678,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13240,13 +45699,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13254,7 +45718,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S308
+	gotoRow{ // S879
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13266,9 +45730,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13283,13 +45751,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13297,7 +45770,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S309
+	gotoRow{ // S880
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13309,9 +45782,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13326,13 +45803,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13340,7 +45822,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S310
+	gotoRow{ // S881
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13352,9 +45834,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13369,13 +45855,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13383,7 +45874,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S311
+	gotoRow{ // S882
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13395,9 +45886,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13412,13 +45907,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13426,7 +45926,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S312
+	gotoRow{ // S883
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13438,9 +45938,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13455,13 +45959,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13469,7 +45978,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S313
+	gotoRow{ // S884
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13481,9 +45990,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13498,13 +46011,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13512,7 +46030,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S314
+	gotoRow{ // S885
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13524,9 +46042,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13541,13 +46063,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13555,50 +46082,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S315
-		-1,  // S'
-		-1,  // File
-		-1,  // Decls
-		-1,  // DeclList
-		-1,  // Decl
-		-1,  // FuncDecl
-		-1,  // FuncHeader
-		-1,  // FuncDef
-		-1,  // VarDecl
-		-1,  // ScalarDecl
-		-1,  // ArrayDecl
-		-1,  // IntLit
-		-1,  // TypeDef
-		-1,  // BasicType
-		-1,  // Params
-		-1,  // ParamList
-		-1,  // Param
-		-1,  // Type
-		-1,  // Stmt
-		184, // OtherStmt
-		181, // BlockStmt
-		317, // MatchedStmt
-		304, // OpenStmt
-		-1,  // Condition
-		-1,  // BlockItems
-		-1,  // BlockItemList
-		-1,  // BlockItem
-		185, // Expr
-		48,  // Expr2R
-		49,  // Expr5L
-		50,  // Expr9L
-		51,  // Expr10L
-		52,  // Expr12L
-		53,  // Expr13L
-		55,  // Expr14
-		56,  // Expr15
-		58,  // PrimaryExpr
-		59,  // ParenExpr
-		-1,  // Args
-		-1,  // ExprList
-
-	},
-	gotoRow{ // S316
+	gotoRow{ // S886
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13610,9 +46094,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13627,13 +46115,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -13641,7 +46134,7 @@ var gotoTab = gotoTable{
 		-1, // ExprList
 
 	},
-	gotoRow{ // S317
+	gotoRow{ // S887
 		-1, // S'
 		-1, // File
 		-1, // Decls
@@ -13653,9 +46146,13 @@ var gotoTab = gotoTable{
 		-1, // VarDecl
 		-1, // ScalarDecl
 		-1, // ArrayDecl
-		-1, // IntLit
+		-1, // VarDef
 		-1, // TypeDef
 		-1, // BasicType
+		-1, // EnumDecl
+		-1, // Enumerators
+		-1, // EnumeratorList
+		-1, // Enumerator
 		-1, // Params
 		-1, // ParamList
 		-1, // Param
@@ -13670,13 +46167,18 @@ var gotoTab = gotoTable{
 		-1, // BlockItemList
 		-1, // BlockItem
 		-1, // Expr
+		-1, // Expr1L
 		-1, // Expr2R
+		-1, // Expr3R
 		-1, // Expr5L
 		-1, // Expr9L
 		-1, // Expr10L
 		-1, // Expr12L
 		-1, // Expr13L
 		-1, // Expr14
+		-1, // SizeofExpr
+		-1, // CastExpr
+		-1, // CastOperand
 		-1, // Expr15
 		-1, // PrimaryExpr
 		-1, // ParenExpr
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 84
-	numStates      = 318
-	numSymbols     = 72
+	numProductions = 106
+	numStates      = 888
+	numSymbols     = 85
 )
 
 // Stack
@@ -736,6 +736,11 @@ func TestParser(t *testing.T) {
 							},
 							Lbracket: 5,
 							Len:      10,
+							LenExpr: &ast.BasicLit{
+								ValPos: 6,
+								Kind:   token.IntLit,
+								Val:    "10",
+							},
 							Rbracket: 8,
 						},
 						VarName: &ast.Ident{
@@ -751,6 +756,11 @@ func TestParser(t *testing.T) {
 							},
 							Lbracket: 17,
 							Len:      10,
+							LenExpr: &ast.BasicLit{
+								ValPos: 18,
+								Kind:   token.IntLit,
+								Val:    "10",
+							},
 							Rbracket: 20,
 						},
 						VarName: &ast.Ident{
@@ -1207,19 +1217,19 @@ func TestParserError(t *testing.T) {
 	}{
 		{
 			path: "../../testdata/incorrect/parser/pe01.c",
-			want: `102: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit"]`,
+			want: `102: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit" "sizeof"]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe02.c",
-			want: `112: unexpected "}", expected ["!=" "&&" "*" "+" "-" "/" ";" "<" "<=" "=" "==" ">" ">="]`,
+			want: `112: unexpected "}", expected ["!=" "&&" "*" "+" "," "-" "/" ";" "<" "<=" "=" "==" ">" ">=" "?"]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe03.c",
-			want: `129: unexpected "}", expected ["!" "(" "-" ";" "char_lit" "ident" "if" "int_lit" "return" "while" "{"]`,
+			want: `129: unexpected "}", expected ["!" "(" "-" ";" "char_lit" "ident" "if" "int_lit" "return" "sizeof" "while" "{"]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe04.c",
-			want: `111: unexpected "a", expected ["!=" "&&" "(" "*" "+" "-" "/" ";" "<" "<=" "=" "==" ">" ">=" "["]`,
+			want: `111: unexpected "a", expected ["!=" "&&" "(" "*" "+" "," "-" "/" ";" "<" "<=" "=" "==" ">" ">=" "?" "["]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe05.c",
@@ -1227,11 +1237,11 @@ func TestParserError(t *testing.T) {
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe06.c",
-			want: `73: unexpected "b", expected ["(" ";" "["]`,
+			want: `73: unexpected "b", expected ["(" ";" "=" "["]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe07.c",
-			want: `72: unexpected ",", expected ["(" ";" "["]`,
+			want: `72: unexpected ",", expected ["(" ";" "=" "["]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe08.c",
@@ -1243,11 +1253,11 @@ func TestParserError(t *testing.T) {
 			//
 			// Update this test case if the test file is fixed.
 			path: "../../testdata/incorrect/parser/pe09.c",
-			want: `87: unexpected ";", expected ["$" "ident" "typedef"]`,
+			want: `87: unexpected ";", expected ["$" "enum" "ident" "typedef"]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe10.c",
-			want: `135: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit"]`,
+			want: `135: unexpected ")", expected ["!" "(" "-" "char_lit" "ident" "int_lit" "sizeof"]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe11.c",
@@ -1255,7 +1265,7 @@ func TestParserError(t *testing.T) {
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe12.c",
-			want: `77: unexpected "{", expected ["(" ";" "["]`,
+			want: `77: unexpected "{", expected ["(" ";" "=" "["]`,
 		},
 		{
 			path: "../../testdata/incorrect/parser/pe13.c",
@@ -94,7 +94,7 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Decl : FuncDecl ";"	<< X[0], nil >>`,
+		String: `Decl : VarDef ";"	<< X[0], nil >>`,
 		Id:         "Decl",
 		NTType:     4,
 		Index:      7,
@@ -104,10 +104,20 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `Decl : FuncDef	<<  >>`,
+		String: `Decl : FuncDecl ";"	<< X[0], nil >>`,
 		Id:         "Decl",
 		NTType:     4,
 		Index:      8,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Decl : FuncDef	<<  >>`,
+		Id:         "Decl",
+		NTType:     4,
+		Index:      9,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -117,7 +127,17 @@ var productionsTable = ProdTab{
 		String: `Decl : TypeDef ";"	<< X[0], nil >>`,
 		Id:         "Decl",
 		NTType:     4,
-		Index:      9,
+		Index:      10,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Decl : EnumDecl ";"	<< X[0], nil >>`,
+		Id:         "Decl",
+		NTType:     4,
+		Index:      11,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -127,7 +147,7 @@ var productionsTable = ProdTab{
 		String: `FuncDecl : FuncHeader	<<  >>`,
 		Id:         "FuncDecl",
 		NTType:     5,
-		Index:      10,
+		Index:      12,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -137,7 +157,7 @@ var productionsTable = ProdTab{
 		String: `FuncHeader : BasicType ident "(" Params ")"	<< astx.NewFuncDecl(X[0], X[1], X[2], X[3], X[4]) >>`,
 		Id:         "FuncHeader",
 		NTType:     6,
-		Index:      11,
+		Index:      13,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewFuncDecl(X[0], X[1], X[2], X[3], X[4])
@@ -147,7 +167,7 @@ var productionsTable = ProdTab{
 		String: `FuncDef : FuncHeader BlockStmt	<< astx.SetFuncBody(X[0], X[1]) >>`,
 		Id:         "FuncDef",
 		NTType:     7,
-		Index:      12,
+		Index:      14,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.SetFuncBody(X[0], X[1])
@@ -157,7 +177,7 @@ var productionsTable = ProdTab{
 		String: `VarDecl : ScalarDecl	<<  >>`,
 		Id:         "VarDecl",
 		NTType:     8,
-		Index:      13,
+		Index:      15,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -167,7 +187,7 @@ var productionsTable = ProdTab{
 		String: `VarDecl : ArrayDecl	<<  >>`,
 		Id:         "VarDecl",
 		NTType:     8,
-		Index:      14,
+		Index:      16,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -177,17 +197,17 @@ var productionsTable = ProdTab{
 		String: `ScalarDecl : BasicType ident	<< astx.NewScalarDecl(X[0], X[1]) >>`,
 		Id:         "ScalarDecl",
 		NTType:     9,
-		Index:      15,
+		Index:      17,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewScalarDecl(X[0], X[1])
 		},
 	},
 	ProdTabEntry{
-		String: `ArrayDecl : BasicType ident "[" IntLit "]"	<< astx.NewArrayDecl(X[0], X[1], X[2], X[3], X[4]) >>`,
+		String: `ArrayDecl : BasicType ident "[" Expr3R "]"	<< astx.NewArrayDecl(X[0], X[1], X[2], X[3], X[4]) >>`,
 		Id:         "ArrayDecl",
 		NTType:     10,
-		Index:      16,
+		Index:      18,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewArrayDecl(X[0], X[1], X[2], X[3], X[4])
@@ -197,37 +217,27 @@ var productionsTable = ProdTab{
 		String: `ArrayDecl : BasicType ident "[" "]"	<< astx.NewArrayDecl(X[0], X[1], X[2], 0, X[3]) >>`,
 		Id:         "ArrayDecl",
 		NTType:     10,
-		Index:      17,
+		Index:      19,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewArrayDecl(X[0], X[1], X[2], 0, X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `IntLit : int_lit	<< astx.NewIntLit(X[0], token.IntLit) >>`,
-		Id:         "IntLit",
+		String: `VarDef : ScalarDecl "=" Expr2R	<< astx.NewVarDef(X[0], X[2]) >>`,
+		Id:         "VarDef",
 		NTType:     11,
-		Index:      18,
-		NumSymbols: 1,
-		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewIntLit(X[0], token.IntLit)
-		},
-	},
-	ProdTabEntry{
-		String: `IntLit : char_lit	<< astx.NewIntLit(X[0], token.CharLit) >>`,
-		Id:         "IntLit",
-		NTType:     11,
-		Index:      19,
-		NumSymbols: 1,
+		Index:      20,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
-			return astx.NewIntLit(X[0], token.CharLit)
+			return astx.NewVarDef(X[0], X[2])
 		},
 	},
 	ProdTabEntry{
 		String: `TypeDef : "typedef" Type ident	<< astx.NewTypeDef(X[0], X[1], X[2]) >>`,
 		Id:         "TypeDef",
 		NTType:     12,
-		Index:      20,
+		Index:      21,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewTypeDef(X[0], X[1], X[2])
@@ -237,17 +247,107 @@ var productionsTable = ProdTab{
 		String: `BasicType : ident	<< astx.NewIdent(X[0]) >>`,
 		Id:         "BasicType",
 		NTType:     13,
-		Index:      21,
+		Index:      22,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIdent(X[0])
 		},
 	},
+	ProdTabEntry{
+		String: `BasicType : "enum" ident	<< astx.NewEnumType(X[0], X[1]) >>`,
+		Id:         "BasicType",
+		NTType:     13,
+		Index:      23,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumType(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumDecl : "enum" ident "{" Enumerators "}"	<< astx.NewEnumDecl(X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "EnumDecl",
+		NTType:     14,
+		Index:      24,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumDecl(X[0], X[1], X[2], X[3], X[4])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumDecl : "enum" "{" Enumerators "}"	<< astx.NewEnumDecl(X[0], nil, X[1], X[2], X[3]) >>`,
+		Id:         "EnumDecl",
+		NTType:     14,
+		Index:      25,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumDecl(X[0], nil, X[1], X[2], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Enumerators : EnumeratorList	<<  >>`,
+		Id:         "Enumerators",
+		NTType:     15,
+		Index:      26,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Enumerators : EnumeratorList ","	<<  >>`,
+		Id:         "Enumerators",
+		NTType:     15,
+		Index:      27,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `EnumeratorList : Enumerator	<< astx.NewEnumeratorList(X[0]) >>`,
+		Id:         "EnumeratorList",
+		NTType:     16,
+		Index:      28,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumeratorList(X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `EnumeratorList : EnumeratorList "," Enumerator	<< astx.AppendEnumerator(X[0], X[2]) >>`,
+		Id:         "EnumeratorList",
+		NTType:     16,
+		Index:      29,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.AppendEnumerator(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Enumerator : ident	<< astx.NewEnumerator(X[0], nil) >>`,
+		Id:         "Enumerator",
+		NTType:     17,
+		Index:      30,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumerator(X[0], nil)
+		},
+	},
+	ProdTabEntry{
+		String: `Enumerator : ident "=" Expr3R	<< astx.NewEnumerator(X[0], X[2]) >>`,
+		Id:         "Enumerator",
+		NTType:     17,
+		Index:      31,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewEnumerator(X[0], X[2])
+		},
+	},
 	ProdTabEntry{
 		String: `Params : empty	<<  >>`,
 		Id:         "Params",
-		NTType:     14,
-		Index:      22,
+		NTType:     18,
+		Index:      32,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return nil, nil
@@ -256,8 +356,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Params : ParamList	<<  >>`,
 		Id:         "Params",
-		NTType:     14,
-		Index:      23,
+		NTType:     18,
+		Index:      33,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -266,8 +366,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParamList : Param	<< astx.NewParamList(X[0]) >>`,
 		Id:         "ParamList",
-		NTType:     15,
-		Index:      24,
+		NTType:     19,
+		Index:      34,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewParamList(X[0])
@@ -276,8 +376,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParamList : ParamList "," Param	<< astx.AppendParam(X[0], X[2]) >>`,
 		Id:         "ParamList",
-		NTType:     15,
-		Index:      25,
+		NTType:     19,
+		Index:      35,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.AppendParam(X[0], X[2])
@@ -286,8 +386,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Param : Type	<< astx.NewAnonParam(X[0]) >>`,
 		Id:         "Param",
-		NTType:     16,
-		Index:      26,
+		NTType:     20,
+		Index:      36,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewAnonParam(X[0])
@@ -296,8 +396,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Param : VarDecl	<<  >>`,
 		Id:         "Param",
-		NTType:     16,
-		Index:      27,
+		NTType:     20,
+		Index:      37,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -306,8 +406,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Type : BasicType	<<  >>`,
 		Id:         "Type",
-		NTType:     17,
-		Index:      28,
+		NTType:     21,
+		Index:      38,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -316,8 +416,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Stmt : MatchedStmt	<<  >>`,
 		Id:         "Stmt",
-		NTType:     18,
-		Index:      29,
+		NTType:     22,
+		Index:      39,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -326,8 +426,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Stmt : OpenStmt	<<  >>`,
 		Id:         "Stmt",
-		NTType:     18,
-		Index:      30,
+		NTType:     22,
+		Index:      40,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -336,8 +436,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OtherStmt : Expr ";"	<< astx.NewExprStmt(X[0]) >>`,
 		Id:         "OtherStmt",
-		NTType:     19,
-		Index:      31,
+		NTType:     23,
+		Index:      41,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewExprStmt(X[0])
@@ -346,8 +446,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OtherStmt : "return" Expr ";"	<< astx.NewReturnStmt(X[0], X[1]) >>`,
 		Id:         "OtherStmt",
-		NTType:     19,
-		Index:      32,
+		NTType:     23,
+		Index:      42,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewReturnStmt(X[0], X[1])
@@ -356,8 +456,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OtherStmt : "return" ";"	<< astx.NewReturnStmt(X[0], nil) >>`,
 		Id:         "OtherStmt",
-		NTType:     19,
-		Index:      33,
+		NTType:     23,
+		Index:      43,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewReturnStmt(X[0], nil)
@@ -366,8 +466,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OtherStmt : BlockStmt	<<  >>`,
 		Id:         "OtherStmt",
-		NTType:     19,
-		Index:      34,
+		NTType:     23,
+		Index:      44,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -376,8 +476,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OtherStmt : ";"	<< astx.NewEmptyStmt(X[0]) >>`,
 		Id:         "OtherStmt",
-		NTType:     19,
-		Index:      35,
+		NTType:     23,
+		Index:      45,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewEmptyStmt(X[0])
@@ -386,8 +486,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockStmt : "{" BlockItems "}"	<< astx.NewBlockStmt(X[0], X[1], X[2]) >>`,
 		Id:         "BlockStmt",
-		NTType:     20,
-		Index:      36,
+		NTType:     24,
+		Index:      46,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBlockStmt(X[0], X[1], X[2])
@@ -396,8 +496,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MatchedStmt : "if" Condition MatchedStmt "else" MatchedStmt	<< astx.NewIfStmt(X[0], X[1], X[2], X[4]) >>`,
 		Id:         "MatchedStmt",
-		NTType:     21,
-		Index:      37,
+		NTType:     25,
+		Index:      47,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIfStmt(X[0], X[1], X[2], X[4])
@@ -406,8 +506,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MatchedStmt : "while" Condition MatchedStmt	<< astx.NewWhileStmt(X[0], X[1], X[2]) >>`,
 		Id:         "MatchedStmt",
-		NTType:     21,
-		Index:      38,
+		NTType:     25,
+		Index:      48,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewWhileStmt(X[0], X[1], X[2])
@@ -416,8 +516,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `MatchedStmt : OtherStmt	<<  >>`,
 		Id:         "MatchedStmt",
-		NTType:     21,
-		Index:      39,
+		NTType:     25,
+		Index:      49,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -426,8 +526,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OpenStmt : "if" Condition Stmt	<< astx.NewIfStmt(X[0], X[1], X[2], nil) >>`,
 		Id:         "OpenStmt",
-		NTType:     22,
-		Index:      40,
+		NTType:     26,
+		Index:      50,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIfStmt(X[0], X[1], X[2], nil)
@@ -436,8 +536,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OpenStmt : "if" Condition MatchedStmt "else" OpenStmt	<< astx.NewIfStmt(X[0], X[1], X[2], X[4]) >>`,
 		Id:         "OpenStmt",
-		NTType:     22,
-		Index:      41,
+		NTType:     26,
+		Index:      51,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIfStmt(X[0], X[1], X[2], X[4])
@@ -446,8 +546,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `OpenStmt : "while" Condition OpenStmt	<< astx.NewWhileStmt(X[0], X[1], X[2]) >>`,
 		Id:         "OpenStmt",
-		NTType:     22,
-		Index:      42,
+		NTType:     26,
+		Index:      52,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewWhileStmt(X[0], X[1], X[2])
@@ -456,8 +556,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Condition : "(" Expr ")"	<< X[1], nil >>`,
 		Id:         "Condition",
-		NTType:     23,
-		Index:      43,
+		NTType:     27,
+		Index:      53,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[1], nil
@@ -466,8 +566,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockItems : empty	<<  >>`,
 		Id:         "BlockItems",
-		NTType:     24,
-		Index:      44,
+		NTType:     28,
+		Index:      54,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return nil, nil
@@ -476,8 +576,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockItems : BlockItemList	<<  >>`,
 		Id:         "BlockItems",
-		NTType:     24,
-		Index:      45,
+		NTType:     28,
+		Index:      55,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -486,8 +586,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockItemList : BlockItem	<< astx.NewBlockItemList(X[0]) >>`,
 		Id:         "BlockItemList",
-		NTType:     25,
-		Index:      46,
+		NTType:     29,
+		Index:      56,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBlockItemList(X[0])
@@ -496,8 +596,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockItemList : BlockItemList BlockItem	<< astx.AppendBlockItem(X[0], X[1]) >>`,
 		Id:         "BlockItemList",
-		NTType:     25,
-		Index:      47,
+		NTType:     29,
+		Index:      57,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.AppendBlockItem(X[0], X[1])
@@ -506,8 +606,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockItem : Decl	<<  >>`,
 		Id:         "BlockItem",
-		NTType:     26,
-		Index:      48,
+		NTType:     30,
+		Index:      58,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -516,28 +616,48 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `BlockItem : Stmt	<<  >>`,
 		Id:         "BlockItem",
-		NTType:     26,
-		Index:      49,
+		NTType:     30,
+		Index:      59,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr : Expr2R	<<  >>`,
+		String: `Expr : Expr1L	<<  >>`,
 		Id:         "Expr",
-		NTType:     27,
-		Index:      50,
+		NTType:     31,
+		Index:      60,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Expr2R : Expr5L	<<  >>`,
+		String: `Expr1L : Expr2R	<<  >>`,
+		Id:         "Expr1L",
+		NTType:     32,
+		Index:      61,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Expr1L : Expr1L "," Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
+		Id:         "Expr1L",
+		NTType:     32,
+		Index:      62,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewBinaryExpr(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr2R : Expr3R	<<  >>`,
 		Id:         "Expr2R",
-		NTType:     28,
-		Index:      51,
+		NTType:     33,
+		Index:      63,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -546,18 +666,38 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr2R : Expr5L "=" Expr2R	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr2R",
-		NTType:     28,
-		Index:      52,
+		NTType:     33,
+		Index:      64,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
 		},
 	},
+	ProdTabEntry{
+		String: `Expr3R : Expr5L	<<  >>`,
+		Id:         "Expr3R",
+		NTType:     34,
+		Index:      65,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Expr3R : Expr5L "?" Expr ":" Expr3R	<< astx.NewCondExpr(X[0], X[1], X[2], X[3], X[4]) >>`,
+		Id:         "Expr3R",
+		NTType:     34,
+		Index:      66,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCondExpr(X[0], X[1], X[2], X[3], X[4])
+		},
+	},
 	ProdTabEntry{
 		String: `Expr5L : Expr9L	<<  >>`,
 		Id:         "Expr5L",
-		NTType:     29,
-		Index:      53,
+		NTType:     35,
+		Index:      67,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -566,8 +706,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr5L : Expr5L "&&" Expr9L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr5L",
-		NTType:     29,
-		Index:      54,
+		NTType:     35,
+		Index:      68,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -576,8 +716,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr9L : Expr10L	<<  >>`,
 		Id:         "Expr9L",
-		NTType:     30,
-		Index:      55,
+		NTType:     36,
+		Index:      69,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -586,8 +726,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr9L : Expr9L "==" Expr10L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr9L",
-		NTType:     30,
-		Index:      56,
+		NTType:     36,
+		Index:      70,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -596,8 +736,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr9L : Expr9L "!=" Expr10L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr9L",
-		NTType:     30,
-		Index:      57,
+		NTType:     36,
+		Index:      71,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -606,8 +746,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr10L : Expr12L	<<  >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      58,
+		NTType:     37,
+		Index:      72,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -616,8 +756,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr10L : Expr10L "<" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      59,
+		NTType:     37,
+		Index:      73,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -626,8 +766,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr10L : Expr10L ">" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      60,
+		NTType:     37,
+		Index:      74,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -636,8 +776,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr10L : Expr10L "<=" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      61,
+		NTType:     37,
+		Index:      75,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -646,8 +786,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr10L : Expr10L ">=" Expr12L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr10L",
-		NTType:     31,
-		Index:      62,
+		NTType:     37,
+		Index:      76,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -656,8 +796,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr12L : Expr13L	<<  >>`,
 		Id:         "Expr12L",
-		NTType:     32,
-		Index:      63,
+		NTType:     38,
+		Index:      77,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -666,8 +806,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr12L : Expr12L "+" Expr13L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr12L",
-		NTType:     32,
-		Index:      64,
+		NTType:     38,
+		Index:      78,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -676,8 +816,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr12L : Expr12L "-" Expr13L	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr12L",
-		NTType:     32,
-		Index:      65,
+		NTType:     38,
+		Index:      79,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -686,8 +826,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr13L : Expr14	<<  >>`,
 		Id:         "Expr13L",
-		NTType:     33,
-		Index:      66,
+		NTType:     39,
+		Index:      80,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -696,8 +836,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr13L : Expr13L "*" Expr14	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr13L",
-		NTType:     33,
-		Index:      67,
+		NTType:     39,
+		Index:      81,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -706,8 +846,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr13L : Expr13L "/" Expr14	<< astx.NewBinaryExpr(X[0], X[1], X[2]) >>`,
 		Id:         "Expr13L",
-		NTType:     33,
-		Index:      68,
+		NTType:     39,
+		Index:      82,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBinaryExpr(X[0], X[1], X[2])
@@ -716,8 +856,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr14 : Expr15	<<  >>`,
 		Id:         "Expr14",
-		NTType:     34,
-		Index:      69,
+		NTType:     40,
+		Index:      83,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -726,8 +866,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr14 : "-" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
 		Id:         "Expr14",
-		NTType:     34,
-		Index:      70,
+		NTType:     40,
+		Index:      84,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewUnaryExpr(X[0], X[1])
@@ -736,18 +876,98 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr14 : "!" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
 		Id:         "Expr14",
-		NTType:     34,
-		Index:      71,
+		NTType:     40,
+		Index:      85,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewUnaryExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `Expr14 : CastExpr	<<  >>`,
+		Id:         "Expr14",
+		NTType:     40,
+		Index:      86,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Expr14 : SizeofExpr	<<  >>`,
+		Id:         "Expr14",
+		NTType:     40,
+		Index:      87,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `SizeofExpr : "sizeof" Expr14	<< astx.NewSizeofExpr(X[0], X[1]) >>`,
+		Id:         "SizeofExpr",
+		NTType:     41,
+		Index:      88,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewSizeofExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `CastExpr : ParenExpr CastOperand	<< astx.NewCastExpr(X[0], X[1]) >>`,
+		Id:         "CastExpr",
+		NTType:     42,
+		Index:      89,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return astx.NewCastExpr(X[0], X[1])
+		},
+	},
+	ProdTabEntry{
+		String: `CastOperand : Expr15	<<  >>`,
+		Id:         "CastOperand",
+		NTType:     43,
+		Index:      90,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CastOperand : "!" Expr14	<< astx.NewUnaryExpr(X[0], X[1]) >>`,
+		Id:         "CastOperand",
+		NTType:     43,
+		Index:      91,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewUnaryExpr(X[0], X[1])
 		},
 	},
+	ProdTabEntry{
+		String: `CastOperand : CastExpr	<<  >>`,
+		Id:         "CastOperand",
+		NTType:     43,
+		Index:      92,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `CastOperand : SizeofExpr	<<  >>`,
+		Id:         "CastOperand",
+		NTType:     43,
+		Index:      93,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `Expr15 : PrimaryExpr	<<  >>`,
 		Id:         "Expr15",
-		NTType:     35,
-		Index:      72,
+		NTType:     44,
+		Index:      94,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -756,8 +976,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr15 : ident "[" Expr "]"	<< astx.NewIndexExpr(X[0], X[1], X[2], X[3]) >>`,
 		Id:         "Expr15",
-		NTType:     35,
-		Index:      73,
+		NTType:     44,
+		Index:      95,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIndexExpr(X[0], X[1], X[2], X[3])
@@ -766,8 +986,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Expr15 : ident "(" Args ")"	<< astx.NewCallExpr(X[0], X[1], X[2], X[3]) >>`,
 		Id:         "Expr15",
-		NTType:     35,
-		Index:      74,
+		NTType:     44,
+		Index:      96,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewCallExpr(X[0], X[1], X[2], X[3])
@@ -776,8 +996,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrimaryExpr : int_lit	<< astx.NewBasicLit(X[0], token.IntLit) >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      75,
+		NTType:     45,
+		Index:      97,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBasicLit(X[0], token.IntLit)
@@ -786,8 +1006,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrimaryExpr : char_lit	<< astx.NewBasicLit(X[0], token.CharLit) >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      76,
+		NTType:     45,
+		Index:      98,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewBasicLit(X[0], token.CharLit)
@@ -796,8 +1016,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrimaryExpr : ident	<< astx.NewIdent(X[0]) >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      77,
+		NTType:     45,
+		Index:      99,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewIdent(X[0])
@@ -806,8 +1026,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PrimaryExpr : ParenExpr	<<  >>`,
 		Id:         "PrimaryExpr",
-		NTType:     36,
-		Index:      78,
+		NTType:     45,
+		Index:      100,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
@@ -816,8 +1036,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ParenExpr : "(" Expr ")"	<< astx.NewParenExpr(X[0], X[1], X[2]) >>`,
 		Id:         "ParenExpr",
-		NTType:     37,
-		Index:      79,
+		NTType:     46,
+		Index:      101,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewParenExpr(X[0], X[1], X[2])
@@ -826,8 +1046,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Args : empty	<<  >>`,
 		Id:         "Args",
-		NTType:     38,
-		Index:      80,
+		NTType:     47,
+		Index:      102,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return nil, nil
@@ -836,28 +1056,28 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Args : ExprList	<<  >>`,
 		Id:         "Args",
-		NTType:     38,
-		Index:      81,
+		NTType:     47,
+		Index:      103,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `ExprList : Expr	<< astx.NewExprList(X[0]) >>`,
+		String: `ExprList : Expr2R	<< astx.NewExprList(X[0]) >>`,
 		Id:         "ExprList",
-		NTType:     39,
-		Index:      82,
+		NTType:     48,
+		Index:      104,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.NewExprList(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `ExprList : ExprList "," Expr	<< astx.AppendExpr(X[0], X[2]) >>`,
+		String: `ExprList : ExprList "," Expr2R	<< astx.AppendExpr(X[0], X[2]) >>`,
 		Id:         "ExprList",
-		NTType:     39,
-		Index:      83,
+		NTType:     48,
+		Index:      105,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib) (Attrib, error) {
 			return astx.AppendExpr(X[0], X[2])
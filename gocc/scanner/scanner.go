@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/gocc/lexer"
 	"github.com/mewmew/uc/gocc/token"
 )
@@ -68,5 +69,68 @@ func NewFromBytes(input []byte) Scanner {
 		input = append(input, '\n')
 	}
 
-	return lexer.NewLexer(input)
+	return &scanner{
+		lexer:           lexer.NewLexer(input),
+		input:           input,
+		CommentRecorder: astutil.NewCommentRecorder(string(input)),
+	}
+}
+
+// a scanner is a Gocc lexer which records the comments skipped between tokens.
+type scanner struct {
+	// Gocc lexer.
+	lexer *lexer.Lexer
+	// Source input.
+	input []byte
+	// End position of the previous token.
+	end int
+	// Comment groups and semicolon tokens scanned so far.
+	*astutil.CommentRecorder
+}
+
+// Ensure that scanner implements the Gocc Scanner interface, and records
+// comments.
+var (
+	_ Scanner                = &scanner{}
+	_ astutil.CommentScanner = &scanner{}
+)
+
+// Scan lexes and returns the next token of the source input.
+func (s *scanner) Scan() *token.Token {
+	tok := s.lexer.Scan()
+	if start := tok.Pos.Offset; start >= s.end {
+		// Only white-space and comments are located between tokens.
+		s.comments(s.end, start)
+		s.Token(start, string(tok.Lit))
+		s.end = start + len(tok.Lit)
+	}
+	return tok
+}
+
+// comments records the comments located between the given start and end
+// positions of the source input.
+func (s *scanner) comments(start, end int) {
+	gap := string(s.input[start:end])
+	for i := 0; i < len(gap); {
+		src := gap[i:]
+		switch {
+		case strings.HasPrefix(src, "//"):
+			// Line comment; excluding the terminating newline and trailing
+			// carriage returns.
+			if j := strings.IndexByte(src, '\n'); j != -1 {
+				src = src[:j]
+			}
+			s.Comment(start+i, strings.TrimRight(src, "\r"))
+		case strings.HasPrefix(src, "/*"):
+			// Block comment; excluding carriage returns.
+			if j := strings.Index(src[2:], "*/"); j != -1 {
+				src = src[:2+j+2]
+			}
+			s.Comment(start+i, strings.Replace(src, "\r", "", -1))
+		default:
+			// White-space character.
+			src = src[:1]
+		}
+		i += len(src)
+	}
 }
@@ -68,17 +68,18 @@ var TokMap = TokenMap{
 		")",
 		"[",
 		"]",
-		"int_lit",
-		"char_lit",
+		"=",
 		"typedef",
-		",",
-		"return",
+		"enum",
 		"{",
 		"}",
+		",",
+		"return",
 		"if",
 		"else",
 		"while",
-		"=",
+		"?",
+		":",
 		"&&",
 		"==",
 		"!=",
@@ -91,6 +92,9 @@ var TokMap = TokenMap{
 		"*",
 		"/",
 		"!",
+		"sizeof",
+		"int_lit",
+		"char_lit",
 	},
 
 	idMap: map[string]Type{
@@ -103,28 +107,32 @@ var TokMap = TokenMap{
 		")":        6,
 		"[":        7,
 		"]":        8,
-		"int_lit":  9,
-		"char_lit": 10,
-		"typedef":  11,
-		",":        12,
-		"return":   13,
-		"{":        14,
-		"}":        15,
+		"=":        9,
+		"typedef":  10,
+		"enum":     11,
+		"{":        12,
+		"}":        13,
+		",":        14,
+		"return":   15,
 		"if":       16,
 		"else":     17,
 		"while":    18,
-		"=":        19,
-		"&&":       20,
-		"==":       21,
-		"!=":       22,
-		"<":        23,
-		">":        24,
-		"<=":       25,
-		">=":       26,
-		"+":        27,
-		"-":        28,
-		"*":        29,
-		"/":        30,
-		"!":        31,
+		"?":        19,
+		":":        20,
+		"&&":       21,
+		"==":       22,
+		"!=":       23,
+		"<":        24,
+		">":        25,
+		"<=":       26,
+		">=":       27,
+		"+":        28,
+		"-":        29,
+		"*":        30,
+		"/":        31,
+		"!":        32,
+		"sizeof":   33,
+		"int_lit":  34,
+		"char_lit": 35,
 	},
 }
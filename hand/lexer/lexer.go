@@ -26,14 +26,24 @@ import (
 // related to lexing are recorded as error tokens with relevant position
 // information.
 func Parse(r io.Reader) ([]token.Token, error) {
+	input, err := ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseString(input), nil
+}
+
+// ReadAll reads the input from r, as lexed by Parse. Input with a byte order
+// mark is decoded from the corresponding Unicode encoding (e.g. UTF-16) to
+// UTF-8, and the byte order mark is removed.
+func ReadAll(r io.Reader) (string, error) {
 	br := bufio.NewReader(r)
 	ur := newUnicodeReader(br)
 	buf, err := ioutil.ReadAll(ur)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	input := string(buf)
-	return ParseString(input), nil
+	return string(buf), nil
 }
 
 // ParseFile lexes the input read from path into a slice of tokens. Potential
@@ -928,8 +928,8 @@ func TestLexer(t *testing.T) {
 					Pos:  50,
 				},
 				{
-					Kind: token.Error,
-					Val:  "unexpected U+003A ':'",
+					Kind: token.Colon,
+					Val:  ":",
 					Pos:  60,
 				},
 				{
@@ -948,8 +948,8 @@ func TestLexer(t *testing.T) {
 					Pos:  64,
 				},
 				{
-					Kind: token.Error,
-					Val:  "unexpected U+003F '?'",
+					Kind: token.Question,
+					Val:  "?",
 					Pos:  65,
 				},
 				{
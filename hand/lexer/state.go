@@ -78,6 +78,12 @@ func lexToken(l *lexer) stateFn {
 	case ',':
 		l.emit(token.Comma)
 		return lexToken
+	case '?':
+		l.emit(token.Question)
+		return lexToken
+	case ':':
+		l.emit(token.Colon)
+		return lexToken
 	case '+':
 		l.emit(token.Add)
 		return lexToken
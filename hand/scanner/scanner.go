@@ -3,7 +3,9 @@ package scanner
 
 import (
 	"io"
+	"os"
 
+	"github.com/mewmew/uc/ast/astutil"
 	"github.com/mewmew/uc/gocc/token"
 	"github.com/mewmew/uc/hand/lexer"
 	uctoken "github.com/mewmew/uc/token"
@@ -15,16 +17,23 @@ type Scanner interface {
 	Scan() *token.Token
 }
 
-// a scanner is a lexer which implements the Gocc Scanner interface.
+// a scanner is a lexer which implements the Gocc Scanner interface. Comments
+// are skipped by Scan, and recorded as comment groups.
 type scanner struct {
 	// Lexed tokens.
 	toks []uctoken.Token
 	// Current token.
 	cur int
+	// Comment groups and semicolon tokens scanned so far.
+	*astutil.CommentRecorder
 }
 
-// Ensure that scanner implements the Gocc Scanner interface.
-var _ Scanner = &scanner{}
+// Ensure that scanner implements the Gocc Scanner interface, and records
+// comments.
+var (
+	_ Scanner                = &scanner{}
+	_ astutil.CommentScanner = &scanner{}
+)
 
 // eof represents an end-of-file token with unknown source position.
 var eof = &token.Token{Type: token.TokMap.Type("$")}
@@ -44,6 +53,7 @@ func (s *scanner) Scan() *token.Token {
 		typ = token.TokMap.Type("INVALID")
 	case uctoken.Comment:
 		// Skip comments.
+		s.Comment(tok.Pos, tok.Val)
 		return s.Scan()
 	case uctoken.Ident:
 		typ = token.TokMap.Type("ident")
@@ -54,6 +64,7 @@ func (s *scanner) Scan() *token.Token {
 	default:
 		typ = token.TokMap.Type(tok.Val)
 	}
+	s.Token(tok.Pos, tok.Val)
 	lit := []byte(tok.Val)
 	pos := token.Pos{Offset: tok.Pos}
 	return &token.Token{
@@ -65,30 +76,30 @@ func (s *scanner) Scan() *token.Token {
 
 // New returns a new scanner lexing from r.
 func New(r io.Reader) (Scanner, error) {
-	toks, err := lexer.Parse(r)
+	input, err := lexer.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
-	return &scanner{toks: toks}, nil
+	return NewFromString(input), nil
 }
 
 // Open returns a new scanner lexing from path.
 func Open(path string) (Scanner, error) {
-	toks, err := lexer.ParseFile(path)
+	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
-	return &scanner{toks: toks}, nil
+	defer f.Close()
+	return New(f)
 }
 
 // NewFromString returns a new scanner lexing from input.
 func NewFromString(input string) Scanner {
 	toks := lexer.ParseString(input)
-	return &scanner{toks: toks}
+	return &scanner{toks: toks, CommentRecorder: astutil.NewCommentRecorder(input)}
 }
 
 // NewFromBytes returns a new scanner lexing from input.
 func NewFromBytes(input []byte) Scanner {
-	toks := lexer.ParseString(string(input))
-	return &scanner{toks: toks}
+	return NewFromString(string(input))
 }
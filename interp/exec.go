@@ -0,0 +1,363 @@
+package interp
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// A frame holds the values of parameters and instructions of a function
+// invocation.
+type frame map[value.Value]int64
+
+// call invokes the given function with the given arguments, and returns its
+// result.
+func (m *machine) call(f *ir.Function, args []int64) (int64, error) {
+	if len(f.Blocks) == 0 {
+		builtin, ok := runtime[f.Name]
+		if !ok {
+			return 0, errutil.Newf("unable to locate runtime routine of external function %q", f.Name)
+		}
+		return builtin(m, args)
+	}
+	if m.depth >= MaxCallDepth {
+		return 0, errutil.New("stack overflow")
+	}
+	// Release stack space of local variables on return.
+	sp := m.sp
+	m.depth++
+	defer func() {
+		m.sp = sp
+		m.depth--
+	}()
+
+	fr := make(frame)
+	for i, param := range f.Params() {
+		fr[param] = args[i]
+	}
+	var pred *ir.BasicBlock
+	block := f.Blocks[0]
+	for {
+		// Assign phi instructions simultaneously, based on the predecessor
+		// basic block.
+		phis := make(map[*ir.InstPhi]int64)
+		for _, inst := range block.Insts {
+			phi, ok := inst.(*ir.InstPhi)
+			if !ok {
+				break
+			}
+			x, err := m.phi(fr, phi, pred)
+			if err != nil {
+				return 0, errutil.Err(err)
+			}
+			phis[phi] = x
+		}
+		for phi, x := range phis {
+			fr[phi] = x
+		}
+		for _, inst := range block.Insts {
+			if _, ok := inst.(*ir.InstPhi); ok {
+				continue
+			}
+			if err := m.inst(fr, inst); err != nil {
+				return 0, errutil.Err(err)
+			}
+		}
+		switch term := block.Term.(type) {
+		case *ir.TermRet:
+			if term.X == nil {
+				return 0, nil
+			}
+			return m.eval(fr, term.X)
+		case *ir.TermBr:
+			pred, block = block, term.Target
+		case *ir.TermCondBr:
+			cond, err := m.eval(fr, term.Cond)
+			if err != nil {
+				return 0, errutil.Err(err)
+			}
+			pred = block
+			if cond != 0 {
+				block = term.TargetTrue
+			} else {
+				block = term.TargetFalse
+			}
+		case *ir.TermUnreachable:
+			return 0, errutil.Newf("unreachable code reached in function %q", f.Name)
+		default:
+			panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+		}
+	}
+}
+
+// phi returns the incoming value of the given phi instruction from the
+// predecessor basic block pred.
+func (m *machine) phi(fr frame, phi *ir.InstPhi, pred *ir.BasicBlock) (int64, error) {
+	for _, inc := range phi.Incs {
+		if inc.Pred == pred {
+			return m.eval(fr, inc.X)
+		}
+	}
+	return 0, errutil.Newf("unable to locate incoming value of phi instruction %v", phi.Ident())
+}
+
+// inst executes the given instruction.
+func (m *machine) inst(fr frame, inst ir.Instruction) error {
+	var x int64
+	switch inst := inst.(type) {
+	// Memory instructions.
+	case *ir.InstAlloca:
+		size := sizeof(inst.Elem)
+		x = m.sp
+		m.sp = align(m.sp+size, 8)
+		if m.sp > int64(len(m.mem)) {
+			return errutil.New("stack overflow")
+		}
+		// Clear memory of reused stack space.
+		for i := x; i < m.sp; i++ {
+			m.mem[i] = 0
+		}
+	case *ir.InstLoad:
+		addr, err := m.eval(fr, inst.Src)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		if x, err = m.load(addr, inst.Type()); err != nil {
+			return errutil.Err(err)
+		}
+	case *ir.InstStore:
+		src, err := m.eval(fr, inst.Src)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		addr, err := m.eval(fr, inst.Dst)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		return m.store(addr, src, inst.Src.Type())
+	case *ir.InstGetElementPtr:
+		var err error
+		if x, err = m.gep(fr, inst.Src, inst.Elem, inst.Indices); err != nil {
+			return errutil.Err(err)
+		}
+
+	// Binary instructions.
+	case *ir.InstAdd:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x + y, nil })
+	case *ir.InstSub:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x - y, nil })
+	case *ir.InstMul:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x * y, nil })
+	case *ir.InstSDiv:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) {
+			if y == 0 {
+				return 0, errutil.New("integer division by zero")
+			}
+			return x / y, nil
+		})
+	case *ir.InstSRem:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) {
+			if y == 0 {
+				return 0, errutil.New("integer division by zero")
+			}
+			return x % y, nil
+		})
+	case *ir.InstAnd:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x & y, nil })
+	case *ir.InstOr:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x | y, nil })
+	case *ir.InstXor:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x ^ y, nil })
+	case *ir.InstShl:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x << uint64(y), nil })
+	case *ir.InstAShr:
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) { return x >> uint64(y), nil })
+
+	// Comparison instructions.
+	case *ir.InstICmp:
+		typ := inst.X.Type()
+		return m.binary(fr, inst, inst.X, inst.Y, func(x, y int64) (int64, error) {
+			return compare(inst.Cond, x, y, typ), nil
+		})
+
+	// Conversion instructions.
+	case *ir.InstTrunc:
+		from, err := m.eval(fr, inst.From)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		x = from
+	case *ir.InstZExt:
+		from, err := m.eval(fr, inst.From)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		x = unsigned(from, inst.From.Type())
+	case *ir.InstSExt:
+		from, err := m.eval(fr, inst.From)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		x = from
+		if types.IsBool(inst.From.Type()) {
+			x = -from
+		}
+
+	// Other instructions.
+	case *ir.InstSelect:
+		cond, err := m.eval(fr, inst.Cond)
+		if err != nil {
+			return errutil.Err(err)
+		}
+		v := inst.Y
+		if cond != 0 {
+			v = inst.X
+		}
+		if x, err = m.eval(fr, v); err != nil {
+			return errutil.Err(err)
+		}
+	case *ir.InstCall:
+		callee, ok := inst.Callee.(*ir.Function)
+		if !ok {
+			panic(fmt.Sprintf("support for callee %T not yet implemented", inst.Callee))
+		}
+		args := make([]int64, len(inst.Args))
+		for i, arg := range inst.Args {
+			var err error
+			if args[i], err = m.eval(fr, arg); err != nil {
+				return errutil.Err(err)
+			}
+		}
+		var err error
+		if x, err = m.call(callee, args); err != nil {
+			return errutil.Err(err)
+		}
+		if types.IsVoid(inst.Type()) {
+			return nil
+		}
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+
+	v, ok := inst.(value.Value)
+	if !ok {
+		panic(fmt.Sprintf("invalid instruction type; expected value.Value, got %T", inst))
+	}
+	fr[v] = normalize(x, v.Type())
+	return nil
+}
+
+// binary evaluates the binary operation op of the operands x and y, and stores
+// the result of the instruction inst.
+func (m *machine) binary(fr frame, inst value.Value, x, y value.Value, op func(x, y int64) (int64, error)) error {
+	a, err := m.eval(fr, x)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	b, err := m.eval(fr, y)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	result, err := op(a, b)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	fr[inst] = normalize(result, inst.Type())
+	return nil
+}
+
+// compare returns the result of the integer comparison cond between x and y of
+// the given type.
+func compare(cond ir.IntPred, x, y int64, typ types.Type) int64 {
+	ux, uy := uint64(unsigned(x, typ)), uint64(unsigned(y, typ))
+	var result bool
+	switch cond {
+	case ir.IntEQ:
+		result = x == y
+	case ir.IntNE:
+		result = x != y
+	case ir.IntUGT:
+		result = ux > uy
+	case ir.IntUGE:
+		result = ux >= uy
+	case ir.IntULT:
+		result = ux < uy
+	case ir.IntULE:
+		result = ux <= uy
+	case ir.IntSGT:
+		result = x > y
+	case ir.IntSGE:
+		result = x >= y
+	case ir.IntSLT:
+		result = x < y
+	case ir.IntSLE:
+		result = x <= y
+	default:
+		panic(fmt.Sprintf("support for integer comparison predicate %v not yet implemented", cond))
+	}
+	if result {
+		return 1
+	}
+	return 0
+}
+
+// unsigned returns the zero-extension of x of the given type to 64 bits.
+func unsigned(x int64, typ types.Type) int64 {
+	if t, ok := typ.(*types.IntType); ok && t.Size < 64 {
+		return x & (1<<uint(t.Size) - 1)
+	}
+	return x
+}
+
+// gep returns the address computed by a getelementptr instruction or
+// expression.
+func (m *machine) gep(fr frame, src value.Value, elem types.Type, indices []value.Value) (int64, error) {
+	addr, err := m.eval(fr, src)
+	if err != nil {
+		return 0, errutil.Err(err)
+	}
+	for i, index := range indices {
+		if i > 0 {
+			switch t := elem.(type) {
+			case *types.ArrayType:
+				elem = t.Elem
+			case *types.PointerType:
+				elem = t.Elem
+			default:
+				panic(fmt.Sprintf("support for getelementptr index into type %T not yet implemented", t))
+			}
+		}
+		x, err := m.eval(fr, index)
+		if err != nil {
+			return 0, errutil.Err(err)
+		}
+		addr += x * sizeof(elem)
+	}
+	return addr, nil
+}
+
+// eval returns the value of the given operand.
+func (m *machine) eval(fr frame, v value.Value) (int64, error) {
+	switch v := v.(type) {
+	case *constant.Int:
+		return v.X.Int64(), nil
+	case *ir.Global:
+		return m.globals[v], nil
+	case *constant.ExprGetElementPtr:
+		indices := make([]value.Value, len(v.Indices))
+		for i, index := range v.Indices {
+			indices[i] = index
+		}
+		return m.gep(fr, v.Src, v.Elem, indices)
+	default:
+		x, ok := fr[v]
+		if !ok {
+			panic(fmt.Sprintf("support for value %T not yet implemented", v))
+		}
+		return x, nil
+	}
+}
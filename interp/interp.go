@@ -0,0 +1,216 @@
+// Package interp implements an interpreter for LLVM IR modules produced by
+// irgen.
+//
+// The interpreter executes µC programs without depending on external tools,
+// such as Clang or LLVM. The runtime library of testdata/uc.c (putint,
+// putstring, getint and getstring) is implemented natively.
+//
+// Memory is modelled as a flat byte-addressed address space, holding global
+// variables followed by the stack. Pointers are represented as addresses
+// within this address space, and the address 0 is never valid.
+package interp
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// StackSize specifies the size in bytes of the stack of interpreted programs.
+var StackSize int64 = 8 << 20
+
+// MaxCallDepth specifies the maximum depth of nested function calls of
+// interpreted programs.
+var MaxCallDepth = 100000
+
+// Run executes the main function of the given LLVM IR module, reading from r
+// and writing to w on calls to the runtime library. The return value of main
+// is returned as exit status.
+func Run(module *ir.Module, r io.Reader, w io.Writer) (int, error) {
+	m := newMachine(module, r, w)
+	var main *ir.Function
+	for _, f := range module.Funcs {
+		if f.Name == "main" && len(f.Blocks) > 0 {
+			main = f
+		}
+	}
+	if main == nil {
+		return 0, errutil.Newf("unable to locate definition of function main")
+	}
+	if err := m.initGlobals(); err != nil {
+		return 0, errutil.Err(err)
+	}
+	ret, err := m.call(main, nil)
+	if err != nil {
+		return 0, errutil.Err(err)
+	}
+	if err := m.w.Flush(); err != nil {
+		return 0, errutil.Err(err)
+	}
+	return int(int32(ret)), nil
+}
+
+// A machine holds the state of an interpreted program.
+type machine struct {
+	// LLVM IR module being interpreted.
+	module *ir.Module
+	// Memory of the program; global variables followed by the stack.
+	mem []byte
+	// Maps from global variables to their addresses.
+	globals map[*ir.Global]int64
+	// Stack pointer; address of the first unused byte of the stack.
+	sp int64
+	// Depth of nested function calls.
+	depth int
+	// Standard input of the program.
+	r *bufio.Reader
+	// Standard output of the program.
+	w *bufio.Writer
+}
+
+// newMachine returns a new machine for interpreting the given module.
+func newMachine(module *ir.Module, r io.Reader, w io.Writer) *machine {
+	m := &machine{
+		module:  module,
+		globals: make(map[*ir.Global]int64),
+		r:       bufio.NewReader(r),
+		w:       bufio.NewWriter(w),
+	}
+	// Lay out global variables, leaving the null address unused.
+	addr := int64(8)
+	for _, global := range module.Globals {
+		m.globals[global] = addr
+		addr = align(addr+sizeof(global.Content), 8)
+	}
+	m.sp = addr
+	m.mem = make([]byte, addr+StackSize)
+	return m
+}
+
+// initGlobals initializes the memory of global variables.
+func (m *machine) initGlobals() error {
+	for _, global := range m.module.Globals {
+		if global.Init == nil {
+			return errutil.Newf("support for external global variable %q not yet implemented", global.Name)
+		}
+		if err := m.storeConst(m.globals[global], global.Init); err != nil {
+			return errutil.Err(err)
+		}
+	}
+	return nil
+}
+
+// storeConst stores the given constant at addr.
+func (m *machine) storeConst(addr int64, c constant.Constant) error {
+	switch c := c.(type) {
+	case *constant.Int:
+		return m.store(addr, c.X.Int64(), c.Typ)
+	case *constant.ZeroInitializer:
+		// Memory is zero initialized.
+		return nil
+	case *constant.Array:
+		size := sizeof(c.Typ.Elem)
+		for i, elem := range c.Elems {
+			if err := m.storeConst(addr+int64(i)*size, elem); err != nil {
+				return errutil.Err(err)
+			}
+		}
+		return nil
+	default:
+		return errutil.Newf("support for constant %T not yet implemented", c)
+	}
+}
+
+// load loads a value of the given type from addr.
+func (m *machine) load(addr int64, typ types.Type) (int64, error) {
+	size := sizeof(typ)
+	if err := m.check(addr, size); err != nil {
+		return 0, errutil.Err(err)
+	}
+	buf := m.mem[addr : addr+size]
+	switch size {
+	case 1:
+		return normalize(int64(buf[0]), typ), nil
+	case 4:
+		return normalize(int64(binary.LittleEndian.Uint32(buf)), typ), nil
+	case 8:
+		return int64(binary.LittleEndian.Uint64(buf)), nil
+	default:
+		panic(fmt.Sprintf("support for loading values of type %v not yet implemented", typ))
+	}
+}
+
+// store stores the value x of the given type at addr.
+func (m *machine) store(addr, x int64, typ types.Type) error {
+	size := sizeof(typ)
+	if err := m.check(addr, size); err != nil {
+		return errutil.Err(err)
+	}
+	buf := m.mem[addr : addr+size]
+	switch size {
+	case 1:
+		buf[0] = byte(x)
+	case 4:
+		binary.LittleEndian.PutUint32(buf, uint32(x))
+	case 8:
+		binary.LittleEndian.PutUint64(buf, uint64(x))
+	default:
+		panic(fmt.Sprintf("support for storing values of type %v not yet implemented", typ))
+	}
+	return nil
+}
+
+// check reports an error if the memory range [addr, addr+size) is outside of
+// the address space.
+func (m *machine) check(addr, size int64) error {
+	if addr < 8 || addr+size > int64(len(m.mem)) {
+		return errutil.Newf("invalid memory access of %d bytes at address 0x%X", size, addr)
+	}
+	return nil
+}
+
+// sizeof returns the size in bytes of the given type.
+func sizeof(typ types.Type) int64 {
+	switch typ := typ.(type) {
+	case *types.IntType:
+		return (int64(typ.Size) + 7) / 8
+	case *types.PointerType:
+		return 8
+	case *types.ArrayType:
+		return typ.Len * sizeof(typ.Elem)
+	default:
+		panic(fmt.Sprintf("support for type %T not yet implemented", typ))
+	}
+}
+
+// normalize truncates x to the bit width of the given type, and sign-extends
+// the result (or zero-extends for booleans) to 64 bits.
+func normalize(x int64, typ types.Type) int64 {
+	t, ok := typ.(*types.IntType)
+	if !ok {
+		return x
+	}
+	switch t.Size {
+	case 1:
+		return x & 1
+	case 8:
+		return int64(int8(x))
+	case 32:
+		return int64(int32(x))
+	case 64:
+		return x
+	default:
+		panic(fmt.Sprintf("support for integer type %v not yet implemented", t))
+	}
+}
+
+// align rounds x up to the nearest multiple of n.
+func align(x, n int64) int64 {
+	return (x + n - 1) / n * n
+}
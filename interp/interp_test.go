@@ -0,0 +1,81 @@
+package interp_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/interp"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/sem"
+)
+
+func TestRun(t *testing.T) {
+	golden := []struct {
+		path   string
+		input  string
+		output string
+		status int
+	}{
+		{
+			path:   "../testdata/extra/irgen/cond_expr.c",
+			status: 99,
+		},
+		{
+			path:   "../testdata/extra/irgen/enum.c",
+			status: 66,
+		},
+		{
+			path:   "../testdata/extra/irgen/sizeof_expr.c",
+			status: 83,
+		},
+		{
+			path:   "../testdata/noisy/medium/fac.c",
+			input:  "5\n",
+			output: "120",
+		},
+		{
+			path:   "../testdata/noisy/simple/sim10.c",
+			input:  "bob\n12\n",
+			output: "Your name? Your age You are: bob\nYou are: 12\n",
+		},
+	}
+
+	for _, g := range golden {
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		s := scanner.NewFromBytes(buf)
+		p := parser.NewParser()
+		f, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("%q: parse error: %v", g.path, err)
+			continue
+		}
+		file := f.(*ast.File)
+		info, err := sem.Check(file)
+		if err != nil {
+			t.Errorf("%q: semantic analysis error: %v", g.path, err)
+			continue
+		}
+		module := irgen.Gen(file, info)
+		out := &bytes.Buffer{}
+		status, err := interp.Run(module, strings.NewReader(g.input), out)
+		if err != nil {
+			t.Errorf("%q: run error: %v", g.path, err)
+			continue
+		}
+		if got, want := out.String(), g.output; got != want {
+			t.Errorf("%q: output mismatch; expected %q, got %q", g.path, want, got)
+		}
+		if got, want := status, g.status; got != want {
+			t.Errorf("%q: exit status mismatch; expected %d, got %d", g.path, want, got)
+		}
+	}
+}
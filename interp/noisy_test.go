@@ -0,0 +1,89 @@
+package interp_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/interp"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/sem"
+)
+
+// TestNoisy compiles and executes every program of testdata/noisy.
+//
+// The standard input of a program "foo.c" is read from "foo.in", if present,
+// and its standard output is compared against "foo.out". The expected output
+// files match the output of the programs when compiled natively and linked with
+// testdata/uc.c.
+func TestNoisy(t *testing.T) {
+	testNoisy(t, irgen.Config{})
+}
+
+// TestNoisySSA compiles and executes every program of testdata/noisy, mapping
+// scalar local variables to SSA values.
+func TestNoisySSA(t *testing.T) {
+	testNoisy(t, irgen.Config{SSA: true})
+}
+
+// testNoisy compiles and executes every program of testdata/noisy, as specified
+// by config.
+func testNoisy(t *testing.T, config irgen.Config) {
+	paths, err := filepath.Glob("../testdata/noisy/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("unable to locate test programs")
+	}
+	for _, path := range paths {
+		base := strings.TrimSuffix(path, ".c")
+		input, err := ioutil.ReadFile(base + ".in")
+		if err != nil && !os.IsNotExist(err) {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		want, err := ioutil.ReadFile(base + ".out")
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+
+		// Compile program.
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Errorf("%q: %v", path, err)
+			continue
+		}
+		s := scanner.NewFromBytes(buf)
+		p := parser.NewParser()
+		f, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("%q: parse error: %v", path, err)
+			continue
+		}
+		file := f.(*ast.File)
+		info, err := sem.Check(file)
+		if err != nil {
+			t.Errorf("%q: semantic analysis error: %v", path, err)
+			continue
+		}
+		module := irgen.GenWith(file, info, config)
+
+		// Execute program.
+		out := &bytes.Buffer{}
+		if _, err := interp.Run(module, bytes.NewReader(input), out); err != nil {
+			t.Errorf("%q: run error: %v", path, err)
+			continue
+		}
+		if got := out.String(); got != string(want) {
+			t.Errorf("%q: output mismatch; expected %q, got %q", path, want, got)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package interp
+
+import (
+	"fmt"
+	"io"
+	"unicode"
+
+	"github.com/llir/llvm/ir/types"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// runtime maps from external function names to their native implementation,
+// as provided by testdata/uc.c.
+var runtime = map[string]func(m *machine, args []int64) (int64, error){
+	"putint":    putint,
+	"putstring": putstring,
+	"getint":    getint,
+	"getstring": getstring,
+}
+
+// putint implements:
+//
+//    void putint(int x)
+func putint(m *machine, args []int64) (int64, error) {
+	if _, err := fmt.Fprintf(m.w, "%d", int32(args[0])); err != nil {
+		return 0, errutil.Err(err)
+	}
+	return 0, nil
+}
+
+// putstring implements:
+//
+//    void putstring(char s[])
+func putstring(m *machine, args []int64) (int64, error) {
+	for addr := args[0]; ; addr++ {
+		if err := m.check(addr, 1); err != nil {
+			return 0, errutil.Err(err)
+		}
+		c := m.mem[addr]
+		if c == 0 {
+			break
+		}
+		if err := m.w.WriteByte(c); err != nil {
+			return 0, errutil.Err(err)
+		}
+	}
+	return 0, nil
+}
+
+// getint implements:
+//
+//    int getint(void)
+//
+// The behaviour mimics scanf("%d", &i); the result is 0 if no integer could be
+// read.
+func getint(m *machine, args []int64) (int64, error) {
+	// Flush output, as interactive programs prompt for input.
+	if err := m.w.Flush(); err != nil {
+		return 0, errutil.Err(err)
+	}
+	var x int32
+	if _, err := fmt.Fscan(m.r, &x); err != nil && err != io.EOF {
+		return 0, nil
+	}
+	return int64(x), nil
+}
+
+// getstring implements:
+//
+//    int getstring(char s[])
+//
+// The behaviour mimics scanf("%s", s); a whitespace delimited word is read
+// into s, and the number of words read (1 or -1 on end of input) is returned.
+func getstring(m *machine, args []int64) (int64, error) {
+	if err := m.w.Flush(); err != nil {
+		return 0, errutil.Err(err)
+	}
+	// Skip leading whitespace.
+	var c byte
+	for {
+		var err error
+		c, err = m.r.ReadByte()
+		if err == io.EOF {
+			return -1, nil
+		} else if err != nil {
+			return 0, errutil.Err(err)
+		}
+		if !unicode.IsSpace(rune(c)) {
+			break
+		}
+	}
+	addr := args[0]
+	for {
+		if err := m.store(addr, int64(c), types.I8); err != nil {
+			return 0, errutil.Err(err)
+		}
+		addr++
+		var err error
+		c, err = m.r.ReadByte()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, errutil.Err(err)
+		}
+		if unicode.IsSpace(rune(c)) {
+			if err := m.r.UnreadByte(); err != nil {
+				return 0, errutil.Err(err)
+			}
+			break
+		}
+	}
+	if err := m.store(addr, 0, types.I8); err != nil {
+		return 0, errutil.Err(err)
+	}
+	return 1, nil
+}
@@ -0,0 +1,84 @@
+package irgen
+
+import (
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/debuginfo"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+// GenDebug generates LLVM IR based on the syntax tree of the given file, as
+// specified by config, and attaches debug metadata to the generated values
+// based on the line tables of the input source. The builder of the debug
+// metadata is used to write the LLVM IR assembly of the module.
+func GenDebug(file *ast.File, info *sem.Info, src *semerrors.Source, config Config) (*ir.Module, *debuginfo.Builder) {
+	m := gen(file, info, config, src)
+	return m.Module, m.debug
+}
+
+// debugFunc attaches debug metadata to the given function definition, if debug
+// information is enabled.
+func (m *Module) debugFunc(f *Function, n *ast.FuncDecl) {
+	if m.debug == nil {
+		return
+	}
+	f.debug = m.debug
+	f.sp = m.debug.Func(f.Function, n)
+	f.pos = n.Start()
+}
+
+// debugGlobal attaches debug metadata to the given global variable definition,
+// if debug information is enabled.
+func (m *Module) debugGlobal(global *ir.Global, n *ast.VarDecl) {
+	if m.debug == nil {
+		return
+	}
+	m.debug.Global(global, n)
+}
+
+// debugVar emits to the current basic block a call to llvm.dbg.declare for the
+// given local variable allocated in memory, if debug information is enabled.
+// Function parameters are identified by their 1-based argument index arg, which
+// is 0 for local variables.
+func (f *Function) debugVar(alloca *ir.InstAlloca, n *ast.VarDecl, arg int) {
+	if f.debug == nil {
+		return
+	}
+	f.debug.Declare(f.curBlock.BasicBlock, alloca, n, arg, f.sp)
+}
+
+// setPos sets the source code position of the statement currently being
+// lowered. Instructions emitted so far to the current basic block are located
+// at the previous position.
+func (f *Function) setPos(pos int) {
+	if f.debug == nil {
+		return
+	}
+	if f.curBlock != nil {
+		f.recordPos(f.curBlock.BasicBlock)
+	}
+	f.pos = pos
+}
+
+// recordPos attaches the location of the current source code position to the
+// instructions and terminator of the given basic block without location.
+func (f *Function) recordPos(block *ir.BasicBlock) {
+	var loc *metadata.Metadata
+	attach := func(inst ir.Instruction) {
+		if debuginfo.HasLocation(inst) {
+			return
+		}
+		if loc == nil {
+			loc = f.debug.Location(f.pos, f.sp)
+		}
+		debuginfo.AttachLocation(inst, loc)
+	}
+	for _, inst := range block.Insts {
+		attach(inst)
+	}
+	if block.Term != nil {
+		attach(block.Term)
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"log"
 
 	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/metadata"
 	irtypes "github.com/llir/llvm/ir/types"
 	"github.com/llir/llvm/ir/value"
 	"github.com/mewkiz/pkg/term"
 	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/debuginfo"
 	"github.com/mewmew/uc/sem"
 )
 
@@ -19,20 +21,38 @@ import (
 // dbg is a logger which prefixes debug messages with "irgen:".
 var dbg = log.New(ioutil.Discard, term.WhiteBold("irgen:"), log.Lshortfile)
 
+// Config specifies the options of LLVM IR generation.
+type Config struct {
+	// SSA specifies whether to map scalar local variables and function
+	// parameters directly to SSA values, instead of allocating them in memory.
+	// Arrays and local variables captured by nested functions are still
+	// allocated in memory.
+	//
+	// SSA construction is based on the algorithm presented in "Simple and
+	// Efficient Construction of Static Single Assignment Form" by Braun et al.
+	SSA bool
+}
+
 // A Module represents an LLVM IR module generator.
 type Module struct {
 	// Module being generated.
 	*ir.Module
 	// info holds semantic information about the program from the type-checker.
 	info *sem.Info
+	// Options of LLVM IR generation.
+	config Config
 	// Maps from identifier source code position to the associated value.
 	idents map[int]value.Value
+	// Map of existing nested function names.
+	exists map[string]bool
+	// Builder of debug metadata; or nil if debug information is disabled.
+	debug *debuginfo.Builder
 }
 
-// NewModule returns a new module generator.
-func NewModule(info *sem.Info) *Module {
+// NewModule returns a new module generator, as specified by config.
+func NewModule(info *sem.Info, config Config) *Module {
 	m := ir.NewModule()
-	return &Module{Module: m, info: info, idents: make(map[int]value.Value)}
+	return &Module{Module: m, info: info, config: config, idents: make(map[int]value.Value), exists: make(map[string]bool)}
 }
 
 // emitFunc emits to m the given function.
@@ -55,6 +75,32 @@ type Function struct {
 	idents map[int]value.Value
 	// Map of existing local variable names.
 	exists map[string]bool
+	// Builder of debug metadata; or nil if debug information is disabled.
+	debug *debuginfo.Builder
+	// Subprogram of the function; used when debug information is enabled.
+	sp *metadata.Metadata
+	// Source code position of the statement currently being lowered.
+	pos int
+
+	// SSA construction state; used when Config.SSA is enabled.
+
+	// Basic blocks of the function, in order of creation.
+	blocks []*ir.BasicBlock
+	// Maps from basic blocks to their predecessors.
+	preds map[*ir.BasicBlock][]*ir.BasicBlock
+	// Set of sealed basic blocks; i.e. basic blocks with known predecessors.
+	sealed map[*ir.BasicBlock]bool
+	// Maps from unsealed basic blocks to their incomplete phi instructions.
+	incompletePhis map[*ir.BasicBlock][]incompletePhi
+	// Maps from phi instructions to their parent basic blocks.
+	phiBlocks map[*ir.InstPhi]*ir.BasicBlock
+	// Maps from source code position of promoted local variables to their type.
+	ssaTypes map[int]irtypes.Type
+	// Maps from source code position of promoted local variables to their
+	// current value in each basic block.
+	defs map[int]map[*ir.BasicBlock]value.Value
+	// Maps from removed trivial phi instructions to their replacement values.
+	removed map[*ir.InstPhi]value.Value
 }
 
 // NewFunction returns a new function generator based on the given function name
@@ -63,13 +109,25 @@ type Function struct {
 // The caller is responsible for initializing basic blocks.
 func NewFunction(name string, sig *irtypes.FuncType) *Function {
 	f := ir.NewFunction(name, sig.Ret, sig.Params...)
-	return &Function{Function: f, idents: make(map[int]value.Value), exists: make(map[string]bool)}
+	return &Function{
+		Function:       f,
+		idents:         make(map[int]value.Value),
+		exists:         make(map[string]bool),
+		preds:          make(map[*ir.BasicBlock][]*ir.BasicBlock),
+		sealed:         make(map[*ir.BasicBlock]bool),
+		incompletePhis: make(map[*ir.BasicBlock][]incompletePhi),
+		phiBlocks:      make(map[*ir.InstPhi]*ir.BasicBlock),
+		ssaTypes:       make(map[int]irtypes.Type),
+		defs:           make(map[int]map[*ir.BasicBlock]value.Value),
+		removed:        make(map[*ir.InstPhi]value.Value),
+	}
 }
 
 // startBody initializes the generation of the function body.
 func (f *Function) startBody() {
 	entry := f.NewBlock("") // "entry"
 	f.curBlock = entry
+	f.sealBlock(entry)
 }
 
 // endBody finalizes the generation of the function body.
@@ -126,6 +184,7 @@ type BasicBlock struct {
 // parent function.
 func (f *Function) NewBlock(name string) *BasicBlock {
 	block := ir.NewBlock(name)
+	f.blocks = append(f.blocks, block)
 	return &BasicBlock{BasicBlock: block, parent: f}
 }
 
@@ -149,5 +208,19 @@ func (b *BasicBlock) SetTerm(term ir.Terminator) {
 		panic(fmt.Sprintf("terminator instruction already set for basic block; old term (%v), new term (%v), basic block (%v)", term, b.Term, b))
 	}
 	b.BasicBlock.Term = term
+	if b.parent.debug != nil {
+		b.parent.recordPos(b.BasicBlock)
+	}
 	b.parent.Blocks = append(b.parent.Blocks, b.BasicBlock)
+	// Record the predecessors of successor basic blocks.
+	var succs []*ir.BasicBlock
+	switch term := term.(type) {
+	case *ir.TermBr:
+		succs = append(succs, term.Target)
+	case *ir.TermCondBr:
+		succs = append(succs, term.TargetTrue, term.TargetFalse)
+	}
+	for _, succ := range succs {
+		b.parent.preds[succ] = append(b.parent.preds[succ], b.BasicBlock)
+	}
 }
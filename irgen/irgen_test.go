@@ -106,6 +106,10 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/unary_expr_sub.c",
 			want: "../testdata/extra/irgen/unary_expr_sub.ll",
 		},
+		{
+			path: "../testdata/extra/irgen/unary_expr_sub_cond.c",
+			want: "../testdata/extra/irgen/unary_expr_sub_cond.ll",
+		},
 		{
 			path: "../testdata/extra/irgen/unary_expr_not.c",
 			want: "../testdata/extra/irgen/unary_expr_not.ll",
@@ -182,6 +186,10 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/call_expr_cast.c",
 			want: "../testdata/extra/irgen/call_expr_cast.ll",
 		},
+		{
+			path: "../testdata/extra/irgen/call_expr_extern.c",
+			want: "../testdata/extra/irgen/call_expr_extern.ll",
+		},
 		// NOTE: Correct output. The only difference is that Clang emits all
 		// alloca instructions at the beginning of the entry block. Thus, disabled
 		// for now.
@@ -217,6 +225,35 @@ func TestGen(t *testing.T) {
 			path: "../testdata/extra/irgen/array_param.c",
 			want: "../testdata/extra/irgen/array_param.ll",
 		},
+		// Cast expressions.
+		{
+			path: "../testdata/extra/irgen/cast_expr.c",
+			want: "../testdata/extra/irgen/cast_expr.ll",
+		},
+		// Sizeof expressions and constant initializers.
+		{
+			path: "../testdata/extra/irgen/sizeof_expr.c",
+			want: "../testdata/extra/irgen/sizeof_expr.ll",
+		},
+		// Conditional expressions and the comma operator.
+		{
+			path: "../testdata/extra/irgen/cond_expr.c",
+			want: "../testdata/extra/irgen/cond_expr.ll",
+		},
+		{
+			path: "../testdata/extra/irgen/binary_expr_land_cond.c",
+			want: "../testdata/extra/irgen/binary_expr_land_cond.ll",
+		},
+		// Enumerations.
+		{
+			path: "../testdata/extra/irgen/enum.c",
+			want: "../testdata/extra/irgen/enum.ll",
+		},
+		// Nested functions.
+		{
+			path: "../testdata/extra/irgen/nested_func.c",
+			want: "../testdata/extra/irgen/nested_func.ll",
+		},
 		// Bug fixes.
 		{
 			path: "../testdata/extra/irgen/issue_68_nested_if.c",
@@ -335,3 +372,45 @@ func TestGen(t *testing.T) {
 		fmt.Println("PASS:", g.path)
 	}
 }
+
+func TestGenSSA(t *testing.T) {
+	golden := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../testdata/extra/irgen/ssa/ssa.c",
+			want: "../testdata/extra/irgen/ssa/ssa.ll",
+		},
+	}
+
+	for _, g := range golden {
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		s := scanner.NewFromBytes(buf)
+		p := parser.NewParser()
+		f, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("%q: parse error: %v", g.path, err)
+			continue
+		}
+		file := f.(*ast.File)
+		info, err := sem.Check(file)
+		if err != nil {
+			t.Errorf("%q: semantic analysis error: %v", g.path, err)
+			continue
+		}
+		module := irgen.GenWith(file, info, irgen.Config{SSA: true})
+		buf, err = ioutil.ReadFile(g.want)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		if got, want := module.String(), string(buf); got != want {
+			t.Errorf("%q: module mismatch; expected `%v`, got `%v`", g.path, want, got)
+		}
+	}
+}
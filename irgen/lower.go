@@ -13,21 +13,42 @@ import (
 	"github.com/llir/llvm/ir/value"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/debuginfo"
 	"github.com/mewmew/uc/sem"
+	semconstant "github.com/mewmew/uc/sem/constant"
+	semerrors "github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/token"
+	uctypes "github.com/mewmew/uc/types"
 )
 
-// Gen generates LLVM IR based on the syntax tree of the given file.
+// Gen generates LLVM IR based on the syntax tree of the given file, using the
+// default options.
 func Gen(file *ast.File, info *sem.Info) *ir.Module {
-	return gen(file, info)
+	return GenWith(file, info, Config{})
+}
+
+// GenWith generates LLVM IR based on the syntax tree of the given file, as
+// specified by config.
+func GenWith(file *ast.File, info *sem.Info, config Config) *ir.Module {
+	return gen(file, info, config, nil).Module
 }
 
 // === [ File scope ] ==========================================================
 
-// gen generates LLVM IR based on the syntax tree of the given file.
-func gen(file *ast.File, info *sem.Info) *ir.Module {
-	m := NewModule(info)
+// gen generates LLVM IR based on the syntax tree of the given file, as
+// specified by config. Debug metadata is attached to the generated values based
+// on the line tables of src, if non-nil.
+func gen(file *ast.File, info *sem.Info, config Config, src *semerrors.Source) *Module {
+	m := NewModule(info, config)
+	if src != nil {
+		m.debug = debuginfo.NewBuilder(m.Module, src)
+	}
 	for _, decl := range file.Decls {
+		// Ignore enumeration declarations, as enumeration constants are folded
+		// into constants at their uses.
+		if _, ok := decl.(*ast.EnumDecl); ok {
+			continue
+		}
 		// Ignore tentative definitions.
 		if isTentativeDef(decl) {
 			dbg.Printf("ignoring tentative definition of %q", decl.Name())
@@ -44,7 +65,7 @@ func gen(file *ast.File, info *sem.Info) *ir.Module {
 			panic(fmt.Sprintf("support for %T not yet implemented", decl))
 		}
 	}
-	return m.Module
+	return m
 }
 
 // --- [ Function declaration ] ------------------------------------------------
@@ -65,18 +86,72 @@ func (m *Module) funcDecl(n *ast.FuncDecl) {
 		dbg.Printf("create function declaration: %v", n)
 		// Emit function declaration.
 		m.emitFunc(f)
+		// Map uses of external functions to the function declaration; uses of
+		// functions defined within the file are mapped to their definition.
+		if ident.Decl == n {
+			m.setIdentValue(ident, f.Function)
+		}
 		return
 	}
 	m.setIdentValue(ident, f.Function)
+	m.debugFunc(f, n)
 
 	// Generate function body.
 	dbg.Printf("create function definition: %v", n)
-	m.funcBody(f, n.FuncType.Params, n.Body)
+	m.funcBody(f, n.FuncType.Params, nil, n.Body)
+}
+
+// nestedFuncDecl lowers the given nested function declaration to LLVM IR,
+// emitting code to m.
+//
+// Nested function definitions are lambda lifted to the top-level of the module,
+// and receive the addresses of captured local variables of enclosing functions
+// as additional parameters.
+func (m *Module) nestedFuncDecl(f *Function, n *ast.FuncDecl) {
+	// Input:
+	//    int f(void) {
+	//       int x;
+	//       void g(void) {
+	//          x = 42;
+	//       }
+	//       g();
+	//       return x;
+	//    }
+	// Output:
+	//    define void @f.g(i32* %x) {
+	//       store i32 42, i32* %x
+	//       ret void
+	//    }
+	if !astutil.IsDef(n) {
+		panic(fmt.Sprintf("support for nested function declarations not yet implemented: %v", n))
+	}
+	ident := n.Name()
+	typ := toIrType(n.Type())
+	sig, ok := typ.(*irtypes.FuncType)
+	if !ok {
+		panic(fmt.Sprintf("invalid function type; expected *types.FuncType, got %T", typ))
+	}
+	captures := m.info.Captures[n]
+	params := sig.Params
+	for _, capture := range captures {
+		addr := m.valueFromIdent(f, capture.Name())
+		param := irtypes.NewParam(capture.Name().Name, addr.Type())
+		params = append(params, param)
+	}
+	name := m.genUnique(fmt.Sprintf("%s.%s", f.Name, ident))
+	nested := NewFunction(name, irtypes.NewFunc(sig.Ret, params...))
+	m.setIdentValue(ident, nested.Function)
+	m.debugFunc(nested, n)
+
+	// Generate function body.
+	dbg.Printf("create nested function definition: %v", n)
+	m.funcBody(nested, n.FuncType.Params, captures, n.Body)
 }
 
 // funcBody lowers the given function declaration to LLVM IR, emitting code to
-// m.
-func (m *Module) funcBody(f *Function, params []*ast.VarDecl, body *ast.BlockStmt) {
+// m. The trailing parameters of f hold the addresses of the captured local
+// variables of nested functions.
+func (m *Module) funcBody(f *Function, params []*ast.VarDecl, captures []*ast.VarDecl, body *ast.BlockStmt) {
 	// Initialize function body.
 	f.startBody()
 
@@ -87,8 +162,19 @@ func (m *Module) funcBody(f *Function, params []*ast.VarDecl, body *ast.BlockStm
 	// approach which only needs one of these two.
 
 	// Emit local variable declarations for function parameters.
-	for i, param := range f.Sig.Params {
+	nparams := len(f.Sig.Params) - len(captures)
+	for i, param := range f.Sig.Params[:nparams] {
+		if m.isPromotable(params[i]) {
+			// Map the parameter directly to its SSA value.
+			dbg.Printf("create SSA function parameter: %v", params[i])
+			ident := params[i].Name()
+			f.genUnique(ident)
+			f.promote(params[i])
+			f.writeVariable(ident, param)
+			continue
+		}
 		p := m.funcParam(f, param)
+		f.debugVar(p.(*ir.InstAlloca), params[i], i+1)
 		// Add mapping from parameter name to the corresponding allocated local
 		// variable; i.e.
 		//
@@ -114,6 +200,14 @@ func (m *Module) funcBody(f *Function, params []*ast.VarDecl, body *ast.BlockStm
 		f.setIdentValue(ident, p)
 	}
 
+	// Map captured local variables to the corresponding address parameters.
+	for i, capture := range captures {
+		param := f.Sig.Params[nparams+i]
+		ident := capture.Name()
+		param.SetName(f.genUnique(ident))
+		f.setIdentValue(ident, param)
+	}
+
 	// Generate function body.
 	m.stmt(f, body)
 
@@ -121,6 +215,7 @@ func (m *Module) funcBody(f *Function, params []*ast.VarDecl, body *ast.BlockStm
 	if err := f.endBody(); err != nil {
 		panic(fmt.Sprintf("unable to finalize function body; %v", err))
 	}
+	f.resolveRemovedPhis()
 
 	// Emit function definition.
 	m.emitFunc(f)
@@ -146,15 +241,23 @@ func (m *Module) funcParam(f *Function, param *irtypes.Param) value.Value {
 func (m *Module) globalVarDecl(n *ast.VarDecl) {
 	// Input:
 	//    int x;
+	//    int y = 2 + 3;
 	// Output:
 	//    @x = global i32 0
+	//    @y = global i32 5
 	ident := n.Name()
 	dbg.Printf("create global variable: %v", n)
 	typ := toIrType(n.Type())
 	var val constant.Constant
 	switch {
 	case n.Val != nil:
-		panic("support for global variable initializer not yet implemented")
+		// Initializers of global variables are constant expressions, as verified
+		// by the type-checker.
+		v, err := semconstant.Eval(n.Val, m.info.Types)
+		if err != nil {
+			panic(fmt.Sprintf("unable to evaluate global variable initializer; %v", err))
+		}
+		val = constant.NewInt(semconstant.Convert(v, n.Type()), typ)
 	case irtypes.IsInt(typ):
 		val = constant.NewInt(0, typ)
 	default:
@@ -164,6 +267,7 @@ func (m *Module) globalVarDecl(n *ast.VarDecl) {
 	m.setIdentValue(ident, global)
 	// Emit global variable definition.
 	m.emitGlobal(global)
+	m.debugGlobal(global, n)
 }
 
 // --- [ Type definition ] -----------------------------------------------------
@@ -187,13 +291,31 @@ func (m *Module) localVarDef(f *Function, n *ast.VarDecl) {
 	// Output:
 	//    %a = alloca i32
 	ident := n.Name()
+	f.setPos(n.Start())
+	if m.isPromotable(n) {
+		// Input:
+		//    int b = 42;
+		// Output:
+		//    (b is mapped to the SSA value i32 42)
+		dbg.Printf("create SSA local variable: %v", n)
+		f.promote(n)
+		if n.Val != nil {
+			f.writeVariable(ident, m.convertedExpr(f, n.Val))
+		}
+		return
+	}
 	dbg.Printf("create local variable: %v", n)
 	typ := toIrType(n.Type())
 	allocaInst := f.curBlock.NewAlloca(typ)
+	f.debugVar(allocaInst, n, 0)
 	// Emit local variable definition.
 	f.emitLocal(ident, allocaInst)
 	if n.Val != nil {
-		panic("support for local variable definition initializer not yet implemented")
+		// Input:
+		//    int b = 42;
+		// Output:
+		//    store i32 42, i32* %b
+		f.curBlock.NewStore(m.convertedExpr(f, n.Val), allocaInst)
 	}
 }
 
@@ -201,6 +323,9 @@ func (m *Module) localVarDef(f *Function, n *ast.VarDecl) {
 
 // stmt lowers the given statement to LLVM IR, emitting code to f.
 func (m *Module) stmt(f *Function, stmt ast.Stmt) {
+	if _, ok := stmt.(*ast.BlockStmt); !ok {
+		f.setPos(stmt.Start())
+	}
 	switch stmt := stmt.(type) {
 	case *ast.BlockStmt:
 		m.blockStmt(f, stmt)
@@ -228,11 +353,18 @@ func (m *Module) stmt(f *Function, stmt ast.Stmt) {
 // blockStmt lowers the given block statement to LLVM IR, emitting code to f.
 func (m *Module) blockStmt(f *Function, stmt *ast.BlockStmt) {
 	for _, item := range stmt.Items {
+		if f.curBlock == nil {
+			// The remaining block items follow a return statement, and are thus
+			// unreachable.
+			return
+		}
 		switch item := item.(type) {
 		case ast.Decl:
 			switch decl := item.(type) {
+			case *ast.EnumDecl:
+				// Enumeration constants are folded into constants at their uses.
 			case *ast.FuncDecl:
-				panic(fmt.Sprintf("support for nested function declarations not yet implemented: %v", decl))
+				m.nestedFuncDecl(f, decl)
 			case *ast.VarDecl:
 				m.localVarDef(f, decl)
 			case *ast.TypeDef:
@@ -261,6 +393,10 @@ func (m *Module) ifStmt(f *Function, stmt *ast.IfStmt) {
 	}
 	termCondBr := ir.NewCondBr(cond, trueBranch.BasicBlock, falseBranch.BasicBlock)
 	f.curBlock.SetTerm(termCondBr)
+	f.sealBlock(trueBranch)
+	if stmt.Else != nil {
+		f.sealBlock(falseBranch)
+	}
 	f.curBlock = trueBranch
 	m.stmt(f, stmt.Body)
 	// Emit jump if body doesn't end with return statement (i.e. the current
@@ -279,6 +415,7 @@ func (m *Module) ifStmt(f *Function, stmt *ast.IfStmt) {
 			f.curBlock.SetTerm(termBr)
 		}
 	}
+	f.sealBlock(end)
 	f.curBlock = end
 }
 
@@ -296,10 +433,7 @@ func (m *Module) returnStmt(f *Function, stmt *ast.ReturnStmt) {
 		f.curBlock = nil
 		return
 	}
-	result := m.expr(f, stmt.Result)
-	// Implicit conversion.
-	resultType := f.Sig.Ret
-	result = m.convert(f, result, resultType)
+	result := m.convertedExpr(f, stmt.Result)
 	termRet := ir.NewRet(result)
 	f.curBlock.SetTerm(termRet)
 	f.curBlock = nil
@@ -316,6 +450,8 @@ func (m *Module) whileStmt(f *Function, stmt *ast.WhileStmt) {
 	endBranch := f.NewBlock("")
 	termCondBr := ir.NewCondBr(cond, bodyBranch.BasicBlock, endBranch.BasicBlock)
 	f.curBlock.SetTerm(termCondBr)
+	f.sealBlock(bodyBranch)
+	f.sealBlock(endBranch)
 	f.curBlock = bodyBranch
 	m.stmt(f, stmt.Body)
 	// Emit jump if body doesn't end with return statement (i.e. the current
@@ -324,6 +460,8 @@ func (m *Module) whileStmt(f *Function, stmt *ast.WhileStmt) {
 		termBr := ir.NewBr(condBranch.BasicBlock)
 		f.curBlock.SetTerm(termBr)
 	}
+	// All predecessors of the loop header are known after the back edge.
+	f.sealBlock(condBranch)
 	f.curBlock = endBranch
 }
 
@@ -352,12 +490,18 @@ func (m *Module) expr(f *Function, expr ast.Expr) value.Value {
 		return m.binaryExpr(f, expr)
 	case *ast.CallExpr:
 		return m.callExpr(f, expr)
+	case *ast.CastExpr:
+		return m.castExpr(f, expr)
+	case *ast.CondExpr:
+		return m.condExpr(f, expr)
 	case *ast.Ident:
 		return m.identUse(f, expr)
 	case *ast.IndexExpr:
 		return m.indexExprUse(f, expr)
 	case *ast.ParenExpr:
 		return m.expr(f, expr.X)
+	case *ast.SizeofExpr:
+		return m.sizeofExpr(f, expr)
 	case *ast.UnaryExpr:
 		return m.unaryExpr(f, expr)
 	default:
@@ -365,6 +509,23 @@ func (m *Module) expr(f *Function, expr ast.Expr) value.Value {
 	}
 }
 
+// convertedExpr lowers the given expression to LLVM IR, emitting code to f. The
+// implicit conversion of expr recorded by the type-checker, if any, is applied
+// to the resulting value.
+func (m *Module) convertedExpr(f *Function, expr ast.Expr) value.Value {
+	v := m.expr(f, expr)
+	if typ, ok := m.info.Conversions[expr]; ok {
+		return m.convert(f, v, toIrType(typ))
+	}
+	if irtypes.IsBool(v.Type()) {
+		// Boolean values (e.g. the result of comparisons) are represented as i1
+		// in LLVM IR, and are extended to the type of the expression when used
+		// as values.
+		return m.convert(f, v, m.typeOf(expr))
+	}
+	return v
+}
+
 // basicLit lowers the given basic literal to LLVM IR, emitting code to f.
 func (m *Module) basicLit(f *Function, n *ast.BasicLit) value.Value {
 	typ := m.typeOf(n)
@@ -387,63 +548,53 @@ func (m *Module) binaryExpr(f *Function, n *ast.BinaryExpr) value.Value {
 	switch n.Op {
 	// +
 	case token.Add:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewAdd(x, y)
 
 	// -
 	case token.Sub:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewSub(x, y)
 
 	// *
 	case token.Mul:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewMul(x, y)
 
 	// /
 	case token.Div:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		// TODO: Add support for unsigned division.
 		return f.curBlock.NewSDiv(x, y)
 
 	// <
 	case token.Lt:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewICmp(ir.IntSLT, x, y)
 
 	// >
 	case token.Gt:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewICmp(ir.IntSGT, x, y)
 
 	// <=
 	case token.Le:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewICmp(ir.IntSLE, x, y)
 
 	// >=
 	case token.Ge:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewICmp(ir.IntSGE, x, y)
 
 	// !=
 	case token.Ne:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewICmp(ir.IntNE, x, y)
 
 	// ==
 	case token.Eq:
-		x, y := m.expr(f, n.X), m.expr(f, n.Y)
-		x, y = m.implicitConversion(f, x, y)
+		x, y := m.convertedExpr(f, n.X), m.convertedExpr(f, n.Y)
 		return f.curBlock.NewICmp(ir.IntEQ, x, y)
 
 	// &&
@@ -455,24 +606,37 @@ func (m *Module) binaryExpr(f *Function, n *ast.BinaryExpr) value.Value {
 		end := f.NewBlock("")
 		termCondBr := ir.NewCondBr(x, trueBranch.BasicBlock, end.BasicBlock)
 		f.curBlock.SetTerm(termCondBr)
+		f.sealBlock(trueBranch)
 		f.curBlock = trueBranch
 
+		// The second operand may span several basic blocks (e.g. conditional
+		// expressions), so the incoming value of the phi instruction originates
+		// from the current basic block at the end of the true branch.
 		y := m.cond(f, n.Y)
+		trueEnd := f.curBlock
 		termBr := ir.NewBr(end.BasicBlock)
-		trueBranch.SetTerm(termBr)
+		trueEnd.SetTerm(termBr)
+		f.sealBlock(end)
 		f.curBlock = end
 
 		var incs []*ir.Incoming
 		zero := constZero(irtypes.I1)
 		inc := ir.NewIncoming(zero, start.BasicBlock)
 		incs = append(incs, inc)
-		inc = ir.NewIncoming(y, trueBranch.BasicBlock)
+		inc = ir.NewIncoming(y, trueEnd.BasicBlock)
 		incs = append(incs, inc)
 		return f.curBlock.NewPhi(incs...)
 
+	// ,
+	case token.Comma:
+		// The first operand is evaluated for its side effects, before the
+		// second operand.
+		m.expr(f, n.X)
+		return m.expr(f, n.Y)
+
 	// =
 	case token.Assign:
-		y := m.expr(f, n.Y)
+		y := m.convertedExpr(f, n.Y)
 		switch expr := n.X.(type) {
 		case *ast.Ident:
 			m.identDef(f, expr, y)
@@ -495,17 +659,21 @@ func (m *Module) callExpr(f *Function, callExpr *ast.CallExpr) value.Value {
 	if !ok {
 		panic(fmt.Sprintf("invalid function type; expected *types.FuncType, got %T", typ))
 	}
-	params := sig.Params
 	result := sig.Ret
 	// TODO: Validate result against function return type.
 	_ = result
 	var args []value.Value
 	// TODO: Add support for variadic arguments.
-	for i, arg := range callExpr.Args {
-		expr := m.expr(f, arg)
-		expr = m.convert(f, expr, params[i].Type())
+	for _, arg := range callExpr.Args {
+		expr := m.convertedExpr(f, arg)
 		args = append(args, expr)
 	}
+	// Pass the addresses of captured local variables to nested functions.
+	if def, ok := callExpr.Name.Decl.(*ast.FuncDecl); ok {
+		for _, capture := range m.info.Captures[def] {
+			args = append(args, m.valueFromIdent(f, capture.Name()))
+		}
+	}
 	v := m.valueFromIdent(f, callExpr.Name)
 	callee, ok := v.(*ir.Function)
 	if !ok {
@@ -514,6 +682,74 @@ func (m *Module) callExpr(f *Function, callExpr *ast.CallExpr) value.Value {
 	return f.curBlock.NewCall(callee, args...)
 }
 
+// castExpr lowers the given cast expression to LLVM IR, emitting code to f.
+func (m *Module) castExpr(f *Function, n *ast.CastExpr) value.Value {
+	// Input:
+	//    void f() {
+	//       int x;
+	//       (char)x;         // <-- relevant line
+	//    }
+	// Output:
+	//    %2 = load i32, i32* %1
+	//    %3 = trunc i32 %2 to i8
+	x := m.expr(f, n.X)
+	typ := m.typeOf(n)
+	if irtypes.IsVoid(typ) {
+		// The value of casts to void is discarded.
+		return x
+	}
+	return m.convert(f, x, typ)
+}
+
+// condExpr lowers the given conditional expression to LLVM IR, emitting code to
+// f.
+func (m *Module) condExpr(f *Function, n *ast.CondExpr) value.Value {
+	// Input:
+	//    c ? x : y
+	// Output:
+	//    br i1 %c, label %1, label %2
+	//    ; <label>:1
+	//    br label %3
+	//    ; <label>:2
+	//    br label %3
+	//    ; <label>:3
+	//    %4 = phi i32 [ %x, %1 ], [ %y, %2 ]
+	cond := m.cond(f, n.Cond)
+	trueBranch := f.NewBlock("")
+	falseBranch := f.NewBlock("")
+	end := f.NewBlock("")
+	termCondBr := ir.NewCondBr(cond, trueBranch.BasicBlock, falseBranch.BasicBlock)
+	f.curBlock.SetTerm(termCondBr)
+	f.sealBlock(trueBranch)
+	f.sealBlock(falseBranch)
+
+	// Only the selected operand is evaluated. The operands may span several
+	// basic blocks (e.g. nested conditional expressions), so the incoming
+	// values of the phi instruction originate from the current basic block at
+	// the end of each branch.
+	f.curBlock = trueBranch
+	x := m.convertedExpr(f, n.X)
+	trueEnd := f.curBlock
+	trueEnd.SetTerm(ir.NewBr(end.BasicBlock))
+
+	f.curBlock = falseBranch
+	y := m.convertedExpr(f, n.Y)
+	falseEnd := f.curBlock
+	falseEnd.SetTerm(ir.NewBr(end.BasicBlock))
+	f.sealBlock(end)
+
+	f.curBlock = end
+	if uctypes.IsVoid(m.info.Types[n]) {
+		// The result of void conditional expressions may not be used.
+		return nil
+	}
+	incs := []*ir.Incoming{
+		ir.NewIncoming(x, trueEnd.BasicBlock),
+		ir.NewIncoming(y, falseEnd.BasicBlock),
+	}
+	return f.curBlock.NewPhi(incs...)
+}
+
 // ident lowers the given identifier to LLVM IR, emitting code to f.
 func (m *Module) ident(f *Function, ident *ast.Ident) value.Value {
 	switch typ := m.typeOf(ident).(type) {
@@ -555,8 +791,21 @@ func (m *Module) ident(f *Function, ident *ast.Ident) value.Value {
 
 // identUse lowers the given identifier usage to LLVM IR, emitting code to f.
 func (m *Module) identUse(f *Function, ident *ast.Ident) value.Value {
-	v := m.ident(f, ident)
 	typ := m.typeOf(ident)
+	if enumerator, ok := ident.Decl.(*ast.Enumerator); ok {
+		// Input:
+		//    enum { N = 42 };
+		//    int f() {
+		//       return N;    // <-- relevant line
+		//    }
+		// Output:
+		//    ret i32 42
+		return constant.NewInt(int64(enumerator.Val), typ)
+	}
+	if f.isPromoted(ident) {
+		return f.readVariable(ident)
+	}
+	v := m.ident(f, ident)
 	if isRef(typ) {
 		return v
 	}
@@ -567,12 +816,11 @@ func (m *Module) identUse(f *Function, ident *ast.Ident) value.Value {
 // identDef lowers the given identifier definition to LLVM IR, emitting code to
 // f.
 func (m *Module) identDef(f *Function, ident *ast.Ident, v value.Value) {
-	addr := m.ident(f, ident)
-	addrType, ok := addr.Type().(*irtypes.PointerType)
-	if !ok {
-		panic(fmt.Sprintf("invalid pointer type; expected *types.PointerType, got %T", addr.Type()))
+	if f.isPromoted(ident) {
+		f.writeVariable(ident, v)
+		return
 	}
-	v = m.convert(f, v, addrType.Elem)
+	addr := m.ident(f, ident)
 	f.curBlock.NewStore(v, addr)
 }
 
@@ -641,14 +889,19 @@ func (m *Module) indexExprUse(f *Function, n *ast.IndexExpr) value.Value {
 // emitting code to f.
 func (m *Module) indexExprDef(f *Function, n *ast.IndexExpr, v value.Value) {
 	addr := m.indexExpr(f, n)
-	addrType, ok := addr.Type().(*irtypes.PointerType)
-	if !ok {
-		panic(fmt.Sprintf("invalid pointer type; expected *types.PointerType, got %T", addr.Type()))
-	}
-	v = m.convert(f, v, addrType.Elem)
 	f.curBlock.NewStore(v, addr)
 }
 
+// sizeofExpr lowers the given sizeof expression to LLVM IR, emitting code to f.
+func (m *Module) sizeofExpr(f *Function, n *ast.SizeofExpr) value.Value {
+	// The operand of sizeof is not evaluated; the size is known at compile time.
+	size, err := semconstant.Eval(n, m.info.Types)
+	if err != nil {
+		panic(fmt.Sprintf("unable to evaluate sizeof expression; %v", err))
+	}
+	return constant.NewInt(size, m.typeOf(n))
+}
+
 // unaryExpr lowers the given unary expression to LLVM IR, emitting code to f.
 func (m *Module) unaryExpr(f *Function, n *ast.UnaryExpr) value.Value {
 	switch n.Op {
@@ -661,7 +914,7 @@ func (m *Module) unaryExpr(f *Function, n *ast.UnaryExpr) value.Value {
 		//    }
 		// Output:
 		//    %2 = sub i32 0, %1
-		expr := m.expr(f, n.X)
+		expr := m.convertedExpr(f, n.X)
 		zero := constZero(expr.Type())
 		return f.curBlock.NewSub(zero, expr)
 	// !expr
@@ -0,0 +1,305 @@
+package irgen
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/metadata"
+	irtypes "github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+	"github.com/mewmew/uc/ast"
+)
+
+// An incompletePhi is a phi instruction of an unsealed basic block, whose
+// incoming values are added once all predecessors of the basic block are
+// known.
+type incompletePhi struct {
+	// Source code position of the local variable declaration.
+	pos int
+	// Phi instruction of the local variable.
+	phi *ir.InstPhi
+}
+
+// isPromotable reports whether the given local variable declaration may be
+// mapped directly to SSA values.
+func (m *Module) isPromotable(decl *ast.VarDecl) bool {
+	if !m.config.SSA {
+		return false
+	}
+	if !irtypes.IsInt(toIrType(decl.Type())) {
+		return false
+	}
+	// Captured local variables are accessed through their address by nested
+	// functions.
+	for _, captures := range m.info.Captures {
+		for _, capture := range captures {
+			if capture == decl {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// promote maps the given local variable declaration to SSA values within f.
+func (f *Function) promote(decl *ast.VarDecl) {
+	pos := decl.Name().Start()
+	f.ssaTypes[pos] = toIrType(decl.Type())
+	f.defs[pos] = make(map[*ir.BasicBlock]value.Value)
+}
+
+// isPromoted reports whether the given identifier refers to a local variable
+// mapped to SSA values within f.
+func (f *Function) isPromoted(ident *ast.Ident) bool {
+	_, ok := f.ssaTypes[ident.Decl.Name().Start()]
+	return ok
+}
+
+// writeVariable records v as the current value of the given local variable in
+// the current basic block.
+func (f *Function) writeVariable(ident *ast.Ident, v value.Value) {
+	pos := ident.Decl.Name().Start()
+	f.defs[pos][f.curBlock.BasicBlock] = v
+}
+
+// readVariable returns the current value of the given local variable in the
+// current basic block.
+func (f *Function) readVariable(ident *ast.Ident) value.Value {
+	pos := ident.Decl.Name().Start()
+	return f.readVariableFrom(pos, f.curBlock.BasicBlock)
+}
+
+// readVariableFrom returns the value of the local variable declared at pos, at
+// the end of the given basic block.
+func (f *Function) readVariableFrom(pos int, block *ir.BasicBlock) value.Value {
+	if v, ok := f.defs[pos][block]; ok {
+		return v
+	}
+	var v value.Value
+	typ := f.ssaTypes[pos]
+	switch preds := f.preds[block]; {
+	case !f.sealed[block]:
+		// Add operands of the phi instruction once the basic block is sealed.
+		phi := f.newPhi(block, typ)
+		f.incompletePhis[block] = append(f.incompletePhis[block], incompletePhi{pos: pos, phi: phi})
+		v = phi
+	case len(preds) == 0:
+		// Uninitialized local variable, or unreachable basic block.
+		v = constZero(typ)
+	case len(preds) == 1:
+		// No phi instruction required.
+		v = f.readVariableFrom(pos, preds[0])
+	default:
+		// Break potential cycles with an operandless phi instruction.
+		phi := f.newPhi(block, typ)
+		f.defs[pos][block] = phi
+		v = f.addPhiOperands(pos, phi)
+	}
+	f.defs[pos][block] = v
+	return v
+}
+
+// newPhi inserts a new phi instruction of the given type at the beginning of
+// the basic block.
+func (f *Function) newPhi(block *ir.BasicBlock, typ irtypes.Type) *ir.InstPhi {
+	phi := &ir.InstPhi{Typ: typ}
+	phi.Metadata = make(map[string]*metadata.Metadata)
+	phi.SetParent(block)
+	block.Insts = append([]ir.Instruction{phi}, block.Insts...)
+	f.phiBlocks[phi] = block
+	return phi
+}
+
+// addPhiOperands adds the incoming values of the local variable declared at
+// pos from each predecessor of the basic block of phi.
+func (f *Function) addPhiOperands(pos int, phi *ir.InstPhi) value.Value {
+	block := f.phiBlocks[phi]
+	for _, pred := range f.preds[block] {
+		inc := ir.NewIncoming(f.readVariableFrom(pos, pred), pred)
+		phi.Incs = append(phi.Incs, inc)
+	}
+	return f.tryRemoveTrivialPhi(phi)
+}
+
+// tryRemoveTrivialPhi removes the given phi instruction if it is trivial; i.e.
+// all incoming values are either the same value or the phi instruction itself.
+// The value replacing the phi instruction is returned.
+func (f *Function) tryRemoveTrivialPhi(phi *ir.InstPhi) value.Value {
+	var same value.Value
+	for _, inc := range phi.Incs {
+		if inc.X == phi || (same != nil && isSameValue(inc.X, same)) {
+			continue
+		}
+		if same != nil {
+			// The phi instruction merges at least two values.
+			return phi
+		}
+		same = inc.X
+	}
+	if same == nil {
+		// Unreachable basic block or uninitialized local variable.
+		same = constZero(phi.Type())
+	}
+
+	// Remember all phi instructions using phi, before reroute.
+	var users []*ir.InstPhi
+	for _, block := range f.blocks {
+		for _, inst := range block.Insts {
+			user, ok := inst.(*ir.InstPhi)
+			if !ok || user == phi {
+				continue
+			}
+			for _, inc := range user.Incs {
+				if inc.X == phi {
+					users = append(users, user)
+					break
+				}
+			}
+		}
+	}
+
+	// Reroute all uses of phi to same, and remove phi.
+	f.replaceUses(phi, same)
+	f.removed[phi] = same
+	block := f.phiBlocks[phi]
+	for i, inst := range block.Insts {
+		if inst == phi {
+			block.Insts = append(block.Insts[:i], block.Insts[i+1:]...)
+			break
+		}
+	}
+	delete(f.phiBlocks, phi)
+
+	// Try to recursively remove all phi users, which might have become trivial.
+	for _, user := range users {
+		if _, ok := f.phiBlocks[user]; ok {
+			f.tryRemoveTrivialPhi(user)
+		}
+	}
+	return same
+}
+
+// resolveRemovedPhis replaces the remaining uses of removed phi instructions.
+// Such uses may originate from values of promoted local variables, which were
+// read before the phi instructions were removed but emitted after.
+func (f *Function) resolveRemovedPhis() {
+	for phi := range f.removed {
+		f.replaceUses(phi, f.replacement(phi))
+	}
+}
+
+// replacement returns the value replacing the given removed phi instruction.
+func (f *Function) replacement(phi *ir.InstPhi) value.Value {
+	v := f.removed[phi]
+	for {
+		p, ok := v.(*ir.InstPhi)
+		if !ok {
+			return v
+		}
+		next, ok := f.removed[p]
+		if !ok {
+			return v
+		}
+		v = next
+	}
+}
+
+// isSameValue reports whether the values x and y are identical.
+func isSameValue(x, y value.Value) bool {
+	if x, ok := x.(*constant.Int); ok {
+		if y, ok := y.(*constant.Int); ok {
+			return irtypes.Equal(x.Type(), y.Type()) && x.X.Cmp(y.X) == 0
+		}
+	}
+	return x == y
+}
+
+// sealBlock marks the given basic block as sealed; i.e. all predecessors of
+// the basic block are known. The operands of incomplete phi instructions of
+// the basic block are added.
+func (f *Function) sealBlock(b *BasicBlock) {
+	block := b.BasicBlock
+	for _, inc := range f.incompletePhis[block] {
+		f.addPhiOperands(inc.pos, inc.phi)
+	}
+	delete(f.incompletePhis, block)
+	f.sealed[block] = true
+}
+
+// replaceUses replaces all uses of old with v in the instructions of f, and in
+// the current definitions of local variables.
+func (f *Function) replaceUses(old, v value.Value) {
+	replace := func(x *value.Value) {
+		if *x == old {
+			*x = v
+		}
+	}
+	for _, block := range f.blocks {
+		for _, inst := range block.Insts {
+			switch inst := inst.(type) {
+			case *ir.InstAlloca:
+				// no operands.
+			case *ir.InstLoad:
+				replace(&inst.Src)
+			case *ir.InstStore:
+				replace(&inst.Src)
+				replace(&inst.Dst)
+			case *ir.InstGetElementPtr:
+				replace(&inst.Src)
+				for i := range inst.Indices {
+					replace(&inst.Indices[i])
+				}
+			case *ir.InstAdd:
+				replace(&inst.X)
+				replace(&inst.Y)
+			case *ir.InstSub:
+				replace(&inst.X)
+				replace(&inst.Y)
+			case *ir.InstMul:
+				replace(&inst.X)
+				replace(&inst.Y)
+			case *ir.InstSDiv:
+				replace(&inst.X)
+				replace(&inst.Y)
+			case *ir.InstXor:
+				replace(&inst.X)
+				replace(&inst.Y)
+			case *ir.InstICmp:
+				replace(&inst.X)
+				replace(&inst.Y)
+			case *ir.InstTrunc:
+				replace(&inst.From)
+			case *ir.InstZExt:
+				replace(&inst.From)
+			case *ir.InstSExt:
+				replace(&inst.From)
+			case *ir.InstPhi:
+				for _, inc := range inst.Incs {
+					replace(&inc.X)
+				}
+			case *ir.InstCall:
+				for i := range inst.Args {
+					replace(&inst.Args[i])
+				}
+			default:
+				panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+			}
+		}
+		switch term := block.Term.(type) {
+		case *ir.TermRet:
+			if term.X != nil {
+				replace(&term.X)
+			}
+		case *ir.TermCondBr:
+			replace(&term.Cond)
+		}
+	}
+	for _, defs := range f.defs {
+		for block, def := range defs {
+			if def == old {
+				defs[block] = v
+			}
+		}
+	}
+}
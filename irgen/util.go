@@ -11,20 +11,6 @@ import (
 	uctypes "github.com/mewmew/uc/types"
 )
 
-// implicitConversion implicitly converts the value of the smallest type to the
-// largest type of x and y, emitting code to f. The new values of x and y are
-// returned.
-func (m *Module) implicitConversion(f *Function, x, y value.Value) (value.Value, value.Value) {
-	// Implicit conversion.
-	switch {
-	case isLarger(x.Type(), y.Type()):
-		y = m.convert(f, y, x.Type())
-	case isLarger(y.Type(), x.Type()):
-		x = m.convert(f, x, y.Type())
-	}
-	return x, y
-}
-
 // convert converts the given value to the specified type, emitting code to f.
 // No conversion is made, if v is already of the correct type.
 func (m *Module) convert(f *Function, v value.Value, to irtypes.Type) value.Value {
@@ -131,6 +117,21 @@ func (f *Function) genUnique(ident *ast.Ident) string {
 	}
 }
 
+// genUnique generates a unique nested function name based on the given name.
+func (m *Module) genUnique(name string) string {
+	if !m.exists[name] {
+		m.exists[name] = true
+		return name
+	}
+	for i := 1; ; i++ {
+		unique := fmt.Sprintf("%s%d", name, i)
+		if !m.exists[unique] {
+			m.exists[unique] = true
+			return unique
+		}
+	}
+}
+
 // isGlobal reports whether the given identifier is a global definition.
 func (m *Module) isGlobal(ident *ast.Ident) bool {
 	pos := ident.Decl.Name().Start()
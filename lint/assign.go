@@ -0,0 +1,55 @@
+package lint
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/token"
+)
+
+// assignCond reports assignments used as the conditions of if and while
+// statements. Parenthesized assignments are considered intentional.
+func assignCond(pass *Pass) {
+	f := func(n ast.Node) error {
+		var cond ast.Expr
+		switch n := n.(type) {
+		case *ast.IfStmt:
+			cond = n.Cond
+		case *ast.WhileStmt:
+			cond = n.Cond
+		default:
+			return nil
+		}
+		if x, ok := cond.(*ast.BinaryExpr); ok && x.Op == token.Assign {
+			pass.Reportf(x.OpPos, "assignment %q used as condition; use == for comparison, or parenthesize the assignment", x)
+		}
+		return nil
+	}
+	if err := astutil.Walk(pass.File, f); err != nil {
+		panic(err)
+	}
+}
+
+// selfAssign reports assignments of variables to themselves.
+func selfAssign(pass *Pass) {
+	f := func(n ast.Node) error {
+		x, ok := n.(*ast.BinaryExpr)
+		if !ok || x.Op != token.Assign {
+			return nil
+		}
+		lhs, ok := astutil.Unparen(x.X).(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		rhs, ok := astutil.Unparen(x.Y).(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		if lhs.Decl != nil && lhs.Decl == rhs.Decl {
+			pass.Reportf(x.OpPos, "self-assignment of %q", lhs)
+		}
+		return nil
+	}
+	if err := astutil.Walk(pass.File, f); err != nil {
+		panic(err)
+	}
+}
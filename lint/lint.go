@@ -0,0 +1,103 @@
+// Package lint implements a static analyzer for the µC language, which reports
+// suspicious constructs of type-checked programs.
+//
+// The analyzer is composed of a set of checks, each implemented by an Analyzer
+// which may be selected by name.
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem"
+)
+
+// An Analyzer is a check of the static analyzer.
+type Analyzer struct {
+	// Check name; e.g. "unused".
+	Name string
+	// One-line description of the check.
+	Doc string
+	// Run runs the check on the file of the given pass, and reports
+	// diagnostics through the pass.
+	Run func(pass *Pass)
+}
+
+// Checks of the static analyzer.
+var (
+	// Unused reports unused local variables, parameters and functions.
+	Unused = &Analyzer{Name: "unused", Doc: "report unused local variables, parameters and functions", Run: unused}
+	// Uninit reports uses of local variables which may be uninitialized.
+	Uninit = &Analyzer{Name: "uninit", Doc: "report uses of uninitialized local variables", Run: uninit}
+	// Unreachable reports unreachable code following return statements.
+	Unreachable = &Analyzer{Name: "unreachable", Doc: "report unreachable code after return statements", Run: unreachable}
+	// AssignCond reports assignments used as the conditions of if and while
+	// statements.
+	AssignCond = &Analyzer{Name: "assigncond", Doc: "report assignments used as conditions", Run: assignCond}
+	// SelfAssign reports assignments of variables to themselves.
+	SelfAssign = &Analyzer{Name: "selfassign", Doc: "report self-assignments", Run: selfAssign}
+	// Shadow reports declarations of nested block scopes which shadow
+	// declarations of outer scopes.
+	Shadow = &Analyzer{Name: "shadow", Doc: "report declarations shadowing outer declarations", Run: shadow}
+)
+
+// Analyzers holds the checks of the static analyzer.
+var Analyzers = []*Analyzer{Unused, Uninit, Unreachable, AssignCond, SelfAssign, Shadow}
+
+// Lookup returns the check of the given name. The boolean return value
+// indicates success.
+func Lookup(name string) (*Analyzer, bool) {
+	for _, a := range Analyzers {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return nil, false
+}
+
+// A Pass provides a check with the file to analyze.
+type Pass struct {
+	// Check being run.
+	Analyzer *Analyzer
+	// Type-checked file.
+	File *ast.File
+	// Type information of the file.
+	Info *sem.Info
+	// Reported diagnostics.
+	diags *[]*Diagnostic
+}
+
+// Reportf reports a diagnostic at the given position (offset in bytes).
+func (pass *Pass) Reportf(pos int, format string, a ...interface{}) {
+	diag := &Diagnostic{
+		Pos:     pos,
+		Check:   pass.Analyzer.Name,
+		Message: fmt.Sprintf(format, a...),
+	}
+	*pass.diags = append(*pass.diags, diag)
+}
+
+// A Diagnostic is a problem reported by a check.
+type Diagnostic struct {
+	// Input source position (in bytes).
+	Pos int
+	// Name of the check reporting the problem.
+	Check string
+	// Diagnostic message.
+	Message string
+}
+
+// Run runs the given checks on the type-checked file, and returns the reported
+// diagnostics sorted by position.
+func Run(file *ast.File, info *sem.Info, analyzers []*Analyzer) []*Diagnostic {
+	var diags []*Diagnostic
+	for _, a := range analyzers {
+		pass := &Pass{Analyzer: a, File: file, Info: info, diags: &diags}
+		a.Run(pass)
+	}
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Pos < diags[j].Pos
+	})
+	return diags
+}
@@ -0,0 +1,132 @@
+package lint_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/lint"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/sem/errors"
+)
+
+func TestRun(t *testing.T) {
+	golden := []struct {
+		check string
+		src   string
+		want  []string
+	}{
+		{
+			check: "unused",
+			src:   "int f(int a, int b) { int x; int y; y = a; return y; }\nint main(void) { return 0; }",
+			want: []string{
+				`1:5: unused function "f"`,
+				`1:18: unused parameter "b"`,
+				`1:27: unused variable "x"`,
+			},
+		},
+		{
+			check: "unused",
+			src:   "void f(int a);\nint g(void) { return 0; }\nint main(void) { int h(void) { return g(); } return 0; }",
+			want: []string{
+				`3:22: unused function "h"`,
+			},
+		},
+		{
+			check: "uninit",
+			src:   "int f(int a) { int x; int y; if (a) x = 1; else x = 2; while (a) { y = 1; a = a - 1; } return x + y; }",
+			want: []string{
+				`1:99: variable "y" may be used uninitialized`,
+			},
+		},
+		{
+			check: "uninit",
+			src:   "int f(int a) { int x; int y; if (a && (x = 1)) y = x; if (!a) return 0; return (a && (y = 2)) + y; }",
+			want: []string{
+				`1:97: variable "y" may be used uninitialized`,
+			},
+		},
+		{
+			check: "uninit",
+			src:   "int f(int a) { int x; while (1) { if (a) { x = 1; return x; } } return x; }",
+			want:  nil,
+		},
+		{
+			check: "unreachable",
+			src:   "int f(int a) { if (a) { return 1; a = 2; } else return 2; a = 3; a = 4; while (0) a = 5; return a; }",
+			want: []string{
+				`1:35: unreachable code`,
+				`1:59: unreachable code`,
+			},
+		},
+		{
+			check: "assigncond",
+			src:   "int f(int a) { if (a = 1) a = 2; while ((a = 0)) ; return a; }",
+			want: []string{
+				`1:22: assignment "a = 1" used as condition; use == for comparison, or parenthesize the assignment`,
+			},
+		},
+		{
+			check: "selfassign",
+			src:   "int f(int a) { int b[2]; a = (a); b[0] = b[0]; a = -a; return a; }",
+			want: []string{
+				`1:28: self-assignment of "a"`,
+			},
+		},
+		{
+			check: "shadow",
+			src:   "int a;\nint f(int b) { int c; { int a; int b; { int c; int d; } } return c; }",
+			want: []string{
+				`2:29: declaration of "a" shadows declaration of outer scope`,
+				`2:36: declaration of "b" shadows declaration of outer scope`,
+				`2:45: declaration of "c" shadows declaration of outer scope`,
+			},
+		},
+	}
+	for _, g := range golden {
+		a, ok := lint.Lookup(g.check)
+		if !ok {
+			t.Errorf("unable to locate check %q", g.check)
+			continue
+		}
+		p := parser.NewParser()
+		file, err := p.Parse(scanner.NewFromString(g.src))
+		if err != nil {
+			t.Errorf("%q: unable to parse source; %v", g.src, err)
+			continue
+		}
+		f := file.(*ast.File)
+		info, err := sem.Check(f)
+		if err != nil {
+			t.Errorf("%q: unable to check source; %v", g.src, err)
+			continue
+		}
+		src := errors.NewSource("foo.c", g.src)
+		var got []string
+		for _, diag := range lint.Run(f, info, []*lint.Analyzer{a}) {
+			if diag.Check != g.check {
+				t.Errorf("%q: check name mismatch; expected %q, got %q", g.src, g.check, diag.Check)
+			}
+			line, col := src.Position(diag.Pos)
+			got = append(got, fmt.Sprintf("%d:%d: %s", line, col, diag.Message))
+		}
+		if !reflect.DeepEqual(got, g.want) {
+			t.Errorf("%s: %q: diagnostics mismatch; expected %q, got %q", g.check, g.src, g.want, got)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, a := range lint.Analyzers {
+		got, ok := lint.Lookup(a.Name)
+		if !ok || got != a {
+			t.Errorf("unable to locate check %q", a.Name)
+		}
+	}
+	if _, ok := lint.Lookup("foo"); ok {
+		t.Errorf("expected invalid check %q", "foo")
+	}
+}
@@ -0,0 +1,24 @@
+package lint
+
+import "github.com/mewmew/uc/ast"
+
+// shadow reports declarations of nested block scopes which shadow declarations
+// of outer scopes; i.e. of enclosing blocks, function parameters or the file
+// scope.
+func shadow(pass *Pass) {
+	for n, scope := range pass.Info.Scopes {
+		if _, ok := n.(*ast.BlockStmt); !ok {
+			continue
+		}
+		for name, decl := range scope.Decls {
+			// The universe scope is not considered, as it only holds the
+			// predeclared types.
+			for outer := scope.Outer; outer != nil && outer.Outer != nil; outer = outer.Outer {
+				if _, ok := outer.Decls[name]; ok {
+					pass.Reportf(decl.Name().Start(), "declaration of %q shadows declaration of outer scope", name)
+					break
+				}
+			}
+		}
+	}
+}
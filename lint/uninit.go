@@ -0,0 +1,11 @@
+package lint
+
+import "github.com/mewmew/uc/sem"
+
+// uninit reports uses of local variables which are not definitely assigned on
+// every path from their declaration.
+func uninit(pass *Pass) {
+	for _, ident := range sem.Uninitialized(pass.File, pass.Info) {
+		pass.Reportf(ident.Start(), "variable %q may be used uninitialized", ident)
+	}
+}
@@ -0,0 +1,87 @@
+package lint
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/cfg"
+)
+
+// unreachable reports unreachable code following return statements. Only the
+// first statement of each sequence of unreachable statements is reported.
+func unreachable(pass *Pass) {
+	f := func(n ast.Node) error {
+		if fn, ok := n.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+			checkUnreachable(pass, fn)
+		}
+		return nil
+	}
+	if err := astutil.Walk(pass.File, f); err != nil {
+		panic(err)
+	}
+}
+
+// checkUnreachable reports unreachable code of the given function definition.
+func checkUnreachable(pass *Pass, fn *ast.FuncDecl) {
+	// Conditions are not evaluated, so that code is only unreachable if
+	// following return statements; not if guarded by constant conditions.
+	g := cfg.New(fn, nil)
+	live := make(map[ast.Node]bool)
+	for _, block := range g.Blocks {
+		for _, n := range block.Nodes {
+			live[n] = block.Live
+		}
+	}
+	// dead reports whether the given block item is unreachable, based on the
+	// first node of the item recorded in the control flow graph. The boolean
+	// return value indicates whether any node of the item was recorded.
+	dead := func(item ast.BlockItem) (bool, bool) {
+		var first ast.Node
+		f := func(n ast.Node) error {
+			if _, ok := live[n]; ok && first == nil {
+				first = n
+			}
+			return nil
+		}
+		if _, ok := live[item]; ok {
+			// Block items recorded in the control flow graph; e.g. nested
+			// function declarations, which are not traversed.
+			first = item
+		} else if err := astutil.Walk(item, f); err != nil {
+			panic(err)
+		}
+		if first == nil {
+			return false, false
+		}
+		return !live[first], true
+	}
+	var check func(stmt ast.Stmt)
+	check = func(stmt ast.Stmt) {
+		switch stmt := stmt.(type) {
+		case *ast.BlockStmt:
+			prevDead := false
+			for _, item := range stmt.Items {
+				isDead, ok := dead(item)
+				if !ok {
+					// Skip empty statements.
+					continue
+				}
+				if isDead {
+					if !prevDead {
+						pass.Reportf(item.Start(), "unreachable code")
+					}
+				} else if stmt, ok := item.(ast.Stmt); ok {
+					check(stmt)
+				}
+				prevDead = isDead
+			}
+		case *ast.IfStmt:
+			check(stmt.Body)
+			if stmt.Else != nil {
+				check(stmt.Else)
+			}
+		case *ast.WhileStmt:
+			check(stmt.Body)
+		}
+	}
+	check(fn.Body)
+}
@@ -0,0 +1,101 @@
+package lint
+
+import (
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+)
+
+// unused reports unused local variables, parameters and functions. Functions
+// are unused if never referenced, except for the main function.
+func unused(pass *Pass) {
+	uses := countUses(pass.File)
+	var funcs []*ast.FuncDecl
+	collect := func(n ast.Node) error {
+		if fn, ok := n.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+			funcs = append(funcs, fn)
+		}
+		return nil
+	}
+	if err := astutil.Walk(pass.File, collect); err != nil {
+		panic(err)
+	}
+	for _, decl := range pass.File.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+			if fn.Name().String() != "main" && uses[fn] == 0 {
+				pass.Reportf(fn.FuncName.Start(), "unused function %q", fn.FuncName)
+			}
+		}
+	}
+	for _, fn := range funcs {
+		for _, param := range fn.FuncType.Params {
+			if param.VarName != nil && uses[param] == 0 {
+				pass.Reportf(param.VarName.Start(), "unused parameter %q", param.VarName)
+			}
+		}
+		checkUnusedLocals(pass, fn.Body, uses)
+	}
+}
+
+// checkUnusedLocals reports unused local declarations of the given statement.
+// The bodies of nested function definitions are checked separately.
+func checkUnusedLocals(pass *Pass, stmt ast.Stmt, uses map[ast.Decl]int) {
+	switch stmt := stmt.(type) {
+	case *ast.BlockStmt:
+		for _, item := range stmt.Items {
+			if stmt, ok := item.(ast.Stmt); ok {
+				checkUnusedLocals(pass, stmt, uses)
+				continue
+			}
+			checkUnusedLocal(pass, item, uses)
+		}
+	case *ast.IfStmt:
+		checkUnusedLocals(pass, stmt.Body, uses)
+		if stmt.Else != nil {
+			checkUnusedLocals(pass, stmt.Else, uses)
+		}
+	case *ast.WhileStmt:
+		checkUnusedLocals(pass, stmt.Body, uses)
+	}
+}
+
+// checkUnusedLocal reports the given local declaration if unused.
+func checkUnusedLocal(pass *Pass, item ast.BlockItem, uses map[ast.Decl]int) {
+	switch decl := item.(type) {
+	case *ast.VarDecl:
+		if decl.VarName != nil && uses[decl] == 0 {
+			pass.Reportf(decl.VarName.Start(), "unused variable %q", decl.VarName)
+		}
+	case *ast.FuncDecl:
+		if astutil.IsDef(decl) && uses[decl] == 0 {
+			pass.Reportf(decl.FuncName.Start(), "unused function %q", decl.FuncName)
+		}
+	}
+}
+
+// countUses returns the number of references to each declaration of the given
+// file. The identifiers of declarations are not counted as references.
+func countUses(file *ast.File) map[ast.Decl]int {
+	names := make(map[*ast.Ident]bool)
+	var idents []*ast.Ident
+	f := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.Ident:
+			idents = append(idents, n)
+		case ast.Decl:
+			if name := n.Name(); name != nil {
+				names[name] = true
+			}
+		}
+		return nil
+	}
+	if err := astutil.Walk(file, f); err != nil {
+		panic(err)
+	}
+	uses := make(map[ast.Decl]int)
+	for _, ident := range idents {
+		if !names[ident] && ident.Decl != nil {
+			uses[ident.Decl]++
+		}
+	}
+	return uses
+}
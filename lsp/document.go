@@ -0,0 +1,223 @@
+package lsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	goccerrors "github.com/mewmew/uc/gocc/errors"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/hand/scanner"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+// A document represents an opened text document, and the result of its
+// analysis.
+type document struct {
+	// Document URI.
+	uri string
+	// Content of the document.
+	text string
+	// Start positions of lines within the content.
+	lines []int
+	// Abstract syntax tree of the document; or nil if parsing failed.
+	file *ast.File
+	// Type information of the document; or nil if semantic analysis failed.
+	info *sem.Info
+	// Identifiers of the document, in source order. Identifiers resolved
+	// before a semantic analysis error are mapped to their declarations.
+	idents []*ast.Ident
+	// Declarations of identifiers; as tags of enumeration declarations are
+	// resolved in a separate name space, their identifiers are mapped here.
+	decls map[*ast.Ident]ast.Decl
+	// Declared identifiers of declarations.
+	declNames map[*ast.Ident]bool
+	// Diagnostics of the document.
+	diags []Diagnostic
+}
+
+// newDocument returns a new document of the given content, and analyses it.
+func newDocument(uri, text string) *document {
+	doc := &document{uri: uri, text: text, decls: make(map[*ast.Ident]ast.Decl), declNames: make(map[*ast.Ident]bool)}
+	doc.lines = append(doc.lines, 0)
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			doc.lines = append(doc.lines, i+1)
+		}
+	}
+	doc.check()
+	return doc
+}
+
+// check parses and performs semantic analysis of the document, recording
+// encountered errors as diagnostics.
+func (doc *document) check() {
+	// Syntactic analysis.
+	s := scanner.NewFromString(doc.text)
+	p := parser.NewParser()
+	f, err := p.Parse(s)
+	if err != nil {
+		doc.addError(err)
+		return
+	}
+	doc.file = f.(*ast.File)
+	if cs, ok := s.(astutil.CommentScanner); ok {
+		astutil.AddComments(doc.file, doc.text, cs.Comments(), cs.Semicolons())
+	}
+
+	// Semantic analysis.
+	info, err := sem.Check(doc.file)
+	if err != nil {
+		doc.addError(err)
+	}
+	doc.info = info
+
+	// Record identifiers; after semantic analysis, to include resolved
+	// declarations.
+	record := func(n ast.Node) error {
+		if decl, ok := n.(ast.Decl); ok && decl.Name() != nil {
+			doc.declNames[decl.Name()] = true
+		}
+		switch n := n.(type) {
+		case *ast.Ident:
+			doc.idents = append(doc.idents, n)
+			if n.Decl != nil {
+				doc.decls[n] = n.Decl
+			}
+		case *ast.EnumDecl:
+			if n.Tag != nil {
+				doc.idents = append(doc.idents, n.Tag)
+				doc.decls[n.Tag] = n
+			}
+		case *ast.EnumType:
+			doc.idents = append(doc.idents, n.Tag)
+			if n.Tag.Decl != nil {
+				doc.decls[n.Tag] = n.Tag.Decl
+			}
+		}
+		return nil
+	}
+	if err := astutil.Walk(doc.file, record); err != nil {
+		panic(fmt.Sprintf("unable to record identifiers; %v", err))
+	}
+	sort.SliceStable(doc.idents, func(i, j int) bool {
+		return doc.idents[i].NamePos < doc.idents[j].NamePos
+	})
+}
+
+// addError records the given parse or semantic analysis error as a diagnostic.
+func (doc *document) addError(err error) {
+	pos, end, msg := -1, -1, err.Error()
+	if e, ok := err.(*errutil.ErrInfo); ok {
+		// Unwrap errutil error.
+		err = e.Err
+	}
+	switch e := err.(type) {
+	case *goccerrors.Error:
+		pos = e.ErrorToken.Pos.Offset
+		end = pos + len(e.ErrorToken.Lit)
+		msg = parser.NewError(e).Error()
+		// Trim position prefix of parse errors.
+		msg = strings.TrimPrefix(msg, fmt.Sprintf("%d: ", pos))
+	case *semerrors.Error:
+		pos, end = e.Pos, doc.wordEnd(e.Pos)
+		msg = e.Text
+	}
+	if pos < 0 || pos > len(doc.text) {
+		pos, end = 0, 0
+	}
+	if end > len(doc.text) {
+		end = len(doc.text)
+	}
+	diag := Diagnostic{
+		Range:    Range{Start: doc.position(pos), End: doc.position(end)},
+		Severity: SeverityError,
+		Source:   "uc",
+		Message:  msg,
+	}
+	doc.diags = append(doc.diags, diag)
+}
+
+// wordEnd returns the end position of the identifier or literal starting at
+// pos; or pos+1 if no identifier or literal starts at pos.
+func (doc *document) wordEnd(pos int) int {
+	end := pos
+	for end < len(doc.text) && isWordChar(doc.text[end]) {
+		end++
+	}
+	if end == pos && end < len(doc.text) {
+		end++
+	}
+	return end
+}
+
+// isWordChar reports whether the given character may be part of an identifier
+// or an integer literal.
+func isWordChar(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// position returns the line and UTF-16 character offset of the given position
+// within the document.
+func (doc *document) position(pos int) Position {
+	line := sort.SearchInts(doc.lines, pos+1) - 1
+	s := doc.text[doc.lines[line]:pos]
+	return Position{Line: line, Character: len(utf16.Encode([]rune(s)))}
+}
+
+// offset returns the position within the document of the given line and UTF-16
+// character offset.
+func (doc *document) offset(pos Position) int {
+	if pos.Line < 0 {
+		return 0
+	}
+	if pos.Line >= len(doc.lines) {
+		return len(doc.text)
+	}
+	i := doc.lines[pos.Line]
+	for n := 0; n < pos.Character && i < len(doc.text) && doc.text[i] != '\n'; {
+		r, size := utf8.DecodeRuneInString(doc.text[i:])
+		n += len(utf16.Encode([]rune{r}))
+		i += size
+	}
+	return i
+}
+
+// identRange returns the range of the given identifier.
+func (doc *document) identRange(ident *ast.Ident) Range {
+	return Range{Start: doc.position(ident.NamePos), End: doc.position(ident.NamePos + len(ident.Name))}
+}
+
+// identAt returns the identifier at the given position; or nil if not present.
+// Positions directly after identifiers are considered part of the identifier.
+func (doc *document) identAt(pos Position) *ast.Ident {
+	off := doc.offset(pos)
+	i := sort.Search(len(doc.idents), func(i int) bool {
+		return doc.idents[i].NamePos > off
+	})
+	if i == 0 {
+		return nil
+	}
+	ident := doc.idents[i-1]
+	if off > ident.NamePos+len(ident.Name) {
+		return nil
+	}
+	return ident
+}
+
+// declName returns the declared identifier of the given declaration; or nil if
+// not present in the document (e.g. the predeclared types of the universe
+// scope).
+func declName(decl ast.Decl) *ast.Ident {
+	ident := decl.Name()
+	if ident == nil || ident.NamePos < 0 {
+		return nil
+	}
+	return ident
+}
@@ -0,0 +1,138 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// A Message represents a JSON-RPC 2.0 request, notification or response
+// message.
+//
+// Requests have an ID and a method, notifications have a method but no ID, and
+// responses have an ID and either a result or an error.
+type Message struct {
+	// JSON-RPC version; always "2.0".
+	JSONRPC string `json:"jsonrpc"`
+	// Request ID; or nil if notification.
+	ID *json.RawMessage `json:"id,omitempty"`
+	// Method name of request or notification.
+	Method string `json:"method,omitempty"`
+	// Parameters of request or notification.
+	Params json.RawMessage `json:"params,omitempty"`
+	// Result of successful response; "null" if no result.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Error of failed response.
+	Error *ResponseError `json:"error,omitempty"`
+}
+
+// A ResponseError represents the error of a failed response.
+type ResponseError struct {
+	// Error code.
+	Code int `json:"code"`
+	// Error message.
+	Message string `json:"message"`
+}
+
+// Error returns the error message of the response error.
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// JSON-RPC error codes.
+const (
+	// Invalid JSON.
+	CodeParseError = -32700
+	// Invalid request message.
+	CodeInvalidRequest = -32600
+	// Unknown method.
+	CodeMethodNotFound = -32601
+	// Invalid method parameters.
+	CodeInvalidParams = -32602
+	// Internal error.
+	CodeInternalError = -32603
+	// Request received before the initialize request.
+	CodeServerNotInitialized = -32002
+)
+
+// ReadMessage reads a message from r. Messages are preceded by a header, which
+// specifies the length of the message content.
+//
+//    Content-Length: 52\r\n
+//    \r\n
+//    {"jsonrpc":"2.0","method":"initialized","params":{}}
+func ReadMessage(r *bufio.Reader) (*Message, error) {
+	header, err := textproto.NewReader(r).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errutil.Err(err)
+	}
+	s := header.Get("Content-Length")
+	if len(s) == 0 {
+		return nil, errutil.Newf("missing Content-Length header")
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, errutil.Newf("invalid Content-Length header %q; %v", s, err)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, errutil.Err(err)
+	}
+	msg := &Message{}
+	if err := json.Unmarshal(buf, msg); err != nil {
+		return nil, &ResponseError{Code: CodeParseError, Message: err.Error()}
+	}
+	return msg, nil
+}
+
+// WriteMessage writes the given message to w, preceded by a header.
+func WriteMessage(w io.Writer, msg *Message) error {
+	msg.JSONRPC = "2.0"
+	buf, err := json.Marshal(msg)
+	if err != nil {
+		return errutil.Err(err)
+	}
+	if _, err := fmt.Fprintf(w, "Content-Length: %d\r\n\r\n%s", len(buf), buf); err != nil {
+		return errutil.Err(err)
+	}
+	return nil
+}
+
+// newNotification returns a new notification message of the given method and
+// parameters.
+func newNotification(method string, params interface{}) (*Message, error) {
+	buf, err := json.Marshal(params)
+	if err != nil {
+		return nil, errutil.Err(err)
+	}
+	return &Message{Method: method, Params: buf}, nil
+}
+
+// newResponse returns a new response message to the request of the given ID;
+// either successful with the given result, or failed with the given error.
+func newResponse(id *json.RawMessage, result interface{}, err error) *Message {
+	msg := &Message{ID: id}
+	if err != nil {
+		e, ok := err.(*ResponseError)
+		if !ok {
+			e = &ResponseError{Code: CodeInternalError, Message: err.Error()}
+		}
+		msg.Error = e
+		return msg
+	}
+	buf, err := json.Marshal(result)
+	if err != nil {
+		msg.Error = &ResponseError{Code: CodeInternalError, Message: err.Error()}
+		return msg
+	}
+	msg.Result = buf
+	return msg
+}
@@ -0,0 +1,241 @@
+package lsp
+
+// Types of the Language Server Protocol used by the server; see
+// https://microsoft.github.io/language-server-protocol/specification
+
+// A Position represents a zero-based line and character offset within a text
+// document. Character offsets are measured in UTF-16 code units.
+type Position struct {
+	// Line position (zero-based).
+	Line int `json:"line"`
+	// Character offset within the line (zero-based).
+	Character int `json:"character"`
+}
+
+// A Range represents a range within a text document.
+type Range struct {
+	// Start position (inclusive).
+	Start Position `json:"start"`
+	// End position (exclusive).
+	End Position `json:"end"`
+}
+
+// A Location represents a range within a given text document.
+type Location struct {
+	// Document URI.
+	URI string `json:"uri"`
+	// Range within the document.
+	Range Range `json:"range"`
+}
+
+// DiagnosticSeverity specifies the severity of a diagnostic.
+type DiagnosticSeverity int
+
+// Diagnostic severities.
+const (
+	SeverityError       DiagnosticSeverity = 1
+	SeverityWarning     DiagnosticSeverity = 2
+	SeverityInformation DiagnosticSeverity = 3
+	SeverityHint        DiagnosticSeverity = 4
+)
+
+// A Diagnostic represents an error or a warning within a text document.
+type Diagnostic struct {
+	// Range of the diagnostic.
+	Range Range `json:"range"`
+	// Severity of the diagnostic.
+	Severity DiagnosticSeverity `json:"severity"`
+	// Source of the diagnostic; e.g. "uc".
+	Source string `json:"source"`
+	// Diagnostic message.
+	Message string `json:"message"`
+}
+
+// PublishDiagnosticsParams holds the parameters of a
+// "textDocument/publishDiagnostics" notification.
+type PublishDiagnosticsParams struct {
+	// Document URI.
+	URI string `json:"uri"`
+	// Diagnostics of the document; an empty list clears previous diagnostics.
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// InitializeParams holds the parameters of an "initialize" request.
+type InitializeParams struct {
+	// Process ID of the client; or nil.
+	ProcessID *int `json:"processId"`
+	// Root URI of the workspace; or empty.
+	RootURI string `json:"rootUri"`
+}
+
+// InitializeResult holds the result of an "initialize" request.
+type InitializeResult struct {
+	// Capabilities of the server.
+	Capabilities ServerCapabilities `json:"capabilities"`
+	// Server information.
+	ServerInfo ServerInfo `json:"serverInfo"`
+}
+
+// ServerCapabilities specifies the capabilities of the server.
+type ServerCapabilities struct {
+	// Text document synchronization kind.
+	TextDocumentSync TextDocumentSyncKind `json:"textDocumentSync"`
+	// Specifies whether go to definition is supported.
+	DefinitionProvider bool `json:"definitionProvider"`
+	// Specifies whether hover is supported.
+	HoverProvider bool `json:"hoverProvider"`
+	// Specifies whether document symbols are supported.
+	DocumentSymbolProvider bool `json:"documentSymbolProvider"`
+	// Specifies whether find references is supported.
+	ReferencesProvider bool `json:"referencesProvider"`
+}
+
+// ServerInfo holds information about the server.
+type ServerInfo struct {
+	// Server name.
+	Name string `json:"name"`
+}
+
+// TextDocumentSyncKind specifies how text documents are synchronized.
+type TextDocumentSyncKind int
+
+// Text document synchronization kinds.
+const (
+	// Documents are not synchronized.
+	SyncNone TextDocumentSyncKind = 0
+	// The full content of documents is sent on each change.
+	SyncFull TextDocumentSyncKind = 1
+)
+
+// A TextDocumentItem represents an opened text document.
+type TextDocumentItem struct {
+	// Document URI.
+	URI string `json:"uri"`
+	// Language identifier; e.g. "c".
+	LanguageID string `json:"languageId"`
+	// Version of the document.
+	Version int `json:"version"`
+	// Content of the document.
+	Text string `json:"text"`
+}
+
+// A TextDocumentIdentifier identifies a text document.
+type TextDocumentIdentifier struct {
+	// Document URI.
+	URI string `json:"uri"`
+}
+
+// A VersionedTextDocumentIdentifier identifies a specific version of a text
+// document.
+type VersionedTextDocumentIdentifier struct {
+	// Document URI.
+	URI string `json:"uri"`
+	// Version of the document.
+	Version int `json:"version"`
+}
+
+// DidOpenTextDocumentParams holds the parameters of a "textDocument/didOpen"
+// notification.
+type DidOpenTextDocumentParams struct {
+	// Opened document.
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+// DidChangeTextDocumentParams holds the parameters of a
+// "textDocument/didChange" notification.
+type DidChangeTextDocumentParams struct {
+	// Changed document.
+	TextDocument VersionedTextDocumentIdentifier `json:"textDocument"`
+	// Content changes; the last change holds the full content of the document,
+	// as full synchronization is used.
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+// A TextDocumentContentChangeEvent represents a change of a text document.
+type TextDocumentContentChangeEvent struct {
+	// Full content of the document.
+	Text string `json:"text"`
+}
+
+// DidCloseTextDocumentParams holds the parameters of a "textDocument/didClose"
+// notification.
+type DidCloseTextDocumentParams struct {
+	// Closed document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// TextDocumentPositionParams holds the parameters of requests for a position
+// within a text document; e.g. "textDocument/definition" and
+// "textDocument/hover".
+type TextDocumentPositionParams struct {
+	// Text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	// Position within the document.
+	Position Position `json:"position"`
+}
+
+// ReferenceParams holds the parameters of a "textDocument/references" request.
+type ReferenceParams struct {
+	TextDocumentPositionParams
+	// Reference context.
+	Context ReferenceContext `json:"context"`
+}
+
+// ReferenceContext specifies the context of a "textDocument/references"
+// request.
+type ReferenceContext struct {
+	// Specifies whether to include the declarations of the referenced
+	// identifier.
+	IncludeDeclaration bool `json:"includeDeclaration"`
+}
+
+// DocumentSymbolParams holds the parameters of a "textDocument/documentSymbol"
+// request.
+type DocumentSymbolParams struct {
+	// Text document.
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+// A Hover holds the result of a "textDocument/hover" request.
+type Hover struct {
+	// Hover contents.
+	Contents MarkupContent `json:"contents"`
+	// Range of the hovered identifier.
+	Range Range `json:"range"`
+}
+
+// MarkupContent represents formatted text.
+type MarkupContent struct {
+	// Markup kind; "plaintext" or "markdown".
+	Kind string `json:"kind"`
+	// Formatted text.
+	Value string `json:"value"`
+}
+
+// SymbolKind specifies the kind of a symbol.
+type SymbolKind int
+
+// Symbol kinds.
+const (
+	SymbolClass      SymbolKind = 5
+	SymbolEnum       SymbolKind = 10
+	SymbolFunction   SymbolKind = 12
+	SymbolVariable   SymbolKind = 13
+	SymbolEnumMember SymbolKind = 22
+)
+
+// A DocumentSymbol represents a declaration within a text document.
+type DocumentSymbol struct {
+	// Name of the symbol.
+	Name string `json:"name"`
+	// Details of the symbol; e.g. its type.
+	Detail string `json:"detail,omitempty"`
+	// Kind of the symbol.
+	Kind SymbolKind `json:"kind"`
+	// Range of the declaration.
+	Range Range `json:"range"`
+	// Range of the declared identifier.
+	SelectionRange Range `json:"selectionRange"`
+	// Nested symbols.
+	Children []DocumentSymbol `json:"children,omitempty"`
+}
@@ -0,0 +1,387 @@
+// Package lsp implements a Language Server Protocol server for the µC
+// language, which communicates with the client using JSON-RPC messages.
+//
+// The server supports the following features.
+//
+//    * diagnostics of syntax and semantic errors
+//    * go to definition
+//    * hover, showing types and documentation of identifiers
+//    * document symbols
+//    * find references
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+)
+
+// A Server is a Language Server Protocol server for the µC language.
+type Server struct {
+	// Input stream of client messages.
+	r *bufio.Reader
+	// Output stream of server messages.
+	w io.Writer
+	// Logger of errors not reported to the client; e.g. invalid notifications.
+	log *log.Logger
+	// Opened documents, indexed by URI.
+	docs map[string]*document
+	// Specifies whether the initialize request has been received.
+	initialized bool
+	// Specifies whether the shutdown request has been received.
+	shutdown bool
+}
+
+// NewServer returns a new server which reads client messages from r, and
+// writes server messages to w. Errors not reported to the client are logged to
+// errw.
+func NewServer(r io.Reader, w, errw io.Writer) *Server {
+	return &Server{
+		r:    bufio.NewReader(r),
+		w:    w,
+		log:  log.New(errw, "ulsp: ", 0),
+		docs: make(map[string]*document),
+	}
+}
+
+// Serve handles client messages until the exit notification is received, or
+// the input stream is closed. An error is returned if the client exits without
+// first requesting the server to shut down.
+func (s *Server) Serve() error {
+	for {
+		msg, err := ReadMessage(s.r)
+		if err != nil {
+			if err == io.EOF {
+				return errutil.Newf("input stream closed before shutdown")
+			}
+			if e, ok := err.(*ResponseError); ok {
+				// Report invalid JSON to the client.
+				if err := WriteMessage(s.w, &Message{Error: e}); err != nil {
+					return errutil.Err(err)
+				}
+				continue
+			}
+			return errutil.Err(err)
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errutil.Newf("exit before shutdown")
+			}
+			return nil
+		}
+		if msg.ID == nil {
+			if err := s.notify(msg.Method, msg.Params); err != nil {
+				s.log.Printf("%s: %v", msg.Method, err)
+			}
+			continue
+		}
+		result, err := s.handle(msg.Method, msg.Params)
+		if err := WriteMessage(s.w, newResponse(msg.ID, result, err)); err != nil {
+			return errutil.Err(err)
+		}
+	}
+}
+
+// handle handles the request of the given method and parameters, and returns
+// its result.
+func (s *Server) handle(method string, params json.RawMessage) (interface{}, error) {
+	if !s.initialized && method != "initialize" {
+		return nil, &ResponseError{Code: CodeServerNotInitialized, Message: "server not initialized"}
+	}
+	switch method {
+	case "initialize":
+		s.initialized = true
+		result := &InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:       SyncFull,
+				DefinitionProvider:     true,
+				HoverProvider:          true,
+				DocumentSymbolProvider: true,
+				ReferencesProvider:     true,
+			},
+			ServerInfo: ServerInfo{Name: "ulsp"},
+		}
+		return result, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/definition":
+		var p TextDocumentPositionParams
+		if err := unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return s.definition(&p), nil
+	case "textDocument/hover":
+		var p TextDocumentPositionParams
+		if err := unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return s.hover(&p), nil
+	case "textDocument/documentSymbol":
+		var p DocumentSymbolParams
+		if err := unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return s.documentSymbols(&p), nil
+	case "textDocument/references":
+		var p ReferenceParams
+		if err := unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return s.references(&p), nil
+	default:
+		return nil, &ResponseError{Code: CodeMethodNotFound, Message: fmt.Sprintf("method %q not supported", method)}
+	}
+}
+
+// notify handles the notification of the given method and parameters.
+func (s *Server) notify(method string, params json.RawMessage) error {
+	switch method {
+	case "textDocument/didOpen":
+		var p DidOpenTextDocumentParams
+		if err := unmarshal(params, &p); err != nil {
+			return errutil.Err(err)
+		}
+		return s.update(p.TextDocument.URI, p.TextDocument.Text)
+	case "textDocument/didChange":
+		var p DidChangeTextDocumentParams
+		if err := unmarshal(params, &p); err != nil {
+			return errutil.Err(err)
+		}
+		if len(p.ContentChanges) == 0 {
+			return nil
+		}
+		// Full synchronization; the last change holds the full content.
+		text := p.ContentChanges[len(p.ContentChanges)-1].Text
+		return s.update(p.TextDocument.URI, text)
+	case "textDocument/didClose":
+		var p DidCloseTextDocumentParams
+		if err := unmarshal(params, &p); err != nil {
+			return errutil.Err(err)
+		}
+		delete(s.docs, p.TextDocument.URI)
+		// Clear diagnostics of closed documents.
+		return s.publish(p.TextDocument.URI, nil)
+	}
+	// Ignore other notifications; e.g. "initialized" and "$/cancelRequest".
+	return nil
+}
+
+// update analyses the given content of the specified document, and publishes
+// its diagnostics.
+func (s *Server) update(uri, text string) error {
+	doc := newDocument(uri, text)
+	s.docs[uri] = doc
+	return s.publish(uri, doc.diags)
+}
+
+// publish publishes the given diagnostics of the specified document.
+func (s *Server) publish(uri string, diags []Diagnostic) error {
+	if diags == nil {
+		diags = []Diagnostic{}
+	}
+	msg, err := newNotification("textDocument/publishDiagnostics", &PublishDiagnosticsParams{URI: uri, Diagnostics: diags})
+	if err != nil {
+		return errutil.Err(err)
+	}
+	return WriteMessage(s.w, msg)
+}
+
+// definition returns the location of the definition of the identifier at the
+// given position; or nil if not present.
+func (s *Server) definition(p *TextDocumentPositionParams) *Location {
+	doc, ok := s.docs[p.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	ident := doc.identAt(p.Position)
+	if ident == nil {
+		return nil
+	}
+	decl, ok := doc.decls[ident]
+	if !ok {
+		return nil
+	}
+	name := declName(decl)
+	if name == nil {
+		return nil
+	}
+	return &Location{URI: doc.uri, Range: doc.identRange(name)}
+}
+
+// hover returns the type and documentation of the identifier at the given
+// position; or nil if not present.
+func (s *Server) hover(p *TextDocumentPositionParams) *Hover {
+	doc, ok := s.docs[p.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	ident := doc.identAt(p.Position)
+	if ident == nil {
+		return nil
+	}
+	decl, ok := doc.decls[ident]
+	if !ok {
+		return nil
+	}
+	// Use the type deduced by semantic analysis if present; e.g. for
+	// identifiers of expressions.
+	typ := decl.Type()
+	if doc.info != nil {
+		if t, ok := doc.info.Types[ident]; ok {
+			typ = t
+		}
+	}
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "```c\n%s: %v\n```\n", ident.Name, typ)
+	if text := declDoc(decl).Text(); len(text) > 0 {
+		fmt.Fprintf(buf, "\n%s", text)
+	}
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: buf.String()},
+		Range:    doc.identRange(ident),
+	}
+}
+
+// declDoc returns the documentation of the given declaration; or nil if not
+// present.
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch decl := decl.(type) {
+	case *ast.EnumDecl:
+		return decl.Doc
+	case *ast.FuncDecl:
+		return decl.Doc
+	case *ast.TypeDef:
+		return decl.Doc
+	case *ast.VarDecl:
+		return decl.Doc
+	}
+	return nil
+}
+
+// documentSymbols returns the symbols of the declarations of the given
+// document.
+func (s *Server) documentSymbols(p *DocumentSymbolParams) []DocumentSymbol {
+	syms := []DocumentSymbol{}
+	doc, ok := s.docs[p.TextDocument.URI]
+	if !ok || doc.file == nil {
+		return syms
+	}
+	for _, decl := range doc.file.Decls {
+		if sym, ok := doc.symbol(decl); ok {
+			syms = append(syms, sym)
+		}
+	}
+	return syms
+}
+
+// symbol returns the symbol of the given declaration. The boolean return value
+// indicates success; anonymous declarations have no symbols.
+func (doc *document) symbol(decl ast.Decl) (DocumentSymbol, bool) {
+	name := decl.Name()
+	if name == nil {
+		return DocumentSymbol{}, false
+	}
+	sym := DocumentSymbol{
+		Name:           name.Name,
+		Detail:         decl.Type().String(),
+		Range:          Range{Start: doc.position(decl.Start()), End: doc.position(name.NamePos + len(name.Name))},
+		SelectionRange: doc.identRange(name),
+	}
+	switch decl := decl.(type) {
+	case *ast.EnumDecl:
+		sym.Kind = SymbolEnum
+		sym.Detail = ""
+		sym.Range.End = doc.position(decl.Rbrace + 1)
+		for _, enumerator := range decl.Enumerators {
+			if child, ok := doc.symbol(enumerator); ok {
+				sym.Children = append(sym.Children, child)
+			}
+		}
+	case *ast.Enumerator:
+		sym.Kind = SymbolEnumMember
+	case *ast.FuncDecl:
+		sym.Kind = SymbolFunction
+		if decl.Body != nil {
+			sym.Range.End = doc.position(decl.Body.Rbrace + 1)
+			sym.Children = doc.localSymbols(decl.Body)
+		}
+	case *ast.TypeDef:
+		sym.Kind = SymbolClass
+	case *ast.VarDecl:
+		sym.Kind = SymbolVariable
+	default:
+		panic(fmt.Sprintf("support for declaration %T not yet implemented", decl))
+	}
+	return sym, true
+}
+
+// localSymbols returns the symbols of the local declarations of the given
+// block, including nested blocks.
+func (doc *document) localSymbols(block *ast.BlockStmt) []DocumentSymbol {
+	var syms []DocumentSymbol
+	var items func(stmt ast.Node)
+	items = func(stmt ast.Node) {
+		switch stmt := stmt.(type) {
+		case ast.Decl:
+			if sym, ok := doc.symbol(stmt); ok {
+				syms = append(syms, sym)
+			}
+		case *ast.BlockStmt:
+			for _, item := range stmt.Items {
+				items(item)
+			}
+		case *ast.IfStmt:
+			items(stmt.Body)
+			if stmt.Else != nil {
+				items(stmt.Else)
+			}
+		case *ast.WhileStmt:
+			items(stmt.Body)
+		}
+	}
+	items(block)
+	return syms
+}
+
+// references returns the locations of the references to the declaration of
+// the identifier at the given position.
+func (s *Server) references(p *ReferenceParams) []Location {
+	locs := []Location{}
+	doc, ok := s.docs[p.TextDocument.URI]
+	if !ok {
+		return locs
+	}
+	ident := doc.identAt(p.Position)
+	if ident == nil {
+		return locs
+	}
+	decl, ok := doc.decls[ident]
+	if !ok {
+		return locs
+	}
+	for _, ref := range doc.idents {
+		if doc.decls[ref] != decl {
+			continue
+		}
+		if !p.Context.IncludeDeclaration && doc.declNames[ref] {
+			continue
+		}
+		locs = append(locs, Location{URI: doc.uri, Range: doc.identRange(ref)})
+	}
+	return locs
+}
+
+// unmarshal decodes the given parameters into v.
+func unmarshal(params json.RawMessage, v interface{}) error {
+	if err := json.Unmarshal(params, v); err != nil {
+		return &ResponseError{Code: CodeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+const uri = "file:///tmp/foo.c"
+
+const src = `// Number of calls.
+int n;
+
+/* add returns the sum of x and y. */
+int add(int x, int y) {
+	n = n + 1;
+	return x + y;
+}
+
+int main(void) {
+	int a[10];
+	a[0] = add(1, 2);
+	return n;
+}
+`
+
+func TestServer(t *testing.T) {
+	c := newClient(t)
+
+	// Requests before initialization.
+	var sym []DocumentSymbol
+	err := c.call("textDocument/documentSymbol", &DocumentSymbolParams{TextDocument: TextDocumentIdentifier{URI: uri}}, &sym)
+	if e, ok := err.(*ResponseError); !ok || e.Code != CodeServerNotInitialized {
+		t.Errorf("request before initialization; expected error code %d, got %v", CodeServerNotInitialized, err)
+	}
+
+	// Initialization.
+	var init InitializeResult
+	if err := c.call("initialize", &InitializeParams{}, &init); err != nil {
+		t.Fatal(err)
+	}
+	if !init.Capabilities.DefinitionProvider || !init.Capabilities.HoverProvider || init.Capabilities.TextDocumentSync != SyncFull {
+		t.Errorf("capabilities mismatch; got %+v", init.Capabilities)
+	}
+	c.notify("initialized", struct{}{})
+
+	// Diagnostics.
+	golden := []struct {
+		text string
+		want []Diagnostic
+	}{
+		{
+			text: "int main(void) {\n\treturn x;\n}\n",
+			want: []Diagnostic{{Range: newRange(1, 8, 1, 9), Severity: SeverityError, Source: "uc", Message: `undeclared identifier "x"`}},
+		},
+		{
+			// The character offset of "µ" is measured in UTF-16 code units.
+			text: "/* µ */ int main(void) {\n\treturn 1\n}\n",
+			want: []Diagnostic{{Range: newRange(2, 0, 2, 1), Severity: SeverityError, Source: "uc", Message: `unexpected "}", expected ["!=" "&&" "*" "+" "," "-" "/" ";" "<" "<=" "=" "==" ">" ">=" "?"]`}},
+		},
+		{
+			text: "/* µ */ int main(void) { return y; }\n",
+			want: []Diagnostic{{Range: newRange(0, 32, 0, 33), Severity: SeverityError, Source: "uc", Message: `undeclared identifier "y"`}},
+		},
+		{
+			text: src,
+			want: []Diagnostic{},
+		},
+	}
+	for i, g := range golden {
+		if i == 0 {
+			c.notify("textDocument/didOpen", &DidOpenTextDocumentParams{TextDocument: TextDocumentItem{URI: uri, LanguageID: "c", Version: 1, Text: g.text}})
+		} else {
+			c.notify("textDocument/didChange", &DidChangeTextDocumentParams{
+				TextDocument:   VersionedTextDocumentIdentifier{URI: uri, Version: i + 1},
+				ContentChanges: []TextDocumentContentChangeEvent{{Text: g.text}},
+			})
+		}
+		got := c.diagnostics()
+		if got.URI != uri {
+			t.Errorf("%q: URI mismatch; expected %q, got %q", g.text, uri, got.URI)
+		}
+		if !reflect.DeepEqual(got.Diagnostics, g.want) {
+			t.Errorf("%q: diagnostics mismatch; expected %+v, got %+v", g.text, g.want, got.Diagnostics)
+		}
+	}
+
+	// Go to definition.
+	var loc *Location
+	if err := c.call("textDocument/definition", newPositionParams(11, 8), &loc); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Location{URI: uri, Range: newRange(4, 4, 4, 7)}); !reflect.DeepEqual(loc, want) {
+		t.Errorf("definition mismatch; expected %+v, got %+v", want, loc)
+	}
+	// Predeclared types have no definition in the document.
+	if err := c.call("textDocument/definition", newPositionParams(1, 1), &loc); err != nil {
+		t.Fatal(err)
+	}
+	if loc != nil {
+		t.Errorf("definition of predeclared type; expected nil, got %+v", loc)
+	}
+
+	// Hover.
+	hovers := []struct {
+		line, char int
+		want       string
+	}{
+		{line: 12, char: 9, want: "```c\nn: int\n```\n\nNumber of calls.\n"},
+		{line: 11, char: 1, want: "```c\na: int[10]\n```\n"},
+	}
+	for _, h := range hovers {
+		var hover *Hover
+		if err := c.call("textDocument/hover", newPositionParams(h.line, h.char), &hover); err != nil {
+			t.Fatal(err)
+		}
+		if hover == nil {
+			t.Errorf("%d:%d: expected hover, got nil", h.line, h.char)
+			continue
+		}
+		if hover.Contents.Value != h.want {
+			t.Errorf("%d:%d: hover mismatch; expected %q, got %q", h.line, h.char, h.want, hover.Contents.Value)
+		}
+	}
+
+	// Document symbols.
+	if err := c.call("textDocument/documentSymbol", &DocumentSymbolParams{TextDocument: TextDocumentIdentifier{URI: uri}}, &sym); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range sym {
+		names = append(names, s.Name)
+	}
+	if want := []string{"n", "add", "main"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("document symbols mismatch; expected %q, got %q", want, names)
+	} else {
+		if sym[1].Kind != SymbolFunction || sym[1].Range != newRange(4, 0, 7, 1) {
+			t.Errorf("symbol %q mismatch; got %+v", sym[1].Name, sym[1])
+		}
+		if len(sym[2].Children) != 1 || sym[2].Children[0].Name != "a" || sym[2].Children[0].Kind != SymbolVariable {
+			t.Errorf("local symbols of %q mismatch; got %+v", sym[2].Name, sym[2].Children)
+		}
+	}
+
+	// Find references.
+	refs := []struct {
+		decl bool
+		want []Location
+	}{
+		{decl: true, want: []Location{{uri, newRange(1, 4, 1, 5)}, {uri, newRange(5, 1, 5, 2)}, {uri, newRange(5, 5, 5, 6)}, {uri, newRange(12, 8, 12, 9)}}},
+		{decl: false, want: []Location{{uri, newRange(5, 1, 5, 2)}, {uri, newRange(5, 5, 5, 6)}, {uri, newRange(12, 8, 12, 9)}}},
+	}
+	for _, r := range refs {
+		var locs []Location
+		params := &ReferenceParams{TextDocumentPositionParams: *newPositionParams(1, 4), Context: ReferenceContext{IncludeDeclaration: r.decl}}
+		if err := c.call("textDocument/references", params, &locs); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(locs, r.want) {
+			t.Errorf("references mismatch (include declaration %v); expected %+v, got %+v", r.decl, r.want, locs)
+		}
+	}
+
+	// Unsupported methods.
+	err = c.call("textDocument/completion", newPositionParams(0, 0), nil)
+	if e, ok := err.(*ResponseError); !ok || e.Code != CodeMethodNotFound {
+		t.Errorf("unsupported method; expected error code %d, got %v", CodeMethodNotFound, err)
+	}
+
+	// Closing documents clears diagnostics.
+	c.notify("textDocument/didClose", &DidCloseTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: uri}})
+	if got := c.diagnostics(); len(got.Diagnostics) != 0 {
+		t.Errorf("diagnostics of closed document; expected none, got %+v", got.Diagnostics)
+	}
+
+	// Shutdown.
+	if err := c.call("shutdown", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	c.notify("exit", nil)
+	if err := <-c.done; err != nil {
+		t.Errorf("unexpected error on exit; %v", err)
+	}
+}
+
+func TestServerExitBeforeShutdown(t *testing.T) {
+	c := newClient(t)
+	c.notify("exit", nil)
+	if err := <-c.done; err == nil {
+		t.Errorf("expected error on exit before shutdown, got nil")
+	}
+}
+
+// A client is an in-process JSON-RPC client of a server.
+type client struct {
+	t *testing.T
+	// Input stream of the server.
+	w io.Writer
+	// Output stream of the server.
+	r *bufio.Reader
+	// ID of the previous request.
+	id int
+	// Notifications received while awaiting responses.
+	notes []*Message
+	// Result of Serve.
+	done chan error
+}
+
+// newClient returns a new client of a server running in a separate goroutine.
+func newClient(t *testing.T) *client {
+	inr, inw := io.Pipe()
+	outr, outw := io.Pipe()
+	s := NewServer(inr, outw, ioutil.Discard)
+	c := &client{t: t, w: inw, r: bufio.NewReader(outr), done: make(chan error, 1)}
+	go func() {
+		c.done <- s.Serve()
+		outw.Close()
+	}()
+	return c
+}
+
+// call sends a request of the given method and parameters, and decodes the
+// result of the response into result.
+func (c *client) call(method string, params, result interface{}) error {
+	c.id++
+	msg, err := newNotification(method, params)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	id := json.RawMessage(strconv.Itoa(c.id))
+	msg.ID = &id
+	if err := WriteMessage(c.w, msg); err != nil {
+		c.t.Fatal(err)
+	}
+	for {
+		resp, err := ReadMessage(c.r)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		if resp.ID == nil {
+			c.notes = append(c.notes, resp)
+			continue
+		}
+		if string(*resp.ID) != string(id) {
+			c.t.Fatalf("response ID mismatch; expected %s, got %s", id, *resp.ID)
+		}
+		if resp.Error != nil {
+			return resp.Error
+		}
+		if result == nil {
+			return nil
+		}
+		if err := json.Unmarshal(resp.Result, result); err != nil {
+			c.t.Fatal(err)
+		}
+		return nil
+	}
+}
+
+// notify sends a notification of the given method and parameters.
+func (c *client) notify(method string, params interface{}) {
+	msg, err := newNotification(method, params)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := WriteMessage(c.w, msg); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// diagnostics returns the parameters of the next diagnostics notification.
+func (c *client) diagnostics() *PublishDiagnosticsParams {
+	var msg *Message
+	if len(c.notes) > 0 {
+		msg, c.notes = c.notes[0], c.notes[1:]
+	} else {
+		var err error
+		if msg, err = ReadMessage(c.r); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+	if msg.Method != "textDocument/publishDiagnostics" {
+		c.t.Fatalf("method mismatch; expected %q, got %q", "textDocument/publishDiagnostics", msg.Method)
+	}
+	params := &PublishDiagnosticsParams{}
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		c.t.Fatal(err)
+	}
+	return params
+}
+
+// newRange returns a new range of the given line and character offsets.
+func newRange(line, char, endLine, endChar int) Range {
+	return Range{Start: Position{Line: line, Character: char}, End: Position{Line: endLine, Character: endChar}}
+}
+
+// newPositionParams returns new position parameters of the given line and
+// character offset in the test document.
+func newPositionParams(line, char int) *TextDocumentPositionParams {
+	return &TextDocumentPositionParams{TextDocument: TextDocumentIdentifier{URI: uri}, Position: Position{Line: line, Character: char}}
+}
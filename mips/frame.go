@@ -0,0 +1,63 @@
+package mips
+
+import (
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// A frame represents the stack frame layout of a function.
+type frame struct {
+	// Maps from values (parameters, local variables and instructions) to their
+	// offset relative to the frame pointer.
+	offsets map[value.Value]int64
+	// Maps from phi instructions to the offset of the stack slot holding their
+	// incoming value, relative to the frame pointer.
+	//
+	// Predecessors store the incoming value of a phi instruction before
+	// branching to its basic block; the phi instruction then copies the
+	// incoming value to its own stack slot, thus preventing incoming values from
+	// being overwritten before being used by other phi instructions.
+	incoming map[*ir.InstPhi]int64
+	// Size in bytes of local variables and temporaries.
+	size int64
+}
+
+// newFrame returns the stack frame layout of the given function.
+func newFrame(f *ir.Function) *frame {
+	fr := &frame{offsets: make(map[value.Value]int64), incoming: make(map[*ir.InstPhi]int64)}
+	// Arguments are stored above the saved return address and frame pointer.
+	for i, param := range f.Params() {
+		fr.offsets[param] = 8 + 4*int64(i)
+	}
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			switch inst := inst.(type) {
+			case *ir.InstAlloca:
+				fr.offsets[inst] = fr.alloc(sizeof(inst.Elem))
+			case *ir.InstPhi:
+				fr.incoming[inst] = fr.alloc(4)
+				fr.offsets[inst] = fr.alloc(4)
+			case value.Value:
+				if !types.IsVoid(inst.Type()) {
+					fr.offsets[inst] = fr.alloc(4)
+				}
+			}
+		}
+	}
+	// Keep the stack pointer double-word aligned.
+	fr.size = align(fr.size, 8)
+	return fr
+}
+
+// alloc allocates n bytes of stack space, and returns its offset relative to
+// the frame pointer.
+func (fr *frame) alloc(n int64) int64 {
+	fr.size = align(fr.size+n, 4)
+	return -fr.size
+}
+
+// align rounds x up to the nearest multiple of n.
+func align(x, n int64) int64 {
+	return (x + n - 1) / n * n
+}
@@ -0,0 +1,340 @@
+package mips
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// function emits the given function definition.
+func (g *generator) function(f *ir.Function) {
+	dbg.Printf("generate function: %v", f.Name)
+	g.frame = newFrame(f)
+	name := mangle(f.Name)
+	g.blockLabels = make(map[*ir.BasicBlock]string)
+	for i, block := range f.Blocks {
+		g.blockLabels[block] = fmt.Sprintf("%s.%d", name, i)
+	}
+	g.label(name)
+
+	// Prologue.
+	g.emit("addiu $sp, $sp, -8")
+	g.emit("sw $ra, 4($sp)")
+	g.emit("sw $fp, 0($sp)")
+	g.emit("move $fp, $sp")
+	if g.frame.size > 0 {
+		g.emit("addiu $sp, $sp, %d", -g.frame.size)
+	}
+
+	for _, block := range f.Blocks {
+		if block != f.Blocks[0] {
+			g.label(g.blockLabels[block])
+		}
+		for _, inst := range block.Insts {
+			g.inst(inst)
+		}
+		g.term(block)
+	}
+}
+
+// inst emits the given instruction.
+func (g *generator) inst(inst ir.Instruction) {
+	switch inst := inst.(type) {
+	// Memory instructions.
+	case *ir.InstAlloca:
+		// Stack space of local variables is allocated by the prologue.
+		return
+	case *ir.InstLoad:
+		addr := g.addr("$t1", inst.Src)
+		g.emit("%s $t0, %s", loadOp(inst.Type()), addr)
+	case *ir.InstStore:
+		addr := g.addr("$t1", inst.Dst)
+		g.load("$t0", inst.Src)
+		g.emit("%s $t0, %s", storeOp(inst.Src.Type()), addr)
+		return
+	case *ir.InstGetElementPtr:
+		g.gep("$t0", inst.Src, inst.Elem, inst.Indices)
+
+	// Binary instructions.
+	case *ir.InstAdd:
+		g.binary("addu", inst.X, inst.Y)
+	case *ir.InstSub:
+		g.binary("subu", inst.X, inst.Y)
+	case *ir.InstMul:
+		g.binary("mul", inst.X, inst.Y)
+	case *ir.InstSDiv:
+		g.load("$t0", inst.X)
+		g.load("$t1", inst.Y)
+		g.emit("div $t0, $t1")
+		g.emit("mflo $t0")
+	case *ir.InstSRem:
+		g.load("$t0", inst.X)
+		g.load("$t1", inst.Y)
+		g.emit("div $t0, $t1")
+		g.emit("mfhi $t0")
+	case *ir.InstAnd:
+		g.binary("and", inst.X, inst.Y)
+	case *ir.InstOr:
+		g.binary("or", inst.X, inst.Y)
+	case *ir.InstXor:
+		g.binary("xor", inst.X, inst.Y)
+	case *ir.InstShl:
+		g.binary("sllv", inst.X, inst.Y)
+	case *ir.InstAShr:
+		g.binary("srav", inst.X, inst.Y)
+
+	// Comparison instructions.
+	case *ir.InstICmp:
+		g.binary(cmpOp(inst.Cond), inst.X, inst.Y)
+
+	// Conversion instructions.
+	case *ir.InstTrunc:
+		// Truncated values are normalized below.
+		g.load("$t0", inst.From)
+	case *ir.InstZExt:
+		g.load("$t0", inst.From)
+		if sizeof(inst.From.Type()) == 1 && !types.IsBool(inst.From.Type()) {
+			g.emit("andi $t0, $t0, 0xFF")
+		}
+	case *ir.InstSExt:
+		g.load("$t0", inst.From)
+		if types.IsBool(inst.From.Type()) {
+			g.emit("negu $t0, $t0")
+		}
+
+	// Other instructions.
+	case *ir.InstPhi:
+		g.emit("lw $t0, %d($fp)", g.frame.incoming[inst])
+	case *ir.InstSelect:
+		g.load("$t0", inst.Cond)
+		g.load("$t1", inst.X)
+		g.load("$t2", inst.Y)
+		g.emit("movz $t1, $t2, $t0")
+		g.emit("move $t0, $t1")
+	case *ir.InstCall:
+		g.call(inst)
+		if types.IsVoid(inst.Type()) {
+			return
+		}
+		g.emit("move $t0, $v0")
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+
+	// Store the result of value producing instructions in their stack slot.
+	v, ok := inst.(value.Value)
+	if !ok {
+		panic(fmt.Sprintf("invalid instruction type; expected value.Value, got %T", inst))
+	}
+	g.normalize("$t0", v.Type())
+	g.emit("sw $t0, %d($fp)", g.frame.offsets[v])
+}
+
+// binary emits the binary operation op, storing the result in $t0.
+func (g *generator) binary(op string, x, y value.Value) {
+	g.load("$t0", x)
+	g.load("$t1", y)
+	g.emit("%s $t0, $t0, $t1", op)
+}
+
+// cmpOp returns the set instruction of the given integer comparison predicate.
+func cmpOp(cond ir.IntPred) string {
+	switch cond {
+	case ir.IntEQ:
+		return "seq"
+	case ir.IntNE:
+		return "sne"
+	case ir.IntUGT:
+		return "sgtu"
+	case ir.IntUGE:
+		return "sgeu"
+	case ir.IntULT:
+		return "sltu"
+	case ir.IntULE:
+		return "sleu"
+	case ir.IntSGT:
+		return "sgt"
+	case ir.IntSGE:
+		return "sge"
+	case ir.IntSLT:
+		return "slt"
+	case ir.IntSLE:
+		return "sle"
+	default:
+		panic(fmt.Sprintf("support for integer comparison predicate %v not yet implemented", cond))
+	}
+}
+
+// normalize sign-extends (or zero-extends for booleans) the value of the given
+// type held in reg, to the full width of the register.
+func (g *generator) normalize(reg string, typ types.Type) {
+	switch {
+	case types.IsBool(typ):
+		g.emit("andi %s, %s, 1", reg, reg)
+	case types.IsInt(typ) && sizeof(typ) == 1:
+		g.emit("sll %s, %s, 24", reg, reg)
+		g.emit("sra %s, %s, 24", reg, reg)
+	}
+}
+
+// gep emits the address computation of a getelementptr instruction or
+// expression, storing the result in reg.
+//
+// The registers $t8 and $t9 are used to scale indices.
+func (g *generator) gep(reg string, src value.Value, elem types.Type, indices []value.Value) {
+	g.load(reg, src)
+	for i, index := range indices {
+		if i > 0 {
+			switch t := elem.(type) {
+			case *types.ArrayType:
+				elem = t.Elem
+			case *types.PointerType:
+				elem = t.Elem
+			default:
+				panic(fmt.Sprintf("support for getelementptr index into type %T not yet implemented", t))
+			}
+		}
+		size := sizeof(elem)
+		if c, ok := index.(*constant.Int); ok {
+			if off := c.X.Int64() * size; off != 0 {
+				g.emit("addiu %s, %s, %d", reg, reg, off)
+			}
+			continue
+		}
+		g.load("$t8", index)
+		if size != 1 {
+			g.emit("li $t9, %d", size)
+			g.emit("mul $t8, $t8, $t9")
+		}
+		g.emit("addu %s, %s, $t8", reg, reg)
+	}
+}
+
+// call emits the given call instruction, leaving the result in $v0.
+func (g *generator) call(inst *ir.InstCall) {
+	if n := len(inst.Args); n > 0 {
+		g.emit("addiu $sp, $sp, %d", -4*n)
+		for i, arg := range inst.Args {
+			g.load("$t0", arg)
+			g.emit("sw $t0, %d($sp)", 4*i)
+		}
+	}
+	switch callee := inst.Callee.(type) {
+	case *ir.Function:
+		if len(callee.Blocks) == 0 {
+			g.externs[callee.Name] = true
+		}
+		g.emit("jal %s", mangle(callee.Name))
+	default:
+		g.load("$t0", callee)
+		g.emit("jalr $t0")
+	}
+	if n := len(inst.Args); n > 0 {
+		g.emit("addiu $sp, $sp, %d", 4*n)
+	}
+}
+
+// term emits the terminator of the given basic block.
+func (g *generator) term(block *ir.BasicBlock) {
+	switch term := block.Term.(type) {
+	case *ir.TermRet:
+		if term.X != nil {
+			g.load("$v0", term.X)
+		}
+		// Epilogue.
+		g.emit("move $sp, $fp")
+		g.emit("lw $fp, 0($sp)")
+		g.emit("lw $ra, 4($sp)")
+		g.emit("addiu $sp, $sp, 8")
+		g.emit("jr $ra")
+	case *ir.TermBr:
+		g.phiCopies(block, term.Target)
+		g.emit("j %s", g.blockLabels[term.Target])
+	case *ir.TermCondBr:
+		g.phiCopies(block, term.TargetTrue)
+		g.phiCopies(block, term.TargetFalse)
+		g.load("$t0", term.Cond)
+		g.emit("bnez $t0, %s", g.blockLabels[term.TargetTrue])
+		g.emit("j %s", g.blockLabels[term.TargetFalse])
+	case *ir.TermUnreachable:
+		g.emit("break 0")
+	default:
+		panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+	}
+}
+
+// phiCopies emits stores of the incoming values from the predecessor basic
+// block pred, to the phi instructions of the successor basic block succ.
+func (g *generator) phiCopies(pred, succ *ir.BasicBlock) {
+	for _, inst := range succ.Insts {
+		phi, ok := inst.(*ir.InstPhi)
+		if !ok {
+			continue
+		}
+		for _, inc := range phi.Incs {
+			if inc.Pred == pred {
+				g.load("$t0", inc.X)
+				g.emit("sw $t0, %d($fp)", g.frame.incoming[phi])
+			}
+		}
+	}
+}
+
+// load emits the instructions required to load the given value into reg.
+func (g *generator) load(reg string, v value.Value) {
+	switch v := v.(type) {
+	case *constant.Int:
+		g.emit("li %s, %d", reg, v.X.Int64())
+	case *ir.Global:
+		g.emit("la %s, %s", reg, mangle(v.Name))
+	case *ir.Function:
+		g.emit("la %s, %s", reg, mangle(v.Name))
+	case *constant.ExprGetElementPtr:
+		indices := make([]value.Value, len(v.Indices))
+		for i, index := range v.Indices {
+			indices[i] = index
+		}
+		g.gep(reg, v.Src, v.Elem, indices)
+	case *ir.InstAlloca:
+		g.emit("addiu %s, $fp, %d", reg, g.frame.offsets[v])
+	default:
+		off, ok := g.frame.offsets[v]
+		if !ok {
+			panic(fmt.Sprintf("support for value %T not yet implemented", v))
+		}
+		g.emit("lw %s, %d($fp)", reg, off)
+	}
+}
+
+// addr returns the memory operand of the given address, emitting instructions
+// to load the address into reg if required.
+func (g *generator) addr(reg string, v value.Value) string {
+	switch v := v.(type) {
+	case *ir.InstAlloca:
+		return fmt.Sprintf("%d($fp)", g.frame.offsets[v])
+	case *ir.Global:
+		return mangle(v.Name)
+	default:
+		g.load(reg, v)
+		return fmt.Sprintf("0(%s)", reg)
+	}
+}
+
+// loadOp returns the load instruction of values of the given type.
+func loadOp(typ types.Type) string {
+	if sizeof(typ) == 1 {
+		return "lb"
+	}
+	return "lw"
+}
+
+// storeOp returns the store instruction of values of the given type.
+func storeOp(typ types.Type) string {
+	if sizeof(typ) == 1 {
+		return "sb"
+	}
+	return "sw"
+}
@@ -0,0 +1,189 @@
+// Package mips implements a MIPS assembly generator for LLVM IR modules
+// produced by irgen.
+//
+// The generated assembly targets the MIPS32 instruction set, as accepted by the
+// SPIM and MARS simulators. Every function uses a frame pointer based stack
+// frame, and arguments are passed on the stack.
+//
+// Calling convention
+//
+// The caller reserves 4 bytes of stack space per argument, stores argument i
+// at 4*i($sp), and transfers control using jal. The callee returns its result
+// in $v0, and the caller releases the argument space once the call returns.
+//
+// Stack frame layout
+//
+//    8+4*i($fp)   argument i
+//        4($fp)   saved return address ($ra)
+//        0($fp)   saved frame pointer ($fp) of the caller
+//       -n($fp)   local variables and temporaries
+//
+// Each local variable (alloca) and each value producing instruction is
+// allocated a stack slot within the frame; all instruction operands are loaded
+// into temporary registers from their stack slots before use.
+package mips
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/term"
+)
+
+// TODO: Remove debug output.
+
+// dbg is a logger which prefixes debug messages with "mips:".
+var dbg = log.New(ioutil.Discard, term.WhiteBold("mips:"), log.Lshortfile)
+
+// Gen generates MIPS assembly for the given LLVM IR module.
+func Gen(module *ir.Module) (string, error) {
+	g := newGenerator()
+
+	// Emit global variables.
+	if len(module.Globals) > 0 {
+		g.emit(".data")
+		for _, global := range module.Globals {
+			if err := g.global(global); err != nil {
+				return "", errutil.Err(err)
+			}
+		}
+		g.buf.WriteString("\n")
+	}
+
+	// Emit program entry point, which exits with the result of main.
+	g.emit(".text")
+	g.emit(".globl main")
+	g.emit("jal main")
+	g.emit("move $a0, $v0")
+	g.emit("li $v0, 17")
+	g.emit("syscall")
+
+	// Emit function definitions.
+	for _, f := range module.Funcs {
+		if len(f.Blocks) == 0 {
+			// Function declarations are resolved against the runtime library.
+			continue
+		}
+		g.buf.WriteString("\n")
+		g.function(f)
+	}
+
+	// Emit runtime library routines of referenced external functions.
+	var externs []string
+	for name := range g.externs {
+		externs = append(externs, name)
+	}
+	sort.Strings(externs)
+	for _, name := range externs {
+		routine, ok := runtime[name]
+		if !ok {
+			return "", errutil.Newf("unable to locate runtime routine of external function %q", name)
+		}
+		g.buf.WriteString("\n")
+		g.label(mangle(name))
+		g.buf.WriteString(routine)
+	}
+
+	return g.buf.String(), nil
+}
+
+// A generator keeps track of the state required to generate MIPS assembly.
+type generator struct {
+	// Output buffer of the generated assembly.
+	buf *bytes.Buffer
+	// Stack frame of the function being generated.
+	frame *frame
+	// Maps from basic blocks of the function being generated to their labels.
+	blockLabels map[*ir.BasicBlock]string
+	// Set of referenced external functions.
+	externs map[string]bool
+}
+
+// newGenerator returns a new MIPS assembly generator.
+func newGenerator() *generator {
+	return &generator{buf: &bytes.Buffer{}, externs: make(map[string]bool)}
+}
+
+// emit emits the given instruction or directive.
+func (g *generator) emit(format string, a ...interface{}) {
+	g.buf.WriteString("\t")
+	fmt.Fprintf(g.buf, format, a...)
+	g.buf.WriteString("\n")
+}
+
+// label emits the given label.
+func (g *generator) label(name string) {
+	fmt.Fprintf(g.buf, "%s:\n", name)
+}
+
+// global emits the given global variable definition.
+func (g *generator) global(global *ir.Global) error {
+	if global.Init == nil {
+		return errutil.Newf("support for external global variable %q not yet implemented", global.Name)
+	}
+	g.emit(".align 2")
+	g.label(mangle(global.Name))
+	switch init := global.Init.(type) {
+	case *constant.Int:
+		g.emit("%s %d", dataDirective(init.Typ), init.X.Int64())
+	case *constant.ZeroInitializer:
+		g.emit(".space %d", sizeof(init.Typ))
+	case *constant.Array:
+		for _, elem := range init.Elems {
+			c, ok := elem.(*constant.Int)
+			if !ok {
+				return errutil.Newf("support for array element constant %T not yet implemented", elem)
+			}
+			g.emit("%s %d", dataDirective(c.Typ), c.X.Int64())
+		}
+	default:
+		return errutil.Newf("support for global variable initializer %T not yet implemented", init)
+	}
+	return nil
+}
+
+// dataDirective returns the data directive used to store integer constants of
+// the given type.
+func dataDirective(typ *types.IntType) string {
+	if typ.Size <= 8 {
+		return ".byte"
+	}
+	return ".word"
+}
+
+// mangle returns the assembly symbol of the given LLVM IR global identifier.
+//
+// Symbols are prefixed with an underscore to prevent clashes between µC
+// identifiers and MIPS mnemonics (e.g. "b" or "la"). The entry function main
+// keeps its name, as it is the program entry point of SPIM.
+func mangle(name string) string {
+	if name == "main" {
+		return name
+	}
+	return "_" + name
+}
+
+// sizeof returns the size in bytes of the given type.
+func sizeof(typ types.Type) int64 {
+	switch typ := typ.(type) {
+	case *types.IntType:
+		if typ.Size <= 8 {
+			return 1
+		}
+		// 64-bit integers are lowered to 32-bit words.
+		return 4
+	case *types.PointerType:
+		return 4
+	case *types.ArrayType:
+		return typ.Len * sizeof(typ.Elem)
+	default:
+		panic(fmt.Sprintf("support for type %T not yet implemented", typ))
+	}
+}
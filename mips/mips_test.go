@@ -0,0 +1,79 @@
+package mips_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/mips"
+	"github.com/mewmew/uc/sem"
+)
+
+func TestGen(t *testing.T) {
+	golden := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../testdata/quiet/mips/m01.c",
+			want: "../testdata/quiet/mips/m01.s",
+		},
+		{
+			path: "../testdata/quiet/mips/m02.c",
+			want: "../testdata/quiet/mips/m02.s",
+		},
+		{
+			path: "../testdata/quiet/mips/m03.c",
+			want: "../testdata/quiet/mips/m03.s",
+		},
+	}
+
+	for _, g := range golden {
+		// Lex input.
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		input := string(buf)
+		s := scanner.NewFromString(input)
+
+		// Parse input.
+		p := parser.NewParser()
+		f, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("%q: parse error: %v", g.path, err)
+			continue
+		}
+		file := f.(*ast.File)
+
+		// Verify input.
+		info, err := sem.Check(file)
+		if err != nil {
+			t.Errorf("%q: semantic analysis error: %v", g.path, err)
+			continue
+		}
+
+		// Generate MIPS assembly.
+		module := irgen.Gen(file, info)
+		got, err := mips.Gen(module)
+		if err != nil {
+			t.Errorf("%q: MIPS assembly generation error: %v", g.path, err)
+			continue
+		}
+
+		// Compare generated assembly against gold standard.
+		buf, err = ioutil.ReadFile(g.want)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		want := string(buf)
+		if got != want {
+			t.Errorf("%q: assembly mismatch; expected `%v`, got `%v`", g.path, want, got)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package mips
+
+// runtime maps from external function names to the MIPS assembly of their
+// runtime library routines, as provided by testdata/uc.c.
+//
+// Runtime routines are leaf functions, and thus access their arguments
+// directly from the stack pointer.
+var runtime = map[string]string{
+	// void putint(int x)
+	"putint": `	lw $a0, 0($sp)
+	li $v0, 1
+	syscall
+	jr $ra
+`,
+	// void putstring(char s[])
+	"putstring": `	lw $a0, 0($sp)
+	li $v0, 4
+	syscall
+	jr $ra
+`,
+	// int getint(void)
+	"getint": `	li $v0, 5
+	syscall
+	jr $ra
+`,
+	// int getstring(char s[])
+	//
+	// Mimics scanf("%s", s); leading whitespace is skipped, and characters are
+	// read into s until the next whitespace character or end of input. Returns
+	// 1, or -1 if end of input is reached before any character is read. End of
+	// input is reported by read_char as a non-positive value.
+	"getstring": `	lw $t2, 0($sp)
+_getstring.skip:
+	li $v0, 12
+	syscall
+	blez $v0, _getstring.eof
+	li $t1, 32
+	beq $v0, $t1, _getstring.skip
+	addiu $t0, $v0, -9
+	sltiu $t0, $t0, 5
+	bnez $t0, _getstring.skip
+_getstring.loop:
+	sb $v0, 0($t2)
+	addiu $t2, $t2, 1
+	li $v0, 12
+	syscall
+	blez $v0, _getstring.done
+	li $t1, 32
+	beq $v0, $t1, _getstring.done
+	addiu $t0, $v0, -9
+	sltiu $t0, $t0, 5
+	beqz $t0, _getstring.loop
+_getstring.done:
+	sb $zero, 0($t2)
+	li $v0, 1
+	jr $ra
+_getstring.eof:
+	li $v0, -1
+	jr $ra
+`,
+}
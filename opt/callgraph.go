@@ -0,0 +1,68 @@
+package opt
+
+import "github.com/llir/llvm/ir"
+
+// A CallGraph records the functions called by each function of a module.
+type CallGraph struct {
+	// Maps from functions to the functions they call directly, in order of
+	// first call.
+	Callees map[*ir.Function][]*ir.Function
+	// Maps from functions to the functions calling them directly, in order of
+	// first call.
+	Callers map[*ir.Function][]*ir.Function
+}
+
+// NewCallGraph returns the call graph of the given module.
+func NewCallGraph(module *ir.Module) *CallGraph {
+	cg := &CallGraph{
+		Callees: make(map[*ir.Function][]*ir.Function),
+		Callers: make(map[*ir.Function][]*ir.Function),
+	}
+	for _, f := range module.Funcs {
+		seen := make(map[*ir.Function]bool)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				call, ok := inst.(*ir.InstCall)
+				if !ok {
+					continue
+				}
+				callee, ok := call.Callee.(*ir.Function)
+				if !ok || seen[callee] {
+					continue
+				}
+				seen[callee] = true
+				cg.Callees[f] = append(cg.Callees[f], callee)
+				cg.Callers[callee] = append(cg.Callers[callee], f)
+			}
+		}
+	}
+	return cg
+}
+
+// Reachable returns the set of functions which may be called from f, directly
+// or indirectly. The function f is included in the set.
+func (cg *CallGraph) Reachable(f *ir.Function) map[*ir.Function]bool {
+	reachable := make(map[*ir.Function]bool)
+	var visit func(f *ir.Function)
+	visit = func(f *ir.Function) {
+		if reachable[f] {
+			return
+		}
+		reachable[f] = true
+		for _, callee := range cg.Callees[f] {
+			visit(callee)
+		}
+	}
+	visit(f)
+	return reachable
+}
+
+// IsRecursive reports whether f may call itself, directly or indirectly.
+func (cg *CallGraph) IsRecursive(f *ir.Function) bool {
+	for _, callee := range cg.Callees[f] {
+		if cg.Reachable(callee)[f] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package opt_test
+
+import (
+	"testing"
+
+	"github.com/llir/llvm/ir"
+	"github.com/mewmew/uc/opt"
+)
+
+func TestCallGraph(t *testing.T) {
+	golden := []struct {
+		path string
+		// Maps from function names to whether they are recursive.
+		recursive map[string]bool
+	}{
+		{
+			path:      "../testdata/noisy/medium/fac.c",
+			recursive: map[string]bool{"fac": true, "main": false, "putint": false},
+		},
+		{
+			path:      "../testdata/noisy/medium/fib.c",
+			recursive: map[string]bool{"fib": true, "main": false},
+		},
+		{
+			path:      "../testdata/extra/opt/inline.c",
+			recursive: map[string]bool{"add": false, "max": false, "main": false},
+		},
+	}
+
+	for _, g := range golden {
+		module, err := compile(g.path, false)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		cg := opt.NewCallGraph(module)
+		for name, want := range g.recursive {
+			f := findFunc(module, name)
+			if f == nil {
+				t.Errorf("%q: unable to locate function %q", g.path, name)
+				continue
+			}
+			if got := cg.IsRecursive(f); got != want {
+				t.Errorf("%q: recursion mismatch of function %q; expected %v, got %v", g.path, name, want, got)
+			}
+		}
+	}
+}
+
+// TestInlineRecursive verifies that recursive functions are neither inlined nor
+// removed.
+func TestInlineRecursive(t *testing.T) {
+	golden := []struct {
+		path string
+		name string
+	}{
+		{path: "../testdata/noisy/medium/fac.c", name: "fac"},
+		{path: "../testdata/noisy/medium/fib.c", name: "fib"},
+	}
+
+	for _, g := range golden {
+		module, err := compile(g.path, false)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		if err := opt.NewManager(opt.Pipeline(1)...).Run(module); err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		f := findFunc(module, g.name)
+		if f == nil {
+			t.Errorf("%q: unable to locate function %q", g.path, g.name)
+			continue
+		}
+		cg := opt.NewCallGraph(module)
+		if !cg.IsRecursive(f) {
+			t.Errorf("%q: expected function %q to remain recursive", g.path, g.name)
+		}
+		if len(cg.Callers[f]) != 2 {
+			t.Errorf("%q: expected function %q to be called from itself and main; got %d callers", g.path, g.name, len(cg.Callers[f]))
+		}
+	}
+}
+
+// findFunc returns the function of the given name in module, or nil if not
+// present.
+func findFunc(module *ir.Module, name string) *ir.Function {
+	for _, f := range module.Funcs {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
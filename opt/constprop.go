@@ -0,0 +1,266 @@
+package opt
+
+import (
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// constProp folds instructions with constant operands, and forwards values
+// stored to local variables to subsequent loads within the same basic block.
+// It reports whether f was changed.
+func constProp(f *ir.Function) bool {
+	changed := false
+	locals := localVars(f)
+	for _, block := range f.Blocks {
+		// Maps from local variables to their current value within the basic
+		// block.
+		stored := make(map[*ir.InstAlloca]value.Value)
+		for i := 0; i < len(block.Insts); i++ {
+			inst := block.Insts[i]
+			switch inst := inst.(type) {
+			case *ir.InstStore:
+				if local, ok := inst.Dst.(*ir.InstAlloca); ok && locals[local] {
+					stored[local] = inst.Src
+				}
+				continue
+			case *ir.InstLoad:
+				local, ok := inst.Src.(*ir.InstAlloca)
+				if !ok || !locals[local] {
+					continue
+				}
+				if v, ok := stored[local]; ok {
+					replaceUses(f, inst, v)
+					block.Insts = append(block.Insts[:i], block.Insts[i+1:]...)
+					i--
+					changed = true
+				} else {
+					// Forward the loaded value to subsequent loads.
+					stored[local] = inst
+				}
+				continue
+			}
+			v, ok := fold(inst)
+			if !ok {
+				continue
+			}
+			replaceUses(f, inst.(value.Value), v)
+			block.Insts = append(block.Insts[:i], block.Insts[i+1:]...)
+			i--
+			changed = true
+		}
+	}
+	return changed
+}
+
+// localVars returns the set of local variables of f, the address of which is
+// only used to load and store the value of the local variable.
+func localVars(f *ir.Function) map[*ir.InstAlloca]bool {
+	locals := make(map[*ir.InstAlloca]bool)
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			if local, ok := inst.(*ir.InstAlloca); ok && !types.IsArray(local.Elem) {
+				locals[local] = true
+			}
+		}
+	}
+	escape := func(v value.Value) {
+		if local, ok := v.(*ir.InstAlloca); ok {
+			delete(locals, local)
+		}
+	}
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			switch inst := inst.(type) {
+			case *ir.InstLoad:
+				// Loading from the local variable.
+			case *ir.InstStore:
+				// Storing the address of a local variable.
+				escape(inst.Src)
+			default:
+				for _, op := range operands(inst) {
+					escape(*op)
+				}
+			}
+		}
+		for _, op := range termOperands(block.Term) {
+			escape(*op)
+		}
+	}
+	return locals
+}
+
+// fold returns the value of the given instruction, if it may be determined at
+// compile time.
+func fold(inst ir.Instruction) (value.Value, bool) {
+	switch inst := inst.(type) {
+	case *ir.InstAdd:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x + y })
+	case *ir.InstSub:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x - y })
+	case *ir.InstMul:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x * y })
+	case *ir.InstSDiv:
+		if isZero(inst.Y) {
+			// Leave division by zero to run time.
+			return nil, false
+		}
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x / y })
+	case *ir.InstSRem:
+		if isZero(inst.Y) {
+			// Leave division by zero to run time.
+			return nil, false
+		}
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x % y })
+	case *ir.InstAnd:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x & y })
+	case *ir.InstOr:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x | y })
+	case *ir.InstXor:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x ^ y })
+	case *ir.InstShl:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x << uint64(y) })
+	case *ir.InstAShr:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 { return x >> uint64(y) })
+	case *ir.InstICmp:
+		return foldBinary(inst, inst.X, inst.Y, func(x, y int64) int64 {
+			if compare(inst.Cond, x, y, inst.X.Type()) {
+				return 1
+			}
+			return 0
+		})
+	case *ir.InstTrunc:
+		return foldConv(inst.From, inst.To, false)
+	case *ir.InstZExt:
+		return foldConv(inst.From, inst.To, true)
+	case *ir.InstSExt:
+		return foldConv(inst.From, inst.To, false)
+	case *ir.InstSelect:
+		c, ok := inst.Cond.(*constant.Int)
+		if !ok {
+			return nil, false
+		}
+		if c.X.Sign() != 0 {
+			return inst.X, true
+		}
+		return inst.Y, true
+	case *ir.InstPhi:
+		// Phi instructions with a single incoming value, disregarding the phi
+		// instruction itself.
+		var same value.Value
+		for _, inc := range inst.Incs {
+			if inc.X == inst || (same != nil && isSameValue(inc.X, same)) {
+				continue
+			}
+			if same != nil {
+				return nil, false
+			}
+			same = inc.X
+		}
+		if same == nil {
+			return nil, false
+		}
+		return same, true
+	default:
+		return nil, false
+	}
+}
+
+// foldBinary folds the binary instruction inst, if both operands are constant.
+func foldBinary(inst value.Value, x, y value.Value, op func(x, y int64) int64) (value.Value, bool) {
+	a, ok := x.(*constant.Int)
+	if !ok {
+		return nil, false
+	}
+	b, ok := y.(*constant.Int)
+	if !ok {
+		return nil, false
+	}
+	return newInt(op(a.X.Int64(), b.X.Int64()), inst.Type()), true
+}
+
+// foldConv folds the conversion of the given value to the type to, if the
+// value is constant.
+func foldConv(from value.Value, to types.Type, zext bool) (value.Value, bool) {
+	c, ok := from.(*constant.Int)
+	if !ok {
+		return nil, false
+	}
+	x := c.X.Int64()
+	if zext {
+		x = unsigned(x, c.Typ)
+	}
+	return newInt(x, to), true
+}
+
+// newInt returns a new integer constant of the given type, truncating x to the
+// bit width of the type.
+func newInt(x int64, typ types.Type) *constant.Int {
+	t := typ.(*types.IntType)
+	switch t.Size {
+	case 1:
+		x &= 1
+	case 8:
+		x = int64(int8(x))
+	case 32:
+		x = int64(int32(x))
+	}
+	return constant.NewInt(x, typ)
+}
+
+// unsigned returns the unsigned interpretation of x, based on the bit width of
+// the given type.
+func unsigned(x int64, typ types.Type) int64 {
+	t := typ.(*types.IntType)
+	if t.Size >= 64 {
+		return x
+	}
+	return x & (1<<uint(t.Size) - 1)
+}
+
+// compare reports whether the integer comparison of x and y is true.
+func compare(cond ir.IntPred, x, y int64, typ types.Type) bool {
+	switch cond {
+	case ir.IntEQ:
+		return x == y
+	case ir.IntNE:
+		return x != y
+	case ir.IntSGT:
+		return x > y
+	case ir.IntSGE:
+		return x >= y
+	case ir.IntSLT:
+		return x < y
+	case ir.IntSLE:
+		return x <= y
+	}
+	ux, uy := uint64(unsigned(x, typ)), uint64(unsigned(y, typ))
+	switch cond {
+	case ir.IntUGT:
+		return ux > uy
+	case ir.IntUGE:
+		return ux >= uy
+	case ir.IntULT:
+		return ux < uy
+	default:
+		// ir.IntULE
+		return ux <= uy
+	}
+}
+
+// isZero reports whether v is the integer constant zero.
+func isZero(v value.Value) bool {
+	c, ok := v.(*constant.Int)
+	return ok && c.X.Sign() == 0
+}
+
+// isSameValue reports whether the values x and y are identical.
+func isSameValue(x, y value.Value) bool {
+	if x, ok := x.(*constant.Int); ok {
+		if y, ok := y.(*constant.Int); ok {
+			return types.Equal(x.Typ, y.Typ) && x.X.Cmp(y.X) == 0
+		}
+	}
+	return x == y
+}
@@ -0,0 +1,102 @@
+package opt
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/value"
+)
+
+// cse removes common subexpressions within the basic blocks of f. It reports
+// whether f was changed.
+func cse(f *ir.Function) bool {
+	changed := false
+	for _, block := range f.Blocks {
+		// Maps from expression keys to the first instruction computing the
+		// expression within the basic block.
+		exprs := make(map[exprKey]value.Value)
+		for i := 0; i < len(block.Insts); i++ {
+			inst := block.Insts[i]
+			key, ok := keyOf(inst)
+			if !ok {
+				continue
+			}
+			if v, ok := exprs[key]; ok {
+				replaceUses(f, inst.(value.Value), v)
+				block.Insts = append(block.Insts[:i], block.Insts[i+1:]...)
+				i--
+				changed = true
+				continue
+			}
+			exprs[key] = inst.(value.Value)
+		}
+	}
+	return changed
+}
+
+// An exprKey uniquely identifies the expression computed by an instruction
+// without side effects.
+type exprKey struct {
+	// Opcode of the instruction, including the comparison predicate or the
+	// target type, if any.
+	op string
+	// Operands of the instruction; either values or intKeys.
+	x, y, z interface{}
+	// Trailing operands of getelementptr instructions.
+	rest string
+}
+
+// An intKey identifies an integer constant.
+type intKey struct {
+	typ string
+	x   string
+}
+
+// keyOf returns the expression key of the given instruction, and reports
+// whether the instruction is without side effects.
+func keyOf(inst ir.Instruction) (exprKey, bool) {
+	switch inst := inst.(type) {
+	case *ir.InstAdd, *ir.InstSub, *ir.InstMul, *ir.InstSDiv, *ir.InstSRem, *ir.InstAnd, *ir.InstOr, *ir.InstXor, *ir.InstShl, *ir.InstAShr, *ir.InstSelect:
+		return newExprKey(fmt.Sprintf("%T", inst), operands(inst)), true
+	case *ir.InstICmp:
+		return newExprKey(fmt.Sprintf("icmp %v", inst.Cond), operands(inst)), true
+	case *ir.InstTrunc:
+		return newExprKey(fmt.Sprintf("trunc %v", inst.To), operands(inst)), true
+	case *ir.InstZExt:
+		return newExprKey(fmt.Sprintf("zext %v", inst.To), operands(inst)), true
+	case *ir.InstSExt:
+		return newExprKey(fmt.Sprintf("sext %v", inst.To), operands(inst)), true
+	case *ir.InstGetElementPtr:
+		return newExprKey("getelementptr", operands(inst)), true
+	default:
+		return exprKey{}, false
+	}
+}
+
+// newExprKey returns the expression key of an instruction based on its opcode
+// and operands.
+func newExprKey(op string, ops []*value.Value) exprKey {
+	key := exprKey{op: op}
+	for i, op := range ops {
+		var k interface{} = *op
+		if c, ok := (*op).(*constant.Int); ok {
+			k = intKey{typ: c.Typ.String(), x: c.X.String()}
+		}
+		switch i {
+		case 0:
+			key.x = k
+		case 1:
+			key.y = k
+		case 2:
+			key.z = k
+		default:
+			if c, ok := k.(intKey); ok {
+				key.rest += fmt.Sprintf("%s %s,", c.typ, c.x)
+			} else {
+				key.rest += fmt.Sprintf("%p,", *op)
+			}
+		}
+	}
+	return key
+}
@@ -0,0 +1,128 @@
+package opt
+
+import (
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/value"
+)
+
+// dce removes basic blocks unreachable from the entry basic block, unused
+// instructions without side effects, and local variables which are never
+// loaded. It reports whether f was changed.
+func dce(f *ir.Function) bool {
+	changed := removeUnreachable(f)
+	for {
+		// Local variables which are never loaded.
+		dead := localVars(f)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				if load, ok := inst.(*ir.InstLoad); ok {
+					if local, ok := load.Src.(*ir.InstAlloca); ok {
+						delete(dead, local)
+					}
+				}
+			}
+		}
+		used := uses(f)
+		removed := false
+		for _, block := range f.Blocks {
+			var insts []ir.Instruction
+			for _, inst := range block.Insts {
+				if isDead(inst, used, dead) {
+					removed = true
+					continue
+				}
+				insts = append(insts, inst)
+			}
+			block.Insts = insts
+		}
+		if !removed {
+			return changed
+		}
+		changed = true
+	}
+}
+
+// isDead reports whether the given instruction may be removed, based on the
+// number of uses of each value and the set of local variables which are never
+// loaded.
+func isDead(inst ir.Instruction, used map[value.Value]int, dead map[*ir.InstAlloca]bool) bool {
+	switch inst := inst.(type) {
+	case *ir.InstStore:
+		local, ok := inst.Dst.(*ir.InstAlloca)
+		return ok && dead[local]
+	case *ir.InstCall:
+		if local, ok := declaredVar(inst); ok {
+			// Debug metadata of local variables is removed with the local
+			// variable.
+			return isDead(local, used, dead)
+		}
+		// Calls may have side effects.
+		return false
+	case *ir.InstAlloca:
+		return used[inst] == 0 || dead[inst]
+	case value.Value:
+		return used[inst] == 0
+	default:
+		return false
+	}
+}
+
+// removeUnreachable removes basic blocks unreachable from the entry basic block
+// of f. It reports whether f was changed.
+func removeUnreachable(f *ir.Function) bool {
+	reachable := make(map[*ir.BasicBlock]bool)
+	var visit func(block *ir.BasicBlock)
+	visit = func(block *ir.BasicBlock) {
+		if reachable[block] {
+			return
+		}
+		reachable[block] = true
+		for _, succ := range succs(block.Term) {
+			visit(succ)
+		}
+	}
+	visit(f.Blocks[0])
+	if len(reachable) == len(f.Blocks) {
+		return false
+	}
+	var blocks []*ir.BasicBlock
+	for _, block := range f.Blocks {
+		if reachable[block] {
+			blocks = append(blocks, block)
+			continue
+		}
+		for _, succ := range succs(block.Term) {
+			removeIncoming(succ, block)
+		}
+	}
+	f.Blocks = blocks
+	return true
+}
+
+// globalDCE removes functions which are never called from the main function of
+// a program, and reports whether the module was changed. Modules without a
+// definition of main are left unchanged, as their functions may be called
+// externally.
+func globalDCE(module *ir.Module) bool {
+	var main *ir.Function
+	for _, f := range module.Funcs {
+		if f.Name == "main" && len(f.Blocks) > 0 {
+			main = f
+		}
+	}
+	if main == nil {
+		return false
+	}
+	reachable := NewCallGraph(module).Reachable(main)
+	var funcs []*ir.Function
+	for _, f := range module.Funcs {
+		if reachable[f] {
+			funcs = append(funcs, f)
+		}
+	}
+	if len(funcs) == len(module.Funcs) {
+		return false
+	}
+	module.Funcs = funcs
+	return true
+}
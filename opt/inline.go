@@ -0,0 +1,314 @@
+package opt
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// InlineThreshold specifies the maximum cost of functions inlined at their call
+// sites. The cost of a function is its number of instructions and terminators.
+var InlineThreshold = 25
+
+// inline inlines calls to small non-recursive functions, and reports whether
+// the module was changed.
+func inline(module *ir.Module) bool {
+	cg := NewCallGraph(module)
+	changed := false
+	for _, f := range module.Funcs {
+		for i := 0; i < len(f.Blocks); i++ {
+			block := f.Blocks[i]
+			for j, inst := range block.Insts {
+				call, ok := inst.(*ir.InstCall)
+				if !ok {
+					continue
+				}
+				callee, ok := call.Callee.(*ir.Function)
+				if !ok || !isInlinable(cg, f, callee) {
+					continue
+				}
+				inlineCall(f, i, j, callee)
+				changed = true
+				// Continue with the instructions following the call, which were
+				// moved to a new basic block.
+				break
+			}
+		}
+	}
+	return changed
+}
+
+// isInlinable reports whether calls from f to callee may be inlined.
+func isInlinable(cg *CallGraph, f, callee *ir.Function) bool {
+	if len(callee.Blocks) == 0 || callee == f || cg.IsRecursive(callee) {
+		return false
+	}
+	return cost(callee) <= InlineThreshold
+}
+
+// cost returns the number of instructions and terminators of f.
+func cost(f *ir.Function) int {
+	n := 0
+	for _, block := range f.Blocks {
+		n += len(block.Insts) + 1
+	}
+	return n
+}
+
+// inlineCall inlines the call instruction at index j of the i:th basic block of
+// f, which calls callee.
+func inlineCall(f *ir.Function, i, j int, callee *ir.Function) {
+	block := f.Blocks[i]
+	call := block.Insts[j].(*ir.InstCall)
+
+	// Split the basic block after the call instruction.
+	cont := &ir.BasicBlock{Parent: f}
+	for _, inst := range block.Insts[j+1:] {
+		inst.SetParent(cont)
+		cont.Insts = append(cont.Insts, inst)
+	}
+	cont.SetTerm(block.Term)
+	for _, succ := range succs(block.Term) {
+		replacePred(succ, block, cont)
+	}
+	block.Insts = block.Insts[:j]
+
+	// Clone the basic blocks of the callee, mapping parameters to arguments.
+	vmap := make(map[value.Value]value.Value)
+	for k, param := range callee.Sig.Params {
+		vmap[param] = call.Args[k]
+	}
+	blocks := cloneBlocks(f, callee, vmap, call.Metadata)
+	block.SetTerm(ir.NewBr(blocks[0]))
+
+	// Insert the cloned basic blocks and the continuation basic block after the
+	// split basic block.
+	var tail []*ir.BasicBlock
+	tail = append(tail, blocks...)
+	tail = append(tail, cont)
+	tail = append(tail, f.Blocks[i+1:]...)
+	f.Blocks = append(f.Blocks[:i+1], tail...)
+
+	// Replace return terminators with branches to the continuation basic block.
+	var incs []*ir.Incoming
+	for _, b := range blocks {
+		ret, ok := b.Term.(*ir.TermRet)
+		if !ok {
+			continue
+		}
+		if ret.X != nil {
+			incs = append(incs, ir.NewIncoming(ret.X, b))
+		}
+		b.SetTerm(ir.NewBr(cont))
+	}
+	if !types.IsVoid(call.Type()) {
+		var v value.Value
+		switch len(incs) {
+		case 0:
+			// The callee never returns.
+			v = newInt(0, call.Type())
+		case 1:
+			v = incs[0].X
+		default:
+			phi := ir.NewPhi(incs...)
+			phi.SetParent(cont)
+			cont.Insts = append([]ir.Instruction{phi}, cont.Insts...)
+			v = phi
+		}
+		replaceUses(f, call, v)
+	}
+
+	// Hoist local variables of the callee to the entry basic block of f, so
+	// that calls within loops don't grow the stack.
+	entry := f.Blocks[0]
+	var allocas []ir.Instruction
+	for _, b := range blocks {
+		var insts []ir.Instruction
+		for _, inst := range b.Insts {
+			if alloca, ok := inst.(*ir.InstAlloca); ok {
+				alloca.SetParent(entry)
+				allocas = append(allocas, alloca)
+				continue
+			}
+			insts = append(insts, inst)
+		}
+		b.Insts = insts
+	}
+	entry.Insts = append(allocas, entry.Insts...)
+
+}
+
+// cloneBlocks returns a copy of the basic blocks of callee for inclusion in f.
+// The given value map is extended with mappings from the values of callee to
+// their copies.
+//
+// The copies are given the metadata attachments md of the call site, so that
+// their debug location is within the scope of f. Calls to llvm.dbg.declare are
+// not copied, as local variables of inlined functions are not described by
+// debug metadata.
+func cloneBlocks(f, callee *ir.Function, vmap map[value.Value]value.Value, md map[string]*metadata.Metadata) []*ir.BasicBlock {
+	bmap := make(map[*ir.BasicBlock]*ir.BasicBlock)
+	var blocks []*ir.BasicBlock
+	for _, old := range callee.Blocks {
+		b := &ir.BasicBlock{Parent: f}
+		bmap[old] = b
+		blocks = append(blocks, b)
+	}
+	for k, old := range callee.Blocks {
+		b := blocks[k]
+		for _, inst := range old.Insts {
+			if _, ok := declaredVar(inst); ok {
+				continue
+			}
+			c := cloneInst(inst)
+			c.SetParent(b)
+			setAttachments(c, md)
+			if v, ok := inst.(value.Value); ok {
+				vmap[v] = c.(value.Value)
+			}
+			b.Insts = append(b.Insts, c)
+		}
+		term := cloneTerm(old.Term, bmap)
+		setAttachments(term, md)
+		b.SetTerm(term)
+	}
+	// Remap operands, once the copies of all values are known.
+	for _, b := range blocks {
+		for _, inst := range b.Insts {
+			for _, op := range operands(inst) {
+				if v, ok := vmap[*op]; ok {
+					*op = v
+				}
+			}
+			if phi, ok := inst.(*ir.InstPhi); ok {
+				for _, inc := range phi.Incs {
+					inc.Pred = bmap[inc.Pred]
+				}
+			}
+		}
+		for _, op := range termOperands(b.Term) {
+			if v, ok := vmap[*op]; ok {
+				*op = v
+			}
+		}
+	}
+	return blocks
+}
+
+// cloneInst returns an unnamed copy of the given instruction. Operands refer to
+// the values of the original instruction.
+func cloneInst(inst ir.Instruction) ir.Instruction {
+	switch inst := inst.(type) {
+	case *ir.InstAlloca:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstLoad:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstStore:
+		c := *inst
+		return &c
+	case *ir.InstGetElementPtr:
+		c := *inst
+		c.SetName("")
+		c.Indices = append([]value.Value(nil), inst.Indices...)
+		return &c
+	case *ir.InstAdd:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstSub:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstMul:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstSDiv:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstSRem:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstAnd:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstOr:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstXor:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstShl:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstAShr:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstICmp:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstTrunc:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstZExt:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstSExt:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstPhi:
+		c := *inst
+		c.SetName("")
+		c.Incs = nil
+		for _, inc := range inst.Incs {
+			c.Incs = append(c.Incs, ir.NewIncoming(inc.X, inc.Pred))
+		}
+		return &c
+	case *ir.InstSelect:
+		c := *inst
+		c.SetName("")
+		return &c
+	case *ir.InstCall:
+		c := *inst
+		c.SetName("")
+		c.Args = append([]value.Value(nil), inst.Args...)
+		return &c
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+}
+
+// cloneTerm returns a copy of the given terminator, with successors mapped
+// through bmap. Operands refer to the values of the original terminator.
+func cloneTerm(term ir.Terminator, bmap map[*ir.BasicBlock]*ir.BasicBlock) ir.Terminator {
+	switch term := term.(type) {
+	case *ir.TermRet:
+		return ir.NewRet(term.X)
+	case *ir.TermBr:
+		return ir.NewBr(bmap[term.Target])
+	case *ir.TermCondBr:
+		return ir.NewCondBr(term.Cond, bmap[term.TargetTrue], bmap[term.TargetFalse])
+	case *ir.TermUnreachable:
+		return ir.NewUnreachable()
+	default:
+		panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+	}
+}
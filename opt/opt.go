@@ -0,0 +1,116 @@
+// Package opt implements optimization passes over LLVM IR modules produced by
+// irgen.
+//
+// Each pass transforms the function definitions of a module in place. A
+// Manager runs a sequence of passes repeatedly, until none of the passes
+// changes the module.
+package opt
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/llir/llvm/ir"
+	"github.com/mewkiz/pkg/errutil"
+)
+
+// A Pass is an optimization pass, which transforms either each function
+// definition or the entire module in place.
+type Pass struct {
+	// Pass name; e.g. "constprop".
+	Name string
+	// Run transforms the given function definition, and reports whether it was
+	// changed.
+	Run func(f *ir.Function) bool
+	// RunModule, if non-nil, transforms the given module instead, and reports
+	// whether it was changed.
+	RunModule func(module *ir.Module) bool
+}
+
+// Optimization passes.
+var (
+	// ConstProp folds instructions with constant operands, and forwards values
+	// stored to local variables to subsequent loads within the same basic
+	// block.
+	ConstProp = &Pass{Name: "constprop", Run: constProp}
+	// DCE removes unused instructions without side effects, local variables
+	// which are never loaded, and basic blocks unreachable from the entry basic
+	// block.
+	DCE = &Pass{Name: "dce", Run: dce}
+	// SimplifyCFG folds conditional branches with constant conditions, and
+	// merges basic blocks into their unique predecessor.
+	SimplifyCFG = &Pass{Name: "simplifycfg", Run: simplifyCFG}
+	// CSE removes common subexpressions within basic blocks.
+	CSE = &Pass{Name: "cse", Run: cse}
+	// Inline inlines calls to small non-recursive functions.
+	Inline = &Pass{Name: "inline", RunModule: inline}
+	// GlobalDCE removes functions which are never called from main.
+	GlobalDCE = &Pass{Name: "globaldce", RunModule: globalDCE}
+)
+
+// Pipeline returns the optimization passes of the given optimization level.
+func Pipeline(level int) []*Pass {
+	switch level {
+	case 0:
+		return nil
+	case 1:
+		return []*Pass{Inline, GlobalDCE, ConstProp, SimplifyCFG, CSE, DCE}
+	default:
+		panic(fmt.Sprintf("support for optimization level %d not yet implemented", level))
+	}
+}
+
+// maxRounds specifies the maximum number of times the passes of a Manager are
+// run over a module.
+const maxRounds = 10
+
+// A Manager runs a sequence of optimization passes over the function
+// definitions of a module.
+type Manager struct {
+	// Optimization passes, in order.
+	Passes []*Pass
+	// PrintAfter, if non-nil, receives the LLVM IR of the module after each
+	// pass.
+	PrintAfter io.Writer
+}
+
+// NewManager returns a new pass manager for the given optimization passes.
+func NewManager(passes ...*Pass) *Manager {
+	return &Manager{Passes: passes}
+}
+
+// Run runs the optimization passes over the given module, until none of the
+// passes changes the module.
+func (pm *Manager) Run(module *ir.Module) error {
+	for round := 0; round < maxRounds; round++ {
+		changed := false
+		for _, pass := range pm.Passes {
+			if pass.RunModule != nil {
+				if pass.RunModule(module) {
+					changed = true
+				}
+			}
+			for _, f := range module.Funcs {
+				if pass.Run == nil {
+					break
+				}
+				// Skip function declarations.
+				if len(f.Blocks) == 0 {
+					continue
+				}
+				if pass.Run(f) {
+					changed = true
+				}
+			}
+			if pm.PrintAfter != nil {
+				if _, err := fmt.Fprintf(pm.PrintAfter, "; *** IR after %s (round %d) ***\n%v\n", pass.Name, round+1, module); err != nil {
+					return errutil.Err(err)
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return nil
+}
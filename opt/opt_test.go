@@ -0,0 +1,183 @@
+package opt_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/interp"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/opt"
+	"github.com/mewmew/uc/sem"
+	semerrors "github.com/mewmew/uc/sem/errors"
+)
+
+func TestPipeline(t *testing.T) {
+	golden := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "../testdata/extra/opt/fold.c",
+			want: "../testdata/extra/opt/fold.ll",
+		},
+		{
+			path: "../testdata/extra/opt/inline.c",
+			want: "../testdata/extra/opt/inline.ll",
+		},
+	}
+
+	for _, g := range golden {
+		module, err := compile(g.path, false)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		if err := opt.NewManager(opt.Pipeline(1)...).Run(module); err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		buf, err := ioutil.ReadFile(g.want)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		if got, want := module.String(), string(buf); got != want {
+			t.Errorf("%q: module mismatch; expected `%v`, got `%v`", g.path, want, got)
+		}
+	}
+}
+
+// TestDebugInfo verifies that the optimization passes preserve valid debug
+// metadata; i.e. that calls are located within the subprogram of their parent
+// function, and that llvm.dbg.declare only describes existing local variables.
+func TestDebugInfo(t *testing.T) {
+	path := "../testdata/extra/debuginfo/debug.c"
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := parser.NewParser()
+	f, err := p.Parse(scanner.NewFromBytes(buf))
+	if err != nil {
+		t.Fatalf("%q: parse error: %v", path, err)
+	}
+	file := f.(*ast.File)
+	info, err := sem.Check(file)
+	if err != nil {
+		t.Fatalf("%q: semantic analysis error: %v", path, err)
+	}
+	module, debug := irgen.GenDebug(file, info, semerrors.NewSource(path, string(buf)), irgen.Config{})
+	if err := opt.NewManager(opt.Pipeline(1)...).Run(module); err != nil {
+		t.Fatalf("%q: %v", path, err)
+	}
+	for _, f := range module.Funcs {
+		sp, ok := f.Metadata["dbg"]
+		if len(f.Blocks) == 0 || !ok {
+			continue
+		}
+		scope := fmt.Sprintf("scope: %s)", sp.Ident())
+		locals := make(map[*ir.InstAlloca]bool)
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				if local, ok := inst.(*ir.InstAlloca); ok {
+					locals[local] = true
+				}
+			}
+		}
+		for _, block := range f.Blocks {
+			for _, inst := range block.Insts {
+				call, ok := inst.(*ir.InstCall)
+				if !ok {
+					continue
+				}
+				loc, ok := call.Metadata["dbg"]
+				if !ok {
+					t.Errorf("%q: missing location of call %q in function %q", path, call.Def(), f.Name)
+				} else if !strings.HasSuffix(debug.Def(loc), scope) {
+					t.Errorf("%q: location of call %q outside of function %q", path, call.Def(), f.Name)
+				}
+				if callee, ok := call.Callee.(*ir.Function); ok && callee.Name == "llvm.dbg.declare" {
+					v := call.Args[0].(*metadata.Value)
+					if local, ok := v.X.(*ir.InstAlloca); !ok || !locals[local] {
+						t.Errorf("%q: llvm.dbg.declare of removed local variable %q in function %q", path, call.Def(), f.Name)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestNoisy verifies that the optimization passes preserve the output of every
+// program of testdata/noisy, both with and without SSA construction in irgen.
+func TestNoisy(t *testing.T) {
+	paths, err := filepath.Glob("../testdata/noisy/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("unable to locate test programs")
+	}
+	for _, ssa := range []bool{false, true} {
+		for _, path := range paths {
+			base := strings.TrimSuffix(path, ".c")
+			input, err := ioutil.ReadFile(base + ".in")
+			if err != nil && !os.IsNotExist(err) {
+				t.Errorf("%q: %v", path, err)
+				continue
+			}
+			want, err := ioutil.ReadFile(base + ".out")
+			if err != nil {
+				t.Errorf("%q: %v", path, err)
+				continue
+			}
+			module, err := compile(path, ssa)
+			if err != nil {
+				t.Errorf("%q: %v", path, err)
+				continue
+			}
+			if err := opt.NewManager(opt.Pipeline(1)...).Run(module); err != nil {
+				t.Errorf("%q: %v", path, err)
+				continue
+			}
+			out := &bytes.Buffer{}
+			if _, err := interp.Run(module, bytes.NewReader(input), out); err != nil {
+				t.Errorf("%q (ssa=%v): run error: %v", path, ssa, err)
+				continue
+			}
+			if got := out.String(); got != string(want) {
+				t.Errorf("%q (ssa=%v): output mismatch; expected %q, got %q", path, ssa, want, got)
+			}
+		}
+	}
+}
+
+// compile compiles the given µC source file into an LLVM IR module, optionally
+// mapping scalar local variables to SSA values.
+func compile(path string, ssa bool) (*ir.Module, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := scanner.NewFromBytes(buf)
+	p := parser.NewParser()
+	f, err := p.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	file := f.(*ast.File)
+	info, err := sem.Check(file)
+	if err != nil {
+		return nil, err
+	}
+	return irgen.GenWith(file, info, irgen.Config{SSA: ssa}), nil
+}
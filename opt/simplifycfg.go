@@ -0,0 +1,81 @@
+package opt
+
+import (
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+)
+
+// simplifyCFG folds conditional branches with constant conditions, and merges
+// basic blocks into their unique predecessor. It reports whether f was changed.
+func simplifyCFG(f *ir.Function) bool {
+	changed := false
+	// Fold conditional branches.
+	for _, block := range f.Blocks {
+		term, ok := block.Term.(*ir.TermCondBr)
+		if !ok {
+			continue
+		}
+		if term.TargetTrue == term.TargetFalse {
+			block.SetTerm(ir.NewBr(term.TargetTrue))
+			changed = true
+			continue
+		}
+		cond, ok := term.Cond.(*constant.Int)
+		if !ok {
+			continue
+		}
+		target, other := term.TargetTrue, term.TargetFalse
+		if cond.X.Sign() == 0 {
+			target, other = other, target
+		}
+		removeIncoming(other, block)
+		block.SetTerm(ir.NewBr(target))
+		changed = true
+	}
+	if removeUnreachable(f) {
+		changed = true
+	}
+
+	// Merge basic blocks into their unique predecessor, if the predecessor has
+	// a single successor.
+	for {
+		merged := false
+		ps := preds(f)
+		for i, block := range f.Blocks[1:] {
+			if len(ps[block]) != 1 {
+				continue
+			}
+			pred := ps[block][0]
+			if _, ok := pred.Term.(*ir.TermBr); !ok || pred == block {
+				continue
+			}
+			mergeBlock(f, pred, block)
+			f.Blocks = append(f.Blocks[:i+1], f.Blocks[i+2:]...)
+			merged = true
+			break
+		}
+		if !merged {
+			return changed
+		}
+		changed = true
+	}
+}
+
+// mergeBlock merges block into its unique predecessor pred.
+func mergeBlock(f *ir.Function, pred, block *ir.BasicBlock) {
+	for _, inst := range block.Insts {
+		if phi, ok := inst.(*ir.InstPhi); ok {
+			// Phi instructions of basic blocks with a single predecessor have a
+			// single incoming value.
+			replaceUses(f, phi, phi.Incs[0].X)
+			continue
+		}
+		inst.SetParent(pred)
+		pred.Insts = append(pred.Insts, inst)
+	}
+	// Update phi instructions of the successors of block.
+	for _, succ := range succs(block.Term) {
+		replacePred(succ, block, pred)
+	}
+	pred.SetTerm(block.Term)
+}
@@ -0,0 +1,268 @@
+package opt
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/metadata"
+	"github.com/llir/llvm/ir/value"
+)
+
+// operands returns pointers to the operands of the given instruction.
+func operands(inst ir.Instruction) []*value.Value {
+	switch inst := inst.(type) {
+	case *ir.InstAlloca:
+		return nil
+	case *ir.InstLoad:
+		return []*value.Value{&inst.Src}
+	case *ir.InstStore:
+		return []*value.Value{&inst.Src, &inst.Dst}
+	case *ir.InstGetElementPtr:
+		ops := []*value.Value{&inst.Src}
+		for i := range inst.Indices {
+			ops = append(ops, &inst.Indices[i])
+		}
+		return ops
+	case *ir.InstAdd:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstSub:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstMul:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstSDiv:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstSRem:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstAnd:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstOr:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstXor:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstShl:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstAShr:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstICmp:
+		return []*value.Value{&inst.X, &inst.Y}
+	case *ir.InstTrunc:
+		return []*value.Value{&inst.From}
+	case *ir.InstZExt:
+		return []*value.Value{&inst.From}
+	case *ir.InstSExt:
+		return []*value.Value{&inst.From}
+	case *ir.InstPhi:
+		var ops []*value.Value
+		for _, inc := range inst.Incs {
+			ops = append(ops, &inc.X)
+		}
+		return ops
+	case *ir.InstSelect:
+		return []*value.Value{&inst.Cond, &inst.X, &inst.Y}
+	case *ir.InstCall:
+		ops := []*value.Value{&inst.Callee}
+		for i := range inst.Args {
+			ops = append(ops, &inst.Args[i])
+		}
+		return ops
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+}
+
+// termOperands returns pointers to the value operands of the given terminator.
+func termOperands(term ir.Terminator) []*value.Value {
+	switch term := term.(type) {
+	case *ir.TermRet:
+		if term.X == nil {
+			return nil
+		}
+		return []*value.Value{&term.X}
+	case *ir.TermBr:
+		return nil
+	case *ir.TermCondBr:
+		return []*value.Value{&term.Cond}
+	case *ir.TermUnreachable:
+		return nil
+	default:
+		panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+	}
+}
+
+// setAttachments sets the metadata attachments of the given instruction or
+// terminator to a copy of md.
+func setAttachments(inst ir.Instruction, md map[string]*metadata.Metadata) {
+	attachments := make(map[string]*metadata.Metadata)
+	for name, node := range md {
+		attachments[name] = node
+	}
+	switch inst := inst.(type) {
+	// Instructions.
+	case *ir.InstAlloca:
+		inst.Metadata = attachments
+	case *ir.InstLoad:
+		inst.Metadata = attachments
+	case *ir.InstStore:
+		inst.Metadata = attachments
+	case *ir.InstGetElementPtr:
+		inst.Metadata = attachments
+	case *ir.InstAdd:
+		inst.Metadata = attachments
+	case *ir.InstSub:
+		inst.Metadata = attachments
+	case *ir.InstMul:
+		inst.Metadata = attachments
+	case *ir.InstSDiv:
+		inst.Metadata = attachments
+	case *ir.InstSRem:
+		inst.Metadata = attachments
+	case *ir.InstAnd:
+		inst.Metadata = attachments
+	case *ir.InstOr:
+		inst.Metadata = attachments
+	case *ir.InstXor:
+		inst.Metadata = attachments
+	case *ir.InstShl:
+		inst.Metadata = attachments
+	case *ir.InstAShr:
+		inst.Metadata = attachments
+	case *ir.InstICmp:
+		inst.Metadata = attachments
+	case *ir.InstTrunc:
+		inst.Metadata = attachments
+	case *ir.InstZExt:
+		inst.Metadata = attachments
+	case *ir.InstSExt:
+		inst.Metadata = attachments
+	case *ir.InstPhi:
+		inst.Metadata = attachments
+	case *ir.InstSelect:
+		inst.Metadata = attachments
+	case *ir.InstCall:
+		inst.Metadata = attachments
+	// Terminators.
+	case *ir.TermRet:
+		inst.Metadata = attachments
+	case *ir.TermBr:
+		inst.Metadata = attachments
+	case *ir.TermCondBr:
+		inst.Metadata = attachments
+	case *ir.TermUnreachable:
+		inst.Metadata = attachments
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+}
+
+// declaredVar returns the local variable described by the given call to
+// llvm.dbg.declare. The boolean return value indicates success.
+func declaredVar(inst ir.Instruction) (*ir.InstAlloca, bool) {
+	call, ok := inst.(*ir.InstCall)
+	if !ok {
+		return nil, false
+	}
+	if callee, ok := call.Callee.(*ir.Function); !ok || callee.Name != "llvm.dbg.declare" {
+		return nil, false
+	}
+	v, ok := call.Args[0].(*metadata.Value)
+	if !ok {
+		return nil, false
+	}
+	local, ok := v.X.(*ir.InstAlloca)
+	return local, ok
+}
+
+// succs returns the successor basic blocks of the given terminator.
+func succs(term ir.Terminator) []*ir.BasicBlock {
+	switch term := term.(type) {
+	case *ir.TermRet:
+		return nil
+	case *ir.TermBr:
+		return []*ir.BasicBlock{term.Target}
+	case *ir.TermCondBr:
+		return []*ir.BasicBlock{term.TargetTrue, term.TargetFalse}
+	case *ir.TermUnreachable:
+		return nil
+	default:
+		panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+	}
+}
+
+// preds returns a map from the basic blocks of f to their predecessors.
+func preds(f *ir.Function) map[*ir.BasicBlock][]*ir.BasicBlock {
+	m := make(map[*ir.BasicBlock][]*ir.BasicBlock)
+	for _, block := range f.Blocks {
+		for _, succ := range succs(block.Term) {
+			m[succ] = append(m[succ], block)
+		}
+	}
+	return m
+}
+
+// uses returns the number of uses of each value within f.
+func uses(f *ir.Function) map[value.Value]int {
+	m := make(map[value.Value]int)
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			for _, op := range operands(inst) {
+				m[*op]++
+			}
+		}
+		for _, op := range termOperands(block.Term) {
+			m[*op]++
+		}
+	}
+	return m
+}
+
+// replaceUses replaces all uses of old with v within f.
+func replaceUses(f *ir.Function, old, v value.Value) {
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			for _, op := range operands(inst) {
+				if *op == old {
+					*op = v
+				}
+			}
+		}
+		for _, op := range termOperands(block.Term) {
+			if *op == old {
+				*op = v
+			}
+		}
+	}
+}
+
+// removeIncoming removes the incoming values from pred of the phi instructions
+// of block.
+func removeIncoming(block, pred *ir.BasicBlock) {
+	for _, inst := range block.Insts {
+		phi, ok := inst.(*ir.InstPhi)
+		if !ok {
+			continue
+		}
+		var incs []*ir.Incoming
+		for _, inc := range phi.Incs {
+			if inc.Pred != pred {
+				incs = append(incs, inc)
+			}
+		}
+		phi.Incs = incs
+	}
+}
+
+// replacePred replaces the predecessor old with pred in the incoming values of
+// the phi instructions of block.
+func replacePred(block, old, pred *ir.BasicBlock) {
+	for _, inst := range block.Insts {
+		phi, ok := inst.(*ir.InstPhi)
+		if !ok {
+			continue
+		}
+		for _, inc := range phi.Incs {
+			if inc.Pred == old {
+				inc.Pred = pred
+			}
+		}
+	}
+}
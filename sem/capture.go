@@ -0,0 +1,128 @@
+package sem
+
+import (
+	"sort"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+)
+
+// captures performs capture analysis, mapping nested function definitions to
+// the local variables of enclosing functions which are referenced from within
+// the nested function, either directly or indirectly through calls to other
+// nested functions.
+func captures(file *ast.File, caps map[*ast.FuncDecl][]*ast.VarDecl) error {
+	// owners maps local variable declarations (including function parameters)
+	// to their enclosing function definition.
+	owners := make(map[*ast.VarDecl]*ast.FuncDecl)
+	// parents maps nested function definitions to their immediately enclosing
+	// function definition.
+	parents := make(map[*ast.FuncDecl]*ast.FuncDecl)
+	// uses maps function definitions to the local variables they reference.
+	uses := make(map[*ast.FuncDecl]map[*ast.VarDecl]bool)
+	// calls maps function definitions to the nested functions they call.
+	calls := make(map[*ast.FuncDecl]map[*ast.FuncDecl]bool)
+
+	// funcs is a stack of function definitions, where the top-most entry
+	// represents the currently active function.
+	var funcs []*ast.FuncDecl
+
+	// collect records local variable declarations, local variable uses and
+	// calls to nested functions.
+	collect := func(n ast.Node) error {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			if !astutil.IsDef(n) {
+				return nil
+			}
+			if len(funcs) > 0 {
+				parents[n] = funcs[len(funcs)-1]
+			}
+			funcs = append(funcs, n)
+			uses[n] = make(map[*ast.VarDecl]bool)
+			calls[n] = make(map[*ast.FuncDecl]bool)
+		case *ast.VarDecl:
+			if len(funcs) > 0 {
+				owners[n] = funcs[len(funcs)-1]
+			}
+		case *ast.Ident:
+			if len(funcs) == 0 {
+				return nil
+			}
+			cur := funcs[len(funcs)-1]
+			switch decl := n.Decl.(type) {
+			case *ast.VarDecl:
+				uses[cur][decl] = true
+			case *ast.FuncDecl:
+				calls[cur][decl] = true
+			}
+		}
+		return nil
+	}
+
+	// after pops function definitions from the stack.
+	after := func(n ast.Node) error {
+		if fn, ok := n.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+			funcs = funcs[:len(funcs)-1]
+		}
+		return nil
+	}
+
+	if err := astutil.WalkBeforeAfter(file, collect, after); err != nil {
+		return errutil.Err(err)
+	}
+
+	// encloses reports whether the function definition outer encloses (or is
+	// identical to) the function definition inner.
+	encloses := func(outer, inner *ast.FuncDecl) bool {
+		for fn := inner; fn != nil; fn = parents[fn] {
+			if fn == outer {
+				return true
+			}
+		}
+		return false
+	}
+
+	// free maps nested function definitions to their free local variables.
+	free := make(map[*ast.FuncDecl]map[*ast.VarDecl]bool)
+	for fn := range parents {
+		free[fn] = make(map[*ast.VarDecl]bool)
+		for v := range uses[fn] {
+			if owner, ok := owners[v]; ok && !encloses(fn, owner) {
+				free[fn][v] = true
+			}
+		}
+	}
+
+	// Propagate the free variables of called nested functions to the caller
+	// until a fixed point is reached.
+	for changed := true; changed; {
+		changed = false
+		for fn := range parents {
+			for callee := range calls[fn] {
+				for v := range free[callee] {
+					if free[fn][v] || encloses(fn, owners[v]) {
+						continue
+					}
+					free[fn][v] = true
+					changed = true
+				}
+			}
+		}
+	}
+
+	// Store the captured variables of each nested function, sorted by source
+	// position.
+	for fn, vars := range free {
+		var vs []*ast.VarDecl
+		for v := range vars {
+			vs = append(vs, v)
+		}
+		sort.Slice(vs, func(i, j int) bool {
+			return vs[i].VarName.Start() < vs[j].VarName.Start()
+		})
+		caps[fn] = vs
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+// Package constant implements compile-time evaluation of integer constant
+// expressions.
+package constant
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+// Eval evaluates the given integer constant expression, based on the types of
+// expressions in exprTypes. Operands are promoted to int, as by the integer
+// promotions; an error is reported if x is not a constant expression, or if the
+// evaluation overflows int or divides by zero.
+func Eval(x ast.Expr, exprTypes map[ast.Expr]types.Type) (int64, error) {
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		return evalBasicLit(x, exprTypes)
+	case *ast.BinaryExpr:
+		return evalBinaryExpr(x, exprTypes)
+	case *ast.CastExpr:
+		v, err := Eval(x.X, exprTypes)
+		if err != nil {
+			return 0, errutil.Err(err)
+		}
+		typ := typeOf(x, exprTypes)
+		if !types.IsInteger(typ) {
+			return 0, errors.Newf(x.Start(), "%q is not a constant expression", x)
+		}
+		// Explicit conversions truncate the value to the size of the target
+		// type.
+		return Convert(v, typ), nil
+	case *ast.CondExpr:
+		cond, err := Eval(x.Cond, exprTypes)
+		if err != nil {
+			return 0, errutil.Err(err)
+		}
+		// Only the selected operand is evaluated.
+		operand := x.Y
+		if cond != 0 {
+			operand = x.X
+		}
+		return Eval(operand, exprTypes)
+	case *ast.Ident:
+		if enumerator, ok := x.Decl.(*ast.Enumerator); ok {
+			return int64(enumerator.Val), nil
+		}
+		return 0, errors.Newf(x.Start(), "%q is not a constant expression", x)
+	case *ast.ParenExpr:
+		return Eval(x.X, exprTypes)
+	case *ast.SizeofExpr:
+		typ := SizeofType(x, exprTypes)
+		size := Sizeof(typ)
+		if t, ok := typ.(*types.Array); ok && t.Len == 0 {
+			// Array parameters may omit the array length.
+			return 0, errors.Newf(x.Sizeof, "invalid application of sizeof to array of unspecified length %q", typ)
+		}
+		if size == 0 {
+			return 0, errors.Newf(x.Sizeof, "invalid application of sizeof to type %q", typ)
+		}
+		return size, nil
+	case *ast.UnaryExpr:
+		v, err := Eval(x.X, exprTypes)
+		if err != nil {
+			return 0, errutil.Err(err)
+		}
+		switch x.Op {
+		case token.Sub:
+			return checkOverflow(x, x.OpPos, -v)
+		case token.Not:
+			return boolToInt(v == 0), nil
+		default:
+			panic(fmt.Sprintf("support for unary operator %v not yet implemented", x.Op))
+		}
+	default:
+		return 0, errors.Newf(x.Start(), "%q is not a constant expression", x)
+	}
+}
+
+// evalBasicLit evaluates the given basic literal.
+func evalBasicLit(x *ast.BasicLit, exprTypes map[ast.Expr]types.Type) (int64, error) {
+	switch x.Kind {
+	case token.CharLit:
+		s, err := strconv.Unquote(x.Val)
+		if err != nil {
+			return 0, errors.Newf(x.ValPos, "unable to unquote character literal; %v", err)
+		}
+		return int64(s[0]), nil
+	case token.IntLit:
+		v, err := strconv.ParseInt(x.Val, 10, 64)
+		if err != nil {
+			return 0, errors.Newf(x.ValPos, "integer constant %v is too large", x)
+		}
+		return checkOverflow(x, x.ValPos, v)
+	default:
+		panic(fmt.Sprintf("support for basic literal kind %v not yet implemented", x.Kind))
+	}
+}
+
+// evalBinaryExpr evaluates the given binary expression.
+func evalBinaryExpr(x *ast.BinaryExpr, exprTypes map[ast.Expr]types.Type) (int64, error) {
+	if x.Op == token.Assign || x.Op == token.Comma {
+		// NOTE: "Constant expressions shall not contain assignment, increment,
+		// decrement, function-call, or comma operators" (see §6.6.3)
+		return 0, errors.Newf(x.Start(), "%q is not a constant expression", x)
+	}
+	a, err := Eval(x.X, exprTypes)
+	if err != nil {
+		return 0, errutil.Err(err)
+	}
+	if x.Op == token.Land && a == 0 {
+		// The second operand of a logical AND expression is not evaluated if the
+		// first operand compares equal to 0.
+		return 0, nil
+	}
+	b, err := Eval(x.Y, exprTypes)
+	if err != nil {
+		return 0, errutil.Err(err)
+	}
+	switch x.Op {
+	case token.Add:
+		return checkOverflow(x, x.OpPos, a+b)
+	case token.Sub:
+		return checkOverflow(x, x.OpPos, a-b)
+	case token.Mul:
+		return checkOverflow(x, x.OpPos, a*b)
+	case token.Div:
+		if b == 0 {
+			return 0, errors.Newf(x.OpPos, "division by zero in constant expression %q", x)
+		}
+		return checkOverflow(x, x.OpPos, a/b)
+	case token.Lt:
+		return boolToInt(a < b), nil
+	case token.Gt:
+		return boolToInt(a > b), nil
+	case token.Le:
+		return boolToInt(a <= b), nil
+	case token.Ge:
+		return boolToInt(a >= b), nil
+	case token.Ne:
+		return boolToInt(a != b), nil
+	case token.Eq:
+		return boolToInt(a == b), nil
+	case token.Land:
+		return boolToInt(b != 0), nil
+	default:
+		panic(fmt.Sprintf("support for binary operator %v not yet implemented", x.Op))
+	}
+}
+
+// checkOverflow reports an error at the given position if the value v of the
+// expression x cannot be represented by int; the type of arithmetic on promoted
+// operands.
+func checkOverflow(x ast.Expr, pos int, v int64) (int64, error) {
+	typ := &types.Basic{Kind: types.Int}
+	if Convert(v, typ) != v {
+		return 0, errors.Newf(pos, "integer overflow in constant expression %q of type %q", x, typ)
+	}
+	return v, nil
+}
+
+// typeOf returns the type of the given expression.
+func typeOf(x ast.Expr, exprTypes map[ast.Expr]types.Type) types.Type {
+	if typ, ok := exprTypes[x]; ok {
+		return typ
+	}
+	panic(fmt.Sprintf("unable to locate type of expression %v", x))
+}
+
+// Convert converts the given integer value to the specified type, truncating
+// the value to the size of the type.
+func Convert(v int64, typ types.Type) int64 {
+	switch Sizeof(typ) {
+	case 1:
+		return int64(int8(v))
+	case 4:
+		return int64(int32(v))
+	default:
+		return v
+	}
+}
+
+// SizeofType returns the type of the operand of the given sizeof expression,
+// which is either a parenthesized type name or an expression.
+func SizeofType(x *ast.SizeofExpr, exprTypes map[ast.Expr]types.Type) types.Type {
+	if paren, ok := x.X.(*ast.ParenExpr); ok {
+		if ident, ok := paren.X.(*ast.Ident); ok {
+			if def, ok := ident.Decl.(*ast.TypeDef); ok {
+				return def.Type()
+			}
+		}
+	}
+	return typeOf(x.X, exprTypes)
+}
+
+// Sizeof returns the size in bytes of the given type; or 0 if the size of the
+// type is unknown (e.g. void, functions and arrays of unspecified length).
+func Sizeof(typ types.Type) int64 {
+	switch typ := typ.(type) {
+	case *types.Basic:
+		switch typ.Kind {
+		case types.Char:
+			return 1
+		case types.Int:
+			// TODO: Get int width from compile env.
+			return 4
+		}
+	case *types.Array:
+		return int64(typ.Len) * Sizeof(typ.Elem)
+	}
+	return 0
+}
+
+// boolToInt returns 1 if b is true, and 0 otherwise.
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -1,4 +1,5 @@
-// Package errors provides pretty-printing of semantic analysis errors.
+// Package errors provides pretty-printing of semantic analysis errors and
+// warnings.
 package errors
 
 import (
@@ -12,7 +13,7 @@ import (
 // UseColor indicates if error messages should use colors.
 var UseColor = true
 
-// An Error represents a semantic analysis error.
+// An Error represents a semantic analysis error or warning.
 type Error struct {
 	// Input source position (in bytes).
 	Pos int
@@ -20,6 +21,9 @@ type Error struct {
 	Text string
 	// Input source.
 	Src *Source
+	// Warning reports whether the error is a warning, which does not prevent
+	// compilation.
+	Warning bool
 }
 
 // New returns a new error based on the given positional information (offset in
@@ -42,19 +46,39 @@ func Newf(pos int, format string, a ...interface{}) *Error {
 	return err
 }
 
+// Warnf returns a new formatted warning based on the given positional
+// information (offset in bytes).
+func Warnf(pos int, format string, a ...interface{}) *Error {
+	err := &Error{
+		Pos:     pos,
+		Text:    fmt.Sprintf(format, a...),
+		Warning: true,
+	}
+	return err
+}
+
 // Error returns an error string with position information.
 //
 // The error format is as follows.
 //
 //    (file:line:column): error: text
+//
+// Warnings are prefixed by "warning:" instead of "error:".
 func (e *Error) Error() string {
 	// Use colors.
 	pos := fmt.Sprintf("(byte offset %d)", e.Pos)
 	prefix := "error:"
+	if e.Warning {
+		prefix = "warning:"
+	}
 	text := e.Text
 	if UseColor {
 		pos = term.Color(pos, term.Bold)
-		prefix = term.RedBold(prefix)
+		if e.Warning {
+			prefix = term.MagentaBold(prefix)
+		} else {
+			prefix = term.RedBold(prefix)
+		}
 		text = term.Color(text, term.Bold)
 	}
 	src := e.Src
@@ -118,9 +142,10 @@ func NewSource(path, input string) *Source {
 	for i := 0; i < len(input); {
 		src.Lines = append(src.Lines, i)
 		pos := strings.IndexRune(input[i:], '\n')
-		if pos != -1 {
-			i += pos + 1
+		if pos == -1 {
+			break
 		}
+		i += pos + 1
 	}
 	return src
 }
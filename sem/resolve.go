@@ -45,7 +45,10 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 	fileScope.IsDef = func(decl ast.Decl) bool {
 		// Consider variable declarations as tentative definitions; i.e. return
 		// false, unless variable definition.
-		return decl.Value() != nil
+		if _, ok := decl.(*ast.VarDecl); ok {
+			return decl.Value() != nil
+		}
+		return astutil.IsDef(decl)
 	}
 	for _, decl := range file.Decls {
 		if err := fileScope.Insert(decl); err != nil {
@@ -68,8 +71,12 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 		switch n := n.(type) {
 		case ast.Decl:
 			// Insert declaration into the scope if not already added by the
-			// file scope pre-pass.
-			if scope != fileScope {
+			// file scope pre-pass. Enumerators are inserted when reached, so
+			// that enumeration constants may only be referenced after their
+			// declaration, as their values are required by constant
+			// expressions.
+			_, isEnumerator := n.(*ast.Enumerator)
+			if scope != fileScope || isEnumerator {
 				if err := scope.Insert(n); err != nil {
 					return errutil.Err(err)
 				}
@@ -88,6 +95,12 @@ func resolve(file *ast.File, scopes map[ast.Node]*Scope) error {
 				scopes[n] = scope
 			}
 			skip = false
+		case *ast.EnumType:
+			decl, ok := scope.LookupTag(n.Tag.Name)
+			if !ok {
+				return errors.Newf(n.Tag.Start(), "undeclared enumeration tag %q", n.Tag)
+			}
+			n.Tag.Decl = decl
 		case *ast.Ident:
 			decl, ok := scope.Lookup(n.Name)
 			if !ok {
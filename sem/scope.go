@@ -14,6 +14,9 @@ type Scope struct {
 	Outer *Scope
 	// Identifiers declared within the current scope.
 	Decls map[string]ast.Decl
+	// Enumeration tags declared within the current scope. Tags reside in a
+	// separate name space from ordinary identifiers.
+	Tags map[string]*ast.EnumDecl
 	// IsDef reports whether the given declaration is a definition.
 	IsDef func(ast.Decl) bool
 }
@@ -24,12 +27,17 @@ func NewScope(outer *Scope) *Scope {
 	return &Scope{
 		Outer: outer,
 		Decls: make(map[string]ast.Decl),
+		Tags:  make(map[string]*ast.EnumDecl),
 		IsDef: astutil.IsDef,
 	}
 }
 
 // Insert inserts the given declaration into the current scope.
 func (s *Scope) Insert(decl ast.Decl) error {
+	if decl, ok := decl.(*ast.EnumDecl); ok {
+		return s.insertTag(decl)
+	}
+
 	// Early return for first-time declarations.
 	ident := decl.Name()
 	if ident == nil {
@@ -86,3 +94,37 @@ func (s *Scope) Lookup(name string) (ast.Decl, bool) {
 	}
 	return s.Outer.Lookup(name)
 }
+
+// insertTag inserts the tag of the given enumeration declaration into the
+// current scope.
+func (s *Scope) insertTag(decl *ast.EnumDecl) error {
+	tag := decl.Tag
+	if tag == nil {
+		// Anonymous enumeration declaration.
+		return nil
+	}
+	name := tag.String()
+	prev, ok := s.Tags[name]
+	if !ok {
+		s.Tags[name] = decl
+		return nil
+	}
+	if prev == decl {
+		// Tag already added to scope.
+		return nil
+	}
+	return errors.Newf(tag.Start(), "redefinition of enumeration tag %q", name)
+}
+
+// LookupTag returns the enumeration declaration of the tag name in the
+// innermost scope of s. The returned boolean variable reports whether a
+// declaration of the tag was located.
+func (s *Scope) LookupTag(name string) (*ast.EnumDecl, bool) {
+	if decl, ok := s.Tags[name]; ok {
+		return decl, true
+	}
+	if s.Outer == nil {
+		return nil, false
+	}
+	return s.Outer.LookupTag(name)
+}
@@ -4,13 +4,28 @@ package sem
 import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/sem/semcheck"
 	"github.com/mewmew/uc/sem/typecheck"
 	"github.com/mewmew/uc/types"
 )
 
-// Check performs a static semantic analysis check on the given file.
+// Config specifies the options of semantic analysis.
+type Config struct {
+	// NoNestedFunctions specifies whether to reject nested function
+	// definitions; which are otherwise supported as a GCC extension.
+	NoNestedFunctions bool
+}
+
+// Check performs a static semantic analysis check on the given file, using the
+// default options.
 func Check(file *ast.File) (*Info, error) {
+	return CheckWith(file, Config{})
+}
+
+// CheckWith performs a static semantic analysis check on the given file, as
+// specified by config.
+func CheckWith(file *ast.File, config Config) (*Info, error) {
 	// Semantic analysis is done in two passes to allow for forward references.
 	// Firstly, the global declarations are added to the file-scope. Secondly,
 	// the global function declaration bodies are traversed to resolve
@@ -18,23 +33,35 @@ func Check(file *ast.File) (*Info, error) {
 
 	// Identifier resolution.
 	info := &Info{
-		Types:  make(map[ast.Expr]types.Type),
-		Scopes: make(map[ast.Node]*Scope),
+		Types:       make(map[ast.Expr]types.Type),
+		Conversions: make(map[ast.Expr]types.Type),
+		Scopes:      make(map[ast.Node]*Scope),
+		Captures:    make(map[*ast.FuncDecl][]*ast.VarDecl),
 	}
 	if err := resolve(file, info.Scopes); err != nil {
 		return nil, errutil.Err(err)
 	}
 
 	// Type-checking.
-	if err := typecheck.Check(file, info.Types); err != nil {
+	if err := typecheck.Check(file, info.Types, info.Conversions); err != nil {
 		return nil, errutil.Err(err)
 	}
 
 	// Semantic analysis.
-	if err := semcheck.Check(file); err != nil {
+	if err := semcheck.Check(file, config.NoNestedFunctions); err != nil {
+		return nil, errutil.Err(err)
+	}
+
+	// Capture analysis of nested functions.
+	if err := captures(file, info.Captures); err != nil {
 		return nil, errutil.Err(err)
 	}
 
+	// Definite-assignment analysis of local variables.
+	for _, ident := range Uninitialized(file, info) {
+		info.Warnings = append(info.Warnings, errors.Warnf(ident.Start(), "variable %q may be used uninitialized", ident))
+	}
+
 	return info, nil
 }
 
@@ -44,6 +71,12 @@ func Check(file *ast.File) (*Info, error) {
 type Info struct {
 	// Types maps expression nodes to types.
 	Types map[ast.Expr]types.Type
+	// Conversions maps expression nodes to the types they are implicitly
+	// converted to; e.g. the operands of binary expressions, the right-hand side
+	// of assignments, call arguments and return results.
+	//
+	// Explicit conversions are represented by *ast.CastExpr nodes.
+	Conversions map[ast.Expr]types.Type
 	// Scopes maps nodes to the scope they define.
 	//
 	// The following nodes define scopes.
@@ -52,4 +85,11 @@ type Info struct {
 	//    *ast.FuncDecl
 	//    *ast.BlockStmt
 	Scopes map[ast.Node]*Scope
+	// Captures maps nested function definitions to the local variables of
+	// enclosing functions referenced from within the nested function, sorted by
+	// source position.
+	Captures map[*ast.FuncDecl][]*ast.VarDecl
+	// Warnings holds the warnings of semantic analysis, sorted by source
+	// position; e.g. uses of uninitialized local variables.
+	Warnings []*errors.Error
 }
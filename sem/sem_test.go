@@ -2,6 +2,7 @@ package sem_test
 
 import (
 	"io/ioutil"
+	"reflect"
 	"testing"
 
 	"github.com/mewkiz/pkg/errutil"
@@ -22,9 +23,15 @@ func TestCheckValid(t *testing.T) {
 		{path: "../testdata/quiet/semantic/s05.c"},
 		{path: "../testdata/quiet/semantic/s06.c"},
 		{path: "../testdata/extra/semantic/missing-return-main.c"},
+		{path: "../testdata/extra/semantic/missing-return-loop.c"},
 		{path: "../testdata/extra/semantic/tentative-var-def.c"},
 		{path: "../testdata/extra/semantic/variable-sized-array-arg.c"},
 		{path: "../testdata/extra/semantic/nested-function-def.c"},
+		{path: "../testdata/extra/semantic/nested-function-capture.c"},
+		{path: "../testdata/extra/semantic/sizeof-expr.c"},
+		{path: "../testdata/extra/semantic/enum.c"},
+		{path: "../testdata/extra/semantic/cond-expr.c"},
+		{path: "../testdata/extra/semantic/cast-expr.c"},
 	}
 
 	errors.UseColor = false
@@ -63,14 +70,22 @@ func TestCheckValid(t *testing.T) {
 
 func TestCheckError(t *testing.T) {
 	var golden = []struct {
-		path string
-		want string
+		path   string
+		config sem.Config
+		want   string
 	}{
 		{
 			path: "../testdata/quiet/semantic/s02.c",
 			want: `(../testdata/quiet/semantic/s02.c:3) error: missing return at end of non-void function "foo"
   ; }
     ^`,
+		},
+		{
+			path:   "../testdata/extra/semantic/nested-function-def.c",
+			config: sem.Config{NoNestedFunctions: true},
+			want: `(../testdata/extra/semantic/nested-function-def.c:5) error: nested functions not allowed
+ void f(void){
+      ^`,
 		},
 		{
 			path: "../testdata/incorrect/semantic/se01.c",
@@ -278,6 +293,60 @@ void a(void);   // Attempt to redefine  'a' as extern
 		},
 
 		// Extra test cases.
+		{
+			path: "../testdata/extra/semantic/cast-non-type.c",
+			want: `(../testdata/extra/semantic/cast-non-type.c:6) error: "x" is not a type
+ return (x)!x;
+         ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/comma-non-constant.c",
+			want: `(../testdata/extra/semantic/comma-non-constant.c:4) error: "1, 2" is not a constant expression
+int a[(1, 2)];
+       ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/cond-type-mismatch.c",
+			want: `(../testdata/extra/semantic/cond-type-mismatch.c:8) error: invalid operation: x ? a : 0 (type mismatch between "int[10]" and "int")
+ return x ? a : 0;
+              ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/const-div-zero.c",
+			want: `(../testdata/extra/semantic/const-div-zero.c:5) error: division by zero in constant expression "10 / (2 - 2)"
+ int a[10 / (2 - 2)];
+          ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/const-overflow.c",
+			want: `(../testdata/extra/semantic/const-overflow.c:4) error: integer overflow in constant expression "2147483647 + 1" of type "int"
+int x = 2147483647 + 1;
+                   ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/enum-assign.c",
+			want: `(../testdata/extra/semantic/enum-assign.c:7) error: cannot assign to "RED" of type "int"
+ RED = 2;
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/enum-overflow.c",
+			want: `(../testdata/extra/semantic/enum-overflow.c:4) error: value 2147483648 of enumerator "B" overflows type "int"
+enum { A = 2147483647, B };
+                       ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/enum-tag-redef.c",
+			want: `(../testdata/extra/semantic/enum-tag-redef.c:5) error: redefinition of enumeration tag "color"
+enum color { GREEN };
+     ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/enum-tag-undeclared.c",
+			want: `(../testdata/extra/semantic/enum-tag-undeclared.c:6) error: undeclared enumeration tag "shape"
+enum shape x;
+     ^`,
+		},
 		{
 			path: "../testdata/extra/semantic/extra-void-arg.c",
 			want: `(../testdata/extra/semantic/extra-void-arg.c:4) error: "void" must be the only parameter
@@ -295,6 +364,12 @@ void f(int a, void) {
 			want: `(../testdata/extra/semantic/index-array.c:7) error: invalid array index; expected integer, got "int[20]"
  x[y];
    ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/invalid-cast.c",
+			want: `(../testdata/extra/semantic/invalid-cast.c:6) error: invalid cast from "int[10]" to "int"
+ return (int)a;
+        ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/local-var-redef.c",
@@ -307,12 +382,30 @@ void f(int a, void) {
 			want: `(../testdata/extra/semantic/missing-return.c:10) error: missing return at end of non-void function "f"
 }
 ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/non-constant-init.c",
+			want: `(../testdata/extra/semantic/non-constant-init.c:8) error: "f()" is not a constant expression
+int x = 1 + f();
+            ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/param-redef.c",
 			want: `(../testdata/extra/semantic/param-redef.c:5) error: redefinition of "x"
  int x;
      ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/sizeof-array-param.c",
+			want: `(../testdata/extra/semantic/sizeof-array-param.c:5) error: invalid application of sizeof to array of unspecified length "char[]"
+ return sizeof s;
+        ^`,
+		},
+		{
+			path: "../testdata/extra/semantic/sizeof-void.c",
+			want: `(../testdata/extra/semantic/sizeof-void.c:5) error: invalid application of sizeof to type "void"
+ return sizeof(void);
+        ^`,
 		},
 		{
 			path: "../testdata/extra/semantic/unnamed-arg.c",
@@ -379,7 +472,7 @@ void f(void, void) {
 		f := file.(*ast.File)
 
 		got := ""
-		if _, err := sem.Check(f); err != nil {
+		if _, err := sem.CheckWith(f, g.config); err != nil {
 			if e, ok := err.(*errutil.ErrInfo); ok {
 				// Unwrap errutil error.
 				err = e.Err
@@ -396,4 +489,62 @@ void f(void, void) {
 	}
 }
 
+func TestCheckWarnings(t *testing.T) {
+	var golden = []struct {
+		path string
+		want []string
+	}{
+		{
+			path: "../testdata/extra/semantic/uninit-var.c",
+			want: []string{
+				`(../testdata/extra/semantic/uninit-var.c:21) warning: variable "y" may be used uninitialized
+ return x + y + z;
+            ^`,
+				`(../testdata/extra/semantic/uninit-var.c:21) warning: variable "z" may be used uninitialized
+ return x + y + z;
+                ^`,
+			},
+		},
+		{
+			path: "../testdata/extra/semantic/missing-return-loop.c",
+			want: nil,
+		},
+	}
+
+	errors.UseColor = false
+
+	for _, g := range golden {
+		buf, err := ioutil.ReadFile(g.path)
+		if err != nil {
+			t.Errorf("%q: %v", g.path, err)
+			continue
+		}
+		input := string(buf)
+		s := scanner.NewFromString(input)
+		src := errors.NewSource(g.path, input)
+
+		p := parser.NewParser()
+		file, err := p.Parse(s)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		f := file.(*ast.File)
+
+		info, err := sem.Check(f)
+		if err != nil {
+			t.Errorf("%q: unexpected error: `%v`", g.path, err)
+			continue
+		}
+		var got []string
+		for _, warning := range info.Warnings {
+			warning.Src = src
+			got = append(got, warning.Error())
+		}
+		if !reflect.DeepEqual(got, g.want) {
+			t.Errorf("%q: warnings mismatch; expected %q, got %q", g.path, g.want, got)
+		}
+	}
+}
+
 // TODO: add benchmark
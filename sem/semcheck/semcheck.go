@@ -8,16 +8,15 @@ import (
 	"github.com/mewmew/uc/sem/errors"
 )
 
-// NoNestedFunctions disables the checking for nested functions
-var NoNestedFunctions = false
-
-// Check performs static semantic analysis on the given file.
-func Check(file *ast.File) error {
+// Check performs static semantic analysis on the given file. Nested function
+// definitions, which are otherwise supported as a GCC extension, are rejected if
+// noNestedFunctions is set.
+func Check(file *ast.File, noNestedFunctions bool) error {
 	for _, decl := range file.Decls {
 		switch decl := decl.(type) {
 		case *ast.FuncDecl:
 			// Check for nested functions.
-			if NoNestedFunctions {
+			if noNestedFunctions {
 				if err := checkNestedFunctions(decl); err != nil {
 					return errutil.Err(err)
 				}
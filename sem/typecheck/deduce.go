@@ -6,22 +6,66 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/sem/constant"
 	"github.com/mewmew/uc/sem/errors"
 	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
 // deduce performs type deduction of expressions, and store the result in
-// exprTypes.
+// exprTypes. The lengths of array types are evaluated from their array length
+// expressions, and the values of enumeration constants from their value
+// expressions.
 func deduce(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 	// deduce performs type deduction of the given expression.
 	deduce := func(n ast.Node) error {
-		if expr, ok := n.(ast.Expr); ok {
-			typ, err := typeOf(expr)
+		switch n := n.(type) {
+		case ast.Expr:
+			typ, err := typeOf(n)
 			if err != nil {
 				return errutil.Err(err)
 			}
-			exprTypes[expr] = typ
+			exprTypes[n] = typ
+		case *ast.ArrayType:
+			// The array length expression has already been visited, as the AST
+			// is traversed bottom-up.
+			if n.LenExpr == nil {
+				return nil
+			}
+			if !types.IsInteger(exprTypes[n.LenExpr]) {
+				return errors.Newf(n.LenExpr.Start(), "invalid array length; expected integer, got %q", exprTypes[n.LenExpr])
+			}
+			len, err := constant.Eval(n.LenExpr, exprTypes)
+			if err != nil {
+				return errutil.Err(err)
+			}
+			if len <= 0 {
+				return errors.Newf(n.LenExpr.Start(), "invalid array length %d; expected positive", len)
+			}
+			n.Len = int(len)
+		case *ast.EnumDecl:
+			// The value of an enumerator without value expression is one
+			// greater than the value of the preceding enumerator (or 0 if
+			// first).
+			intType := &types.Basic{Kind: types.Int}
+			var next int64
+			for _, enumerator := range n.Enumerators {
+				if x := enumerator.ValExpr; x != nil {
+					if !types.IsInteger(exprTypes[x]) {
+						return errors.Newf(x.Start(), "invalid value of enumerator %q; expected integer, got %q", enumerator.ConstName, exprTypes[x])
+					}
+					v, err := constant.Eval(x, exprTypes)
+					if err != nil {
+						return errutil.Err(err)
+					}
+					next = v
+				}
+				if constant.Convert(next, intType) != next {
+					return errors.Newf(enumerator.Start(), "value %d of enumerator %q overflows type %q", next, enumerator.ConstName, intType)
+				}
+				enumerator.Val = int(next)
+				next++
+			}
 		}
 		return nil
 	}
@@ -59,6 +103,12 @@ func typeOf(n ast.Expr) (types.Type, error) {
 		if err != nil {
 			return nil, errutil.Err(err)
 		}
+		if n.Op == token.Comma {
+			// "The left operand of a comma operator is evaluated as a void
+			// expression [...] the result has its type and value." [C99 draft
+			// 6.5.17.2]
+			return yType, nil
+		}
 		if n.Op == token.Assign {
 			if !isAssignable(n.X) {
 				return nil, errors.Newf(n.OpPos, "cannot assign to %q of type %q", n.X, xType)
@@ -88,6 +138,40 @@ func typeOf(n ast.Expr) (types.Type, error) {
 			return typ.Result, nil
 		}
 		return nil, errors.Newf(n.Lparen, "cannot call non-function %q of type %q", n.Name, typ)
+	case *ast.CastExpr:
+		ident, ok := n.Type.(*ast.Ident)
+		if !ok {
+			panic(fmt.Sprintf("support for cast type %T not yet implemented", n.Type))
+		}
+		if _, ok := ident.Decl.(*ast.TypeDef); !ok {
+			return nil, errors.Newf(ident.Start(), "%q is not a type", ident)
+		}
+		return ident.Decl.Type(), nil
+	case *ast.CondExpr:
+		condType, err := typeOf(n.Cond)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		if !isNumerical(condType) {
+			return nil, errors.Newf(n.Cond.Start(), "invalid operation: %v (non-numerical condition type %q)", n, condType)
+		}
+		xType, err := typeOf(n.X)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		yType, err := typeOf(n.Y)
+		if err != nil {
+			return nil, errutil.Err(err)
+		}
+		// See [C99 draft 6.5.15.3 and 6.5.15.5]
+		switch {
+		case types.IsVoid(xType) && types.IsVoid(yType):
+			return xType, nil
+		case isNumerical(xType) && isNumerical(yType):
+			return higherPrecision(xType, yType), nil
+		default:
+			return nil, errors.Newf(n.Colon, "invalid operation: %v (type mismatch between %q and %q)", n, xType, yType)
+		}
 	case *ast.Ident:
 		return n.Decl.Type(), nil
 	case *ast.IndexExpr:
@@ -98,6 +182,10 @@ func typeOf(n ast.Expr) (types.Type, error) {
 		return nil, errors.Newf(n.Lbracket, "invalid operation: %v (type %q does not support indexing)", n, typ)
 	case *ast.ParenExpr:
 		return typeOf(n.X)
+	case *ast.SizeofExpr:
+		// TODO: Use an unsigned integer type for the result of sizeof, when
+		// support for unsigned types is added.
+		return &types.Basic{Kind: types.Int}, nil
 	case *ast.UnaryExpr:
 		// TODO: Add support for pointers.
 		return typeOf(n.X)
@@ -119,7 +207,15 @@ func isAssignable(x ast.Expr) bool {
 		return false
 	case *ast.CallExpr:
 		return false
+	case *ast.CastExpr:
+		return false
+	case *ast.CondExpr:
+		return false
 	case *ast.Ident:
+		if _, ok := x.Decl.(*ast.Enumerator); ok {
+			// Enumeration constants are not lvalues.
+			return false
+		}
 		switch typ := x.Decl.Type().(type) {
 		case *types.Basic:
 			return true
@@ -134,6 +230,8 @@ func isAssignable(x ast.Expr) bool {
 		return true
 	case *ast.ParenExpr:
 		return isAssignable(x.X)
+	case *ast.SizeofExpr:
+		return false
 	case *ast.UnaryExpr:
 		// TODO: Add support for pointer dereferences.
 		return false
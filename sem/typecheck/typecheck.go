@@ -7,20 +7,25 @@ import (
 	"github.com/mewkiz/pkg/errutil"
 	"github.com/mewmew/uc/ast"
 	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/cfg"
+	"github.com/mewmew/uc/sem/constant"
 	"github.com/mewmew/uc/sem/errors"
+	"github.com/mewmew/uc/token"
 	"github.com/mewmew/uc/types"
 )
 
 // Check type-checks the given file, and store a mapping from expression nodes
-// to types in exprTypes.
-func Check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
+// to types in exprTypes. Implicit conversions of expression nodes are recorded
+// in conversions, which maps from expression nodes to the type they are
+// converted to.
+func Check(file *ast.File, exprTypes, conversions map[ast.Expr]types.Type) error {
 	// Deduce the types of expressions.
 	if err := deduce(file, exprTypes); err != nil {
 		return errutil.Err(err)
 	}
 
 	// Type-check file.
-	if err := check(file, exprTypes); err != nil {
+	if err := check(file, exprTypes, conversions); err != nil {
 		return errutil.Err(err)
 	}
 
@@ -28,11 +33,25 @@ func Check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 }
 
 // check type-checks the given file.
-func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
+func check(file *ast.File, exprTypes, conversions map[ast.Expr]types.Type) error {
 	// funcs is a stack of function declarations, where the top-most entry
 	// represents the currently active function.
 	var funcs []*types.Func
 
+	// convert records the implicit conversion of x to the given type. Only
+	// conversions between distinct numerical types are recorded, as array
+	// arguments are passed by reference.
+	convert := func(x ast.Expr, to types.Type) {
+		from := exprTypes[x]
+		if types.Equal(from, to) {
+			return
+		}
+		if !isNumerical(from) || !isNumerical(to) {
+			return
+		}
+		conversions[x] = to
+	}
+
 	// check type-checks the given node.
 	check := func(n ast.Node) error {
 		switch n := n.(type) {
@@ -68,6 +87,20 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 					return errors.Newf(n.VarName.NamePos, `invalid element type "void" of array %q`, n.VarName)
 				}
 			}
+			if n.Val != nil {
+				// Verify variable initializer.
+				valType := exprTypes[n.Val]
+				if !isCompatible(typ, valType) {
+					return errors.Newf(n.Val.Start(), "cannot initialize %q of type %q with value of type %q", n.VarName, typ, valType)
+				}
+				convert(n.Val, typ)
+				// Initializers of global variables must be constant expressions.
+				if len(funcs) == 0 {
+					if _, err := constant.Eval(n.Val, exprTypes); err != nil {
+						return errutil.Err(err)
+					}
+				}
+			}
 		case *ast.FuncDecl:
 			if astutil.IsDef(n) {
 				// push function declaration.
@@ -81,48 +114,10 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 					}
 				}
 
-				// Verify that non-void functions end with return statement.
+				// Verify that control does not reach the end of non-void functions
+				// without a return statement.
 				if !types.IsVoid(n.Type().(*types.Func).Result) {
-					// endsWithReturn reports whether the given block item ends with
-					// a return statement. The following two terminating statements
-					// are supported, recursively.
-					//
-					//    return;
-					//
-					//    if (cond) {
-					//       return;
-					//    } else {
-					//       return;
-					//    }
-					var endsWithReturn func(ast.Node) bool
-					endsWithReturn = func(node ast.Node) bool {
-						last := node
-						if block, ok := node.(*ast.BlockStmt); ok {
-							items := block.Items
-							if len(items) == 0 {
-								// node ends without a return statement.
-								return false
-							}
-							last = items[len(items)-1]
-						}
-						switch last := last.(type) {
-						case *ast.ReturnStmt:
-							// node ends with a return statement.
-							return true
-						case *ast.IfStmt:
-							if last.Else == nil {
-								// node may ends without a return statement if the else-
-								// branch is invoked.
-								return false
-							}
-							return endsWithReturn(last.Body) && endsWithReturn(last.Else)
-						default:
-							// node may end without return statement.
-							return false
-						}
-					}
-
-					missing := !endsWithReturn(n.Body)
+					missing := cfg.New(n, exprTypes).ReachesEnd()
 					// Missing return statements are valid from the main function.
 					//
 					// NOTE: "reaching the } that terminates the main function
@@ -147,6 +142,41 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 				}
 				return errors.Newf(resultPos, "returning %q from a function with incompatible result type %q", resultType, curFunc.Result)
 			}
+			if n.Result != nil {
+				convert(n.Result, curFunc.Result)
+			}
+		case *ast.BinaryExpr:
+			switch n.Op {
+			case token.Land:
+				// The operands of logical expressions are compared against zero,
+				// and are thus not converted.
+			case token.Comma:
+				// The value of the first operand is discarded, and the result
+				// has the type of the second operand.
+			case token.Assign:
+				convert(n.Y, exprTypes[n.X])
+			default:
+				// Usual arithmetic conversions.
+				typ := higherPrecision(exprTypes[n.X], exprTypes[n.Y])
+				convert(n.X, typ)
+				convert(n.Y, typ)
+			}
+		case *ast.CastExpr:
+			// Verify that the operand may be converted to the target type.
+			fromType, toType := exprTypes[n.X], exprTypes[n]
+			if types.IsVoid(toType) {
+				// Any expression may be cast to void to discard its value.
+				return nil
+			}
+			if !isNumerical(fromType) || !isNumerical(toType) {
+				return errors.Newf(n.Lparen, "invalid cast from %q to %q", fromType, toType)
+			}
+		case *ast.CondExpr:
+			// Convert both operands to the type of the conditional expression.
+			if typ := exprTypes[n]; !types.IsVoid(typ) {
+				convert(n.X, typ)
+				convert(n.Y, typ)
+			}
 		case *ast.CallExpr:
 			funcType, ok := n.Name.Decl.Type().(*types.Func)
 			if !ok {
@@ -176,6 +206,7 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 				if !isCompatibleArg(argType, paramType) {
 					return errors.Newf(arg.Start(), "calling %q with incompatible argument type %q to parameter of type %q", n.Name, argType, paramType)
 				}
+				convert(arg, paramType)
 			}
 		case *ast.FuncType:
 			for _, param := range n.Params {
@@ -184,6 +215,11 @@ func check(file *ast.File, exprTypes map[ast.Expr]types.Type) error {
 					return errors.Newf(n.Lparen, `"void" must be the only parameter`)
 				}
 			}
+		case *ast.SizeofExpr:
+			// Verify that the size of the operand type is known.
+			if _, err := constant.Eval(n, exprTypes); err != nil {
+				return errutil.Err(err)
+			}
 		case *ast.IndexExpr:
 			indexType, ok := exprTypes[n.Index]
 			if !ok {
@@ -238,7 +274,17 @@ func isCompatibleArg(arg, param types.Type) bool {
 	return false
 }
 
-// isCompatible reports whether t and u are of compatible types.
+// isNumerical reports whether the given type is numerical.
+func isNumerical(t types.Type) bool {
+	if t, ok := t.(types.Numerical); ok {
+		return t.IsNumerical()
+	}
+	return false
+}
+
+// isCompatible reports whether t and u are of compatible types. Any pair of
+// numerical types is compatible, as values are implicitly converted between
+// numerical types; see Info.Conversions of package sem.
 func isCompatible(t, u types.Type) bool {
 	if types.Equal(t, u) {
 		return true
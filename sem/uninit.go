@@ -0,0 +1,233 @@
+package sem
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/ast/astutil"
+	"github.com/mewmew/uc/cfg"
+	"github.com/mewmew/uc/token"
+	"github.com/mewmew/uc/types"
+)
+
+// Uninitialized returns the uses of local variables which may be used
+// uninitialized in the given type-checked file, sorted by source position.
+//
+// A definite-assignment analysis of the control flow graph of each function
+// definition determines the local variables which are assigned on every path
+// from their declaration. Local arrays and local variables captured by nested
+// functions are not tracked.
+func Uninitialized(file *ast.File, info *Info) []*ast.Ident {
+	captured := make(map[*ast.VarDecl]bool)
+	for _, vars := range info.Captures {
+		for _, v := range vars {
+			captured[v] = true
+		}
+	}
+	var uses []*ast.Ident
+	f := func(n ast.Node) error {
+		if fn, ok := n.(*ast.FuncDecl); ok && astutil.IsDef(fn) {
+			uses = append(uses, uninitialized(fn, info.Types, captured)...)
+		}
+		return nil
+	}
+	if err := astutil.Walk(file, f); err != nil {
+		panic(err)
+	}
+	sort.SliceStable(uses, func(i, j int) bool {
+		return uses[i].Start() < uses[j].Start()
+	})
+	return uses
+}
+
+// uninitialized returns the uses of uninitialized local variables of the
+// given function definition.
+func uninitialized(fn *ast.FuncDecl, exprTypes map[ast.Expr]types.Type, captured map[*ast.VarDecl]bool) []*ast.Ident {
+	g := cfg.New(fn, exprTypes)
+	c := &uninitChecker{vars: make(map[*ast.VarDecl]int)}
+	for _, block := range g.Blocks {
+		for _, n := range block.Nodes {
+			v, ok := n.(*ast.VarDecl)
+			if !ok || v.Val != nil || captured[v] {
+				continue
+			}
+			if _, ok := v.Type().(*types.Array); ok {
+				continue
+			}
+			c.vars[v] = len(c.vars)
+		}
+	}
+	if len(c.vars) == 0 {
+		return nil
+	}
+
+	// Forward analysis of definitely assigned local variables; the meet of
+	// facts is their intersection.
+	all := make(assigned, len(c.vars))
+	for i := range all {
+		all[i] = true
+	}
+	a := &cfg.Analysis{
+		Boundary: make(assigned, len(c.vars)),
+		Init:     all,
+		Meet: func(x, y cfg.Fact) cfg.Fact {
+			return x.(assigned).meet(y.(assigned))
+		},
+		Transfer: func(block *cfg.Block, in cfg.Fact) cfg.Fact {
+			return c.block(block, in.(assigned))
+		},
+		Equal: func(x, y cfg.Fact) bool {
+			return x.(assigned).equal(y.(assigned))
+		},
+	}
+	res := cfg.Solve(g, a)
+
+	// Record uses of uninitialized variables, based on the facts at the start
+	// of reachable basic blocks.
+	var uses []*ast.Ident
+	c.report = func(ident *ast.Ident) {
+		uses = append(uses, ident)
+	}
+	for _, block := range g.Blocks {
+		if block.Live {
+			c.block(block, res.In[block.Index].(assigned))
+		}
+	}
+	return uses
+}
+
+// assigned is the set of definitely assigned local variables, indexed by
+// variable index.
+type assigned []bool
+
+// clone returns a copy of the set.
+func (s assigned) clone() assigned {
+	return append(assigned(nil), s...)
+}
+
+// meet returns the intersection of the given sets.
+func (s assigned) meet(t assigned) assigned {
+	u := make(assigned, len(s))
+	for i := range s {
+		u[i] = s[i] && t[i]
+	}
+	return u
+}
+
+// equal reports whether the given sets are equal.
+func (s assigned) equal(t assigned) bool {
+	for i := range s {
+		if s[i] != t[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// An uninitChecker tracks the definitely assigned local variables of a
+// function.
+type uninitChecker struct {
+	// Tracked local variables, mapped to their variable index.
+	vars map[*ast.VarDecl]int
+	// report, if non-nil, records uses of uninitialized variables.
+	report func(ident *ast.Ident)
+}
+
+// block returns the set of definitely assigned variables after the given basic
+// block, based on the set before the basic block.
+func (c *uninitChecker) block(block *cfg.Block, in assigned) assigned {
+	s := in.clone()
+	for _, n := range block.Nodes {
+		switch n := n.(type) {
+		case *ast.VarDecl:
+			if n.Val != nil {
+				c.expr(n.Val, s)
+			} else if i, ok := c.vars[n]; ok {
+				// Each execution of a declaration yields a new uninitialized
+				// variable; e.g. in loop bodies.
+				s[i] = false
+			}
+		case *ast.ExprStmt:
+			c.expr(n.X, s)
+		case *ast.ReturnStmt:
+			if n.Result != nil {
+				c.expr(n.Result, s)
+			}
+		case ast.Expr:
+			c.expr(n, s)
+		}
+	}
+	return s
+}
+
+// expr updates the set of definitely assigned variables s with the
+// assignments of the given expression, in order of evaluation.
+func (c *uninitChecker) expr(x ast.Expr, s assigned) {
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		// Nothing to do.
+	case *ast.BinaryExpr:
+		switch x.Op {
+		case token.Assign:
+			if i, ok := c.index(astutil.Unparen(x.X)); ok {
+				c.expr(x.Y, s)
+				s[i] = true
+				return
+			}
+			c.expr(x.X, s)
+			c.expr(x.Y, s)
+		case token.Land:
+			// The second operand is only evaluated if the first operand is true;
+			// its assignments are thus conditional.
+			c.expr(x.X, s)
+			c.expr(x.Y, s.clone())
+		default:
+			c.expr(x.X, s)
+			c.expr(x.Y, s)
+		}
+	case *ast.CallExpr:
+		for _, arg := range x.Args {
+			c.expr(arg, s)
+		}
+	case *ast.CastExpr:
+		c.expr(x.X, s)
+	case *ast.CondExpr:
+		c.expr(x.Cond, s)
+		t := s.clone()
+		c.expr(x.X, t)
+		f := s.clone()
+		c.expr(x.Y, f)
+		copy(s, t.meet(f))
+	case *ast.Ident:
+		if i, ok := c.index(x); ok && !s[i] && c.report != nil {
+			c.report(x)
+		}
+	case *ast.IndexExpr:
+		c.expr(x.Index, s)
+	case *ast.ParenExpr:
+		c.expr(x.X, s)
+	case *ast.SizeofExpr:
+		// The operand of sizeof expressions is not evaluated.
+	case *ast.UnaryExpr:
+		c.expr(x.X, s)
+	default:
+		panic(fmt.Sprintf("support for expression %T not yet implemented", x))
+	}
+}
+
+// index returns the variable index of the local variable referred to by the
+// given expression. The boolean return value indicates whether x is an
+// identifier of a tracked local variable.
+func (c *uninitChecker) index(x ast.Expr) (int, bool) {
+	ident, ok := x.(*ast.Ident)
+	if !ok {
+		return 0, false
+	}
+	v, ok := ident.Decl.(*ast.VarDecl)
+	if !ok {
+		return 0, false
+	}
+	i, ok := c.vars[v]
+	return i, ok
+}
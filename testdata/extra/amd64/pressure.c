@@ -0,0 +1,67 @@
+// Exercises stack arguments, and register pressure exceeding the number of
+// allocatable registers.
+
+void putint(int x);
+void putstring(char s[]);
+
+int g[10];
+
+void newline(void) {
+	char s[2];
+	s[0] = '\n';
+	s[1] = 0;
+	putstring(s);
+}
+
+int many(int a, int b, int c, int d, int e, int f, int h, int i) {
+	return a - b + c*d - e/f + (h - h/i*i) + i*100;
+}
+
+int pressure(int n) {
+	int a;
+	int b;
+	int c;
+	int d;
+	int e;
+	int f;
+	int h;
+	int i;
+	int j;
+	int k;
+	int l;
+	int m;
+	a = n + 1;
+	b = n + 2;
+	c = n + 3;
+	d = n + 4;
+	e = n + 5;
+	f = n + 6;
+	h = n + 7;
+	i = n + 8;
+	j = n + 9;
+	k = n + 10;
+	l = n + 11;
+	m = n + 12;
+	putint(many(a, b, c, d, e, f, h, i));
+	newline();
+	return a*b + c*d + e*f + h*i + j*k + l*m + a*m + b*l + c*k + d*j + (e - f)*(h - i) + a/b + m/c + many(m, l, k, j, i, h, f, e);
+}
+
+int main(void) {
+	int x;
+	char ch;
+	x = 0;
+	while (x < 10) {
+		g[x] = x*x - 7;
+		x = x + 1;
+	}
+	ch = 127;
+	ch = ch + 2;
+	putint(ch);
+	newline();
+	putint(pressure(3));
+	newline();
+	putint(g[9] + g[3]);
+	newline();
+	return 0;
+}
@@ -0,0 +1,32 @@
+// Implicit conversions, char arithmetic, nested functions and C keywords.
+void putint(int x);
+
+typedef char byte;
+
+int signed;
+
+int sum(char a, char b) {
+	char c;
+	c = a + b;
+	return c;
+}
+
+int main(void) {
+	int x;
+	byte b;
+	int twice(int y) {
+		int inc(int z) { return z + 1; }
+		return inc(y) * 2 - 1;
+	}
+	x = 100;
+	b = x;
+	signed = -(-x);
+	if (b > 0)
+		putint(sum(b, b));
+	else if (x > 100) {
+		putint(twice(x));
+	} else
+		putint(0);
+	while (x > 0) x = x - sizeof(int), x = x / 2;
+	return x < 0 ? b : signed;
+}
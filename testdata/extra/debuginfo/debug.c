@@ -0,0 +1,30 @@
+void putint(int i);
+
+int n;
+char s[4];
+
+int sum(int a[], int len) {
+	int i;
+	int x;
+	int unused;
+	i = 0;
+	x = 0;
+	while (i < len) {
+		x = x + a[i];
+		i = i + 1;
+	}
+	return x;
+}
+
+int main(void) {
+	int b[3];
+	void set(int v) {
+		n = v;
+		putint(v);
+	}
+	b[0] = 1; b[1] = 2;
+	b[2] = 3;
+	set(sum(b, 3));
+	putint(n);
+	return 0;
+}
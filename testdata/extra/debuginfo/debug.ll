@@ -0,0 +1,138 @@
+@n = global i32 0, !dbg !7
+@s = global [4 x i8] zeroinitializer, !dbg !13
+
+declare void @putint(i32)
+
+declare void @llvm.dbg.declare(metadata, metadata, metadata)
+
+define i32 @sum(i32* %a, i32 %len) !dbg !17 {
+; <label>:0
+	%1 = alloca i32*, !dbg !23
+	store i32* %a, i32** %1, !dbg !23
+	call void @llvm.dbg.declare(metadata i32** %1, metadata !18, metadata !19), !dbg !20
+	%2 = alloca i32, !dbg !23
+	store i32 %len, i32* %2, !dbg !23
+	call void @llvm.dbg.declare(metadata i32* %2, metadata !21, metadata !19), !dbg !22
+	%i = alloca i32, !dbg !25
+	call void @llvm.dbg.declare(metadata i32* %i, metadata !24, metadata !19), !dbg !25
+	%x = alloca i32, !dbg !27
+	call void @llvm.dbg.declare(metadata i32* %x, metadata !26, metadata !19), !dbg !27
+	%unused = alloca i32, !dbg !29
+	call void @llvm.dbg.declare(metadata i32* %unused, metadata !28, metadata !19), !dbg !29
+	store i32 0, i32* %i, !dbg !30
+	store i32 0, i32* %x, !dbg !31
+	br label %3, !dbg !32
+; <label>:3
+	%4 = load i32, i32* %i, !dbg !32
+	%5 = load i32, i32* %2, !dbg !32
+	%6 = icmp slt i32 %4, %5, !dbg !32
+	br i1 %6, label %7, label %17, !dbg !32
+; <label>:7
+	%8 = load i32, i32* %x, !dbg !33
+	%9 = load i32, i32* %i, !dbg !33
+	%10 = sext i32 %9 to i64, !dbg !33
+	%11 = load i32*, i32** %1, !dbg !33
+	%12 = getelementptr i32, i32* %11, i64 %10, !dbg !33
+	%13 = load i32, i32* %12, !dbg !33
+	%14 = add i32 %8, %13, !dbg !33
+	store i32 %14, i32* %x, !dbg !33
+	%15 = load i32, i32* %i, !dbg !34
+	%16 = add i32 %15, 1, !dbg !34
+	store i32 %16, i32* %i, !dbg !34
+	br label %3, !dbg !34
+; <label>:17
+	%18 = load i32, i32* %x, !dbg !35
+	ret i32 %18, !dbg !35
+}
+
+define void @main.set(i32 %v) !dbg !46 {
+; <label>:0
+	%1 = alloca i32, !dbg !49
+	store i32 %v, i32* %1, !dbg !49
+	call void @llvm.dbg.declare(metadata i32* %1, metadata !47, metadata !19), !dbg !48
+	%2 = load i32, i32* %1, !dbg !50
+	store i32 %2, i32* @n, !dbg !50
+	%3 = load i32, i32* %1, !dbg !51
+	call void @putint(i32 %3), !dbg !51
+	ret void, !dbg !51
+}
+
+define i32 @main() !dbg !38 {
+; <label>:0
+	%b = alloca [3 x i32], !dbg !43
+	call void @llvm.dbg.declare(metadata [3 x i32]* %b, metadata !42, metadata !19), !dbg !43
+	%1 = getelementptr [3 x i32], [3 x i32]* %b, i64 0, i64 0, !dbg !52
+	store i32 1, i32* %1, !dbg !52
+	%2 = getelementptr [3 x i32], [3 x i32]* %b, i64 0, i64 1, !dbg !53
+	store i32 2, i32* %2, !dbg !53
+	%3 = getelementptr [3 x i32], [3 x i32]* %b, i64 0, i64 2, !dbg !54
+	store i32 3, i32* %3, !dbg !54
+	%4 = getelementptr [3 x i32], [3 x i32]* %b, i64 0, i64 0, !dbg !55
+	%5 = call i32 @sum(i32* %4, i32 3), !dbg !55
+	call void @main.set(i32 %5), !dbg !55
+	%6 = load i32, i32* @n, !dbg !56
+	call void @putint(i32 %6), !dbg !56
+	ret i32 0, !dbg !57
+}
+
+!llvm.dbg.cu = !{!2}
+!llvm.module.flags = !{!0, !1}
+
+!0 = !{i32 2, !"Dwarf Version", i32 4}
+!1 = !{i32 2, !"Debug Info Version", i32 3}
+!4 = !{!7, !13}
+!2 = distinct !DICompileUnit(language: DW_LANG_C99, file: !3, producer: "uclang", isOptimized: false, runtimeVersion: 0, emissionKind: FullDebug, globals: !4)
+!3 = !DIFile(filename: "debug.c", directory: ".")
+!5 = !DIBasicType(name: "int", size: 32, encoding: DW_ATE_signed)
+!6 = distinct !DIGlobalVariable(name: "n", scope: !2, file: !3, line: 3, type: !5, isLocal: false, isDefinition: true)
+!7 = !DIGlobalVariableExpression(var: !6, expr: !DIExpression())
+!8 = !DIBasicType(name: "char", size: 8, encoding: DW_ATE_signed_char)
+!9 = !DISubrange(count: 4)
+!10 = !{!9}
+!11 = !DICompositeType(tag: DW_TAG_array_type, baseType: !8, size: 32, elements: !10)
+!12 = distinct !DIGlobalVariable(name: "s", scope: !2, file: !3, line: 4, type: !11, isLocal: false, isDefinition: true)
+!13 = !DIGlobalVariableExpression(var: !12, expr: !DIExpression())
+!14 = !DIDerivedType(tag: DW_TAG_pointer_type, baseType: !5, size: 64)
+!15 = !{!5, !14, !5}
+!16 = !DISubroutineType(types: !15)
+!17 = distinct !DISubprogram(name: "sum", scope: !3, file: !3, line: 6, type: !16, scopeLine: 6, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: !2)
+!18 = !DILocalVariable(name: "a", arg: 1, scope: !17, file: !3, line: 6, type: !14)
+!19 = !DIExpression()
+!20 = !DILocation(line: 6, column: 9, scope: !17)
+!21 = !DILocalVariable(name: "len", arg: 2, scope: !17, file: !3, line: 6, type: !5)
+!22 = !DILocation(line: 6, column: 18, scope: !17)
+!23 = !DILocation(line: 6, column: 1, scope: !17)
+!24 = !DILocalVariable(name: "i", scope: !17, file: !3, line: 7, type: !5)
+!25 = !DILocation(line: 7, column: 2, scope: !17)
+!26 = !DILocalVariable(name: "x", scope: !17, file: !3, line: 8, type: !5)
+!27 = !DILocation(line: 8, column: 2, scope: !17)
+!28 = !DILocalVariable(name: "unused", scope: !17, file: !3, line: 9, type: !5)
+!29 = !DILocation(line: 9, column: 2, scope: !17)
+!30 = !DILocation(line: 10, column: 2, scope: !17)
+!31 = !DILocation(line: 11, column: 2, scope: !17)
+!32 = !DILocation(line: 12, column: 2, scope: !17)
+!33 = !DILocation(line: 13, column: 3, scope: !17)
+!34 = !DILocation(line: 14, column: 3, scope: !17)
+!35 = !DILocation(line: 16, column: 2, scope: !17)
+!36 = !{!5}
+!37 = !DISubroutineType(types: !36)
+!38 = distinct !DISubprogram(name: "main", scope: !3, file: !3, line: 19, type: !37, scopeLine: 19, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: !2)
+!39 = !DISubrange(count: 3)
+!40 = !{!39}
+!41 = !DICompositeType(tag: DW_TAG_array_type, baseType: !5, size: 96, elements: !40)
+!42 = !DILocalVariable(name: "b", scope: !38, file: !3, line: 20, type: !41)
+!43 = !DILocation(line: 20, column: 2, scope: !38)
+!44 = !{null, !5}
+!45 = !DISubroutineType(types: !44)
+!46 = distinct !DISubprogram(name: "set", linkageName: "main.set", scope: !3, file: !3, line: 21, type: !45, scopeLine: 21, flags: DIFlagPrototyped, spFlags: DISPFlagDefinition, unit: !2)
+!47 = !DILocalVariable(name: "v", arg: 1, scope: !46, file: !3, line: 21, type: !5)
+!48 = !DILocation(line: 21, column: 11, scope: !46)
+!49 = !DILocation(line: 21, column: 2, scope: !46)
+!50 = !DILocation(line: 22, column: 3, scope: !46)
+!51 = !DILocation(line: 23, column: 3, scope: !46)
+!52 = !DILocation(line: 25, column: 2, scope: !38)
+!53 = !DILocation(line: 25, column: 12, scope: !38)
+!54 = !DILocation(line: 26, column: 2, scope: !38)
+!55 = !DILocation(line: 27, column: 2, scope: !38)
+!56 = !DILocation(line: 28, column: 2, scope: !38)
+!57 = !DILocation(line: 29, column: 2, scope: !38)
@@ -0,0 +1,37 @@
+/* Test file for the canonical formatter.
+ * Contains comments in various positions. */
+
+// Runtime library.
+void putint(int x);  // print integer
+void putstring(char s[]);
+
+
+enum color{RED,GREEN=5,BLUE,};
+typedef   enum color color_t;
+int a[N+1]; int b;
+
+int max(int x,int y){return x<y?y:x;}
+
+int main(void)
+{
+	// Leading comment.
+
+
+	int i; int n; char buf[10];
+	i=0; n = - -3; /* trailing block */
+	if(i==0) putint(i); else if (i==1) { putint(1); } else
+		putint(-1);
+	while(i<10)
+	{
+		buf[i]='a'+(char)i; // store
+		i=i+1;
+
+		// Comment at end of block.
+	}
+	if (n) {} else ;
+	n = sizeof(int) + sizeof buf / sizeof(buf[0]);
+	{
+	}
+	n = max(a[0],/* inline */ b), n = !n && (n != 1);
+	return 0; }
+// End of file.
@@ -0,0 +1,32 @@
+define i32 @f(i32 %a, i32 %b, i32 %c, i32 %d) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %a, i32* %1
+	%2 = alloca i32
+	store i32 %b, i32* %2
+	%3 = alloca i32
+	store i32 %c, i32* %3
+	%4 = alloca i32
+	store i32 %d, i32* %4
+	%5 = load i32, i32* %1
+	%6 = icmp ne i32 %5, 0
+	br i1 %6, label %7, label %17
+; <label>:7
+	%8 = load i32, i32* %2
+	%9 = icmp ne i32 %8, 0
+	br i1 %9, label %10, label %12
+; <label>:10
+	%11 = load i32, i32* %3
+	br label %14
+; <label>:12
+	%13 = load i32, i32* %4
+	br label %14
+; <label>:14
+	%15 = phi i32 [ %11, %10 ], [ %13, %12 ]
+	%16 = icmp ne i32 %15, 0
+	br label %17
+; <label>:17
+	%18 = phi i1 [ false, %0 ], [ %16, %14 ]
+	%19 = zext i1 %18 to i32
+	ret i32 %19
+}
@@ -0,0 +1,6 @@
+void putint(int x);
+
+int main(void) {
+	putint(42);
+	return 0;
+}
@@ -0,0 +1,6 @@
+char f(int x) {
+	char c;
+	c = (char)x;
+	(void)c;
+	return (char)((int)c + 1);
+}
@@ -0,0 +1,15 @@
+define i8 @f(i32 %x) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %x, i32* %1
+	%c = alloca i8
+	%2 = load i32, i32* %1
+	%3 = trunc i32 %2 to i8
+	store i8 %3, i8* %c
+	%4 = load i8, i8* %c
+	%5 = load i8, i8* %c
+	%6 = sext i8 %5 to i32
+	%7 = add i32 %6, 1
+	%8 = trunc i32 %7 to i8
+	ret i8 %8
+}
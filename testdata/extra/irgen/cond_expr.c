@@ -0,0 +1,23 @@
+int n;
+
+void inc(void) {
+	n = n + 1;
+}
+
+int max(int a, int b) {
+	return a > b ? a : b;
+}
+
+int sign(int x) {
+	return x < 0 ? -1 : x > 0 ? 1 : 0;
+}
+
+int main(void) {
+	int x;
+	char c;
+	c = 'a';
+	x = (inc(), n = n + 1, n);
+	n == 2 ? inc() : inc();
+	x = x == 2 ? c : 0;
+	return max(x, 3) + sign(-5) + sign(0) + n;
+}
@@ -0,0 +1,101 @@
+@n = global i32 0
+
+define void @inc() {
+; <label>:0
+	%1 = load i32, i32* @n
+	%2 = add i32 %1, 1
+	store i32 %2, i32* @n
+	ret void
+}
+
+define i32 @max(i32 %a, i32 %b) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %a, i32* %1
+	%2 = alloca i32
+	store i32 %b, i32* %2
+	%3 = load i32, i32* %1
+	%4 = load i32, i32* %2
+	%5 = icmp sgt i32 %3, %4
+	br i1 %5, label %6, label %8
+; <label>:6
+	%7 = load i32, i32* %1
+	br label %10
+; <label>:8
+	%9 = load i32, i32* %2
+	br label %10
+; <label>:10
+	%11 = phi i32 [ %7, %6 ], [ %9, %8 ]
+	ret i32 %11
+}
+
+define i32 @sign(i32 %x) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %x, i32* %1
+	%2 = load i32, i32* %1
+	%3 = icmp slt i32 %2, 0
+	br i1 %3, label %4, label %6
+; <label>:4
+	%5 = sub i32 0, 1
+	br label %13
+; <label>:6
+	%7 = load i32, i32* %1
+	%8 = icmp sgt i32 %7, 0
+	br i1 %8, label %9, label %10
+; <label>:9
+	br label %11
+; <label>:10
+	br label %11
+; <label>:11
+	%12 = phi i32 [ 1, %9 ], [ 0, %10 ]
+	br label %13
+; <label>:13
+	%14 = phi i32 [ %5, %4 ], [ %12, %11 ]
+	ret i32 %14
+}
+
+define i32 @main() {
+; <label>:0
+	%x = alloca i32
+	%c = alloca i8
+	store i8 97, i8* %c
+	call void @inc()
+	%1 = load i32, i32* @n
+	%2 = add i32 %1, 1
+	store i32 %2, i32* @n
+	%3 = load i32, i32* @n
+	store i32 %3, i32* %x
+	%4 = load i32, i32* @n
+	%5 = icmp eq i32 %4, 2
+	br i1 %5, label %6, label %7
+; <label>:6
+	call void @inc()
+	br label %8
+; <label>:7
+	call void @inc()
+	br label %8
+; <label>:8
+	%9 = load i32, i32* %x
+	%10 = icmp eq i32 %9, 2
+	br i1 %10, label %11, label %14
+; <label>:11
+	%12 = load i8, i8* %c
+	%13 = sext i8 %12 to i32
+	br label %15
+; <label>:14
+	br label %15
+; <label>:15
+	%16 = phi i32 [ %13, %11 ], [ 0, %14 ]
+	store i32 %16, i32* %x
+	%17 = load i32, i32* %x
+	%18 = call i32 @max(i32 %17, i32 3)
+	%19 = sub i32 0, 5
+	%20 = call i32 @sign(i32 %19)
+	%21 = add i32 %18, %20
+	%22 = call i32 @sign(i32 0)
+	%23 = add i32 %21, %22
+	%24 = load i32, i32* @n
+	%25 = add i32 %23, %24
+	ret i32 %25
+}
@@ -0,0 +1,17 @@
+enum color { RED, GREEN = 5, BLUE, };
+enum { N = BLUE * 2 };
+int a[N];
+enum color c;
+
+int f(enum color x) {
+	enum { LOCAL = 7 };
+	return x + LOCAL;
+}
+
+int main(void) {
+	enum color d;
+	d = BLUE;
+	c = GREEN;
+	a[0] = sizeof a;
+	return f(d) + c + a[0] + RED;
+}
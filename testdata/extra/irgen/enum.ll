@@ -0,0 +1,27 @@
+@a = global [12 x i32] zeroinitializer
+@c = global i32 0
+
+define i32 @f(i32 %x) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %x, i32* %1
+	%2 = load i32, i32* %1
+	%3 = add i32 %2, 7
+	ret i32 %3
+}
+
+define i32 @main() {
+; <label>:0
+	%d = alloca i32
+	store i32 6, i32* %d
+	store i32 5, i32* @c
+	store i32 48, i32* getelementptr ([12 x i32], [12 x i32]* @a, i64 0, i64 0)
+	%1 = load i32, i32* %d
+	%2 = call i32 @f(i32 %1)
+	%3 = load i32, i32* @c
+	%4 = add i32 %2, %3
+	%5 = load i32, i32* getelementptr ([12 x i32], [12 x i32]* @a, i64 0, i64 0)
+	%6 = add i32 %4, %5
+	%7 = add i32 %6, 0
+	ret i32 %7
+}
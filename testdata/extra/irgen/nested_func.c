@@ -0,0 +1,11 @@
+int f(int a) {
+	int x;
+	void g(int b) {
+		int h(void) {
+			return a + b;
+		}
+		x = h();
+	}
+	g(1);
+	return x;
+}
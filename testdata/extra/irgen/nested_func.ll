@@ -0,0 +1,26 @@
+define i32 @f.g.h(i32* %a, i32* %b) {
+; <label>:0
+	%1 = load i32, i32* %a
+	%2 = load i32, i32* %b
+	%3 = add i32 %1, %2
+	ret i32 %3
+}
+
+define void @f.g(i32 %b, i32* %a, i32* %x) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %b, i32* %1
+	%2 = call i32 @f.g.h(i32* %a, i32* %1)
+	store i32 %2, i32* %x
+	ret void
+}
+
+define i32 @f(i32 %a) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %a, i32* %1
+	%x = alloca i32
+	call void @f.g(i32 1, i32* %1, i32* %x)
+	%2 = load i32, i32* %x
+	ret i32 %2
+}
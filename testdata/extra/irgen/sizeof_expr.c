@@ -0,0 +1,8 @@
+int x = 2 * 3 + (char)300;
+int a[sizeof(int) * 2];
+
+int main(void) {
+	char c = 'a';
+	int n = sizeof c + sizeof a;
+	return x + n;
+}
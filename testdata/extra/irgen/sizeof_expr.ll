@@ -0,0 +1,15 @@
+@x = global i32 50
+@a = global [8 x i32] zeroinitializer
+
+define i32 @main() {
+; <label>:0
+	%c = alloca i8
+	store i8 97, i8* %c
+	%n = alloca i32
+	%1 = add i32 1, 32
+	store i32 %1, i32* %n
+	%2 = load i32, i32* @x
+	%3 = load i32, i32* %n
+	%4 = add i32 %2, %3
+	ret i32 %4
+}
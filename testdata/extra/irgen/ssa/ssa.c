@@ -0,0 +1,16 @@
+int f(int n) {
+	int i;
+	int sum;
+	int a[10];
+	i = 0;
+	sum = 0;
+	while (i < n) {
+		if (i && sum < 100) {
+			sum = sum + i;
+		} else {
+			a[0] = i;
+		}
+		i = i + 1;
+	}
+	return n > 0 ? sum : a[0];
+}
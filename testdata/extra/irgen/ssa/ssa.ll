@@ -0,0 +1,42 @@
+define i32 @f(i32 %n) {
+; <label>:0
+	%a = alloca [10 x i32]
+	br label %1
+; <label>:1
+	%2 = phi i32 [ 0, %0 ], [ %16, %15 ]
+	%3 = phi i32 [ 0, %0 ], [ %17, %15 ]
+	%4 = icmp slt i32 %3, %n
+	br i1 %4, label %5, label %18
+; <label>:5
+	%6 = icmp ne i32 %3, 0
+	br i1 %6, label %7, label %9
+; <label>:7
+	%8 = icmp slt i32 %2, 100
+	br label %9
+; <label>:9
+	%10 = phi i1 [ false, %5 ], [ %8, %7 ]
+	br i1 %10, label %11, label %13
+; <label>:11
+	%12 = add i32 %2, %3
+	br label %15
+; <label>:13
+	%14 = getelementptr [10 x i32], [10 x i32]* %a, i64 0, i64 0
+	store i32 %3, i32* %14
+	br label %15
+; <label>:15
+	%16 = phi i32 [ %12, %11 ], [ %2, %13 ]
+	%17 = add i32 %3, 1
+	br label %1
+; <label>:18
+	%19 = icmp sgt i32 %n, 0
+	br i1 %19, label %20, label %21
+; <label>:20
+	br label %24
+; <label>:21
+	%22 = getelementptr [10 x i32], [10 x i32]* %a, i64 0, i64 0
+	%23 = load i32, i32* %22
+	br label %24
+; <label>:24
+	%25 = phi i32 [ %2, %20 ], [ %23, %21 ]
+	ret i32 %25
+}
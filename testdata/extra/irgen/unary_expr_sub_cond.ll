@@ -0,0 +1,13 @@
+define i32 @f(i32 %a, i32 %b) {
+; <label>:0
+	%1 = alloca i32
+	store i32 %a, i32* %1
+	%2 = alloca i32
+	store i32 %b, i32* %2
+	%3 = load i32, i32* %1
+	%4 = load i32, i32* %2
+	%5 = icmp slt i32 %3, %4
+	%6 = zext i1 %5 to i32
+	%7 = sub i32 0, %6
+	ret i32 %7
+}
@@ -0,0 +1,17 @@
+int g;
+
+int f(int x) {
+	int a;
+	int b;
+	a = 2 * 3 + 4;
+	b = a - 10;
+	if (b) {
+		g = x * a;
+	} else {
+		g = x * a + x * a;
+	}
+	while (0) {
+		g = 0;
+	}
+	return g;
+}
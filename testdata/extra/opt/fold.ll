@@ -0,0 +1,10 @@
+@g = global i32 0
+
+define i32 @f(i32 %x) {
+; <label>:0
+	%1 = mul i32 %x, 10
+	%2 = add i32 %1, %1
+	store i32 %2, i32* @g
+	%3 = load i32, i32* @g
+	ret i32 %3
+}
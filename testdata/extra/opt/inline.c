@@ -0,0 +1,19 @@
+void putint(int x);
+
+int add(int a, int b) {
+	return a + b;
+}
+
+int max(int a, int b) {
+	if (a > b) {
+		return a;
+	}
+	return b;
+}
+
+int main(void) {
+	int x;
+	x = add(2, 3);
+	putint(max(x, 4));
+	return 0;
+}
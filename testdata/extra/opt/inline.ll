@@ -0,0 +1,7 @@
+declare void @putint(i32)
+
+define i32 @main() {
+; <label>:0
+	call void @putint(i32 5)
+	ret i32 0
+}
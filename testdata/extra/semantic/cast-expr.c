@@ -0,0 +1,12 @@
+// Explicit conversions between numerical types, and casts to void.
+typedef char byte;
+
+int main(void) {
+	int x;
+	char c;
+	x = 300;
+	c = (char)x;
+	x = (int)(byte)x + (int)c;
+	(void)x;
+	return (int)!c;
+}
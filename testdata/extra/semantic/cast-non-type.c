@@ -0,0 +1,7 @@
+// Invalid cast expression
+//
+//    "x" is not a type
+int main(void) {
+	int x;
+	return (x)!x;
+}
@@ -0,0 +1,4 @@
+// Invalid array length
+//
+//    "1, 2" is not a constant expression
+int a[(1, 2)];
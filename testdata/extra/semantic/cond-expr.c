@@ -0,0 +1,17 @@
+// Conditional expressions and the comma operator.
+enum { N = 1 ? 10 : 1 / 0 };
+
+int a[N > 5 ? N : 5];
+
+void f(void) {
+}
+
+int main(void) {
+	int x;
+	char c;
+	c = 'a';
+	x = (f(), c);
+	x > 0 ? f() : f();
+	x = x ? c : 42;
+	return x < N ? x : N, 0;
+}
@@ -0,0 +1,9 @@
+// Invalid conditional expression
+//
+//    invalid operation: x ? a : 0 (type mismatch between "int[10]" and "int")
+int main(void) {
+	int a[10];
+	int x;
+	x = 1;
+	return x ? a : 0;
+}
@@ -0,0 +1,6 @@
+// Invalid array length
+//
+//    division by zero in constant expression "10 / (2 - 2)"
+int main(void) {
+	int a[10 / (2 - 2)];
+}
@@ -0,0 +1,4 @@
+// Invalid global variable initializer
+//
+//    integer overflow in constant expression "2147483647 + 1" of type "int"
+int x = 2147483647 + 1;
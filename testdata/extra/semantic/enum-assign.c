@@ -0,0 +1,8 @@
+// Invalid assignment
+//
+//    cannot assign to "RED" of type "int"
+enum color { RED, GREEN };
+
+int main(void) {
+	RED = 2;
+}
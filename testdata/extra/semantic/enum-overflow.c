@@ -0,0 +1,4 @@
+// Invalid enumerator value
+//
+//    value 2147483648 of enumerator "B" overflows type "int"
+enum { A = 2147483647, B };
@@ -0,0 +1,5 @@
+// Invalid enumeration declaration
+//
+//    redefinition of enumeration tag "color"
+enum color { RED };
+enum color { GREEN };
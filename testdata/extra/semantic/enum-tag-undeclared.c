@@ -0,0 +1,6 @@
+// Invalid variable declaration
+//
+//    undeclared enumeration tag "shape"
+enum color { RED };
+
+enum shape x;
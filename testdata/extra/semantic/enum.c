@@ -0,0 +1,20 @@
+// Enumerations with implicit and explicit values, used as constant expressions
+// and as types.
+enum color { RED, GREEN = 5, BLUE, };
+enum { N = BLUE * 2 };
+typedef enum color color_t;
+
+int a[N];
+enum color c = BLUE;
+
+int f(color_t x) {
+	enum shape { CIRCLE = 'c', SQUARE };
+	enum shape s;
+	s = SQUARE;
+	return x + s;
+}
+
+int main(void) {
+	c = RED;
+	return f(GREEN) + sizeof a / sizeof a[0];
+}
@@ -0,0 +1,7 @@
+// Invalid cast expression
+//
+//    invalid cast from "int[10]" to "int"
+int main(void) {
+	int a[10];
+	return (int)a;
+}
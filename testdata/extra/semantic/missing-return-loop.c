@@ -0,0 +1,27 @@
+// Return statements in infinite loops, and statements following return
+// statements, do not reach the end of the function body.
+int f(int a) {
+	while (1) {
+		if (a < 10) {
+			return a;
+		}
+		a = a - 1;
+	}
+}
+
+int g(int a) {
+	return a;
+	a = a + 1;
+}
+
+int h(int a) {
+	if (!(a && 1)) {
+		return 0;
+	} else {
+		return a;
+	}
+}
+
+int main(void) {
+	return f(3) + g(2) + h(1);
+}
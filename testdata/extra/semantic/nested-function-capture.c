@@ -0,0 +1,15 @@
+// Nested functions may refer to local variables and parameters of enclosing
+// functions.
+int main(void) {
+	int sum;
+	void add(int x) {
+		sum = sum + x;
+	}
+	void twice(int x) {
+		add(x);
+		add(x);
+	}
+	sum = 0;
+	twice(21);
+	return sum;
+}
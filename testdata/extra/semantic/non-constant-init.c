@@ -0,0 +1,8 @@
+// Invalid global variable initializer
+//
+//    "f()" is not a constant expression
+int f(void) {
+	return 42;
+}
+
+int x = 1 + f();
@@ -0,0 +1,10 @@
+// Invalid sizeof expression
+//
+//    invalid application of sizeof to array of unspecified length "char[]"
+int f(char s[]) {
+	return sizeof s;
+}
+
+int main(void) {
+	return 0;
+}
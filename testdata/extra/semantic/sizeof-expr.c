@@ -0,0 +1,16 @@
+// Compile-time constant expressions, as used by sizeof, array lengths and
+// global variable initializers.
+int x = 2 * 3 + (char)300;
+char c = 'a' + 1;
+int a[sizeof(int) * 2];
+char b[sizeof a / sizeof a[0] + 1];
+// Arithmetic on char operands is evaluated in int.
+char d[(char)100 + (char)100];
+enum { BIG = (char)'x' * (char)2 };
+
+int main(void) {
+	int n = sizeof x;
+	char s[4 - 1];
+	n = sizeof(char) + sizeof c + sizeof s;
+	return n + sizeof(a);
+}
@@ -0,0 +1,6 @@
+// Invalid sizeof expression
+//
+//    invalid application of sizeof to type "void"
+int main(void) {
+	return sizeof(void);
+}
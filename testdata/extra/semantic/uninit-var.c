@@ -0,0 +1,26 @@
+// Use of uninitialized local variables
+//
+//    variable "y" may be used uninitialized
+//    variable "z" may be used uninitialized
+int f(int a) {
+	int x;
+	int y;
+	int z;
+	if (a) {
+		x = 1;
+	} else {
+		x = 2;
+	}
+	while (a > 0) {
+		y = a;
+		a = a - 1;
+	}
+	if (a && (z = 3)) {
+		return x + z;
+	}
+	return x + y + z;
+}
+
+int main(void) {
+	return f(3);
+}
@@ -0,0 +1,24 @@
+/* Logical and with a conditional expression as right operand. */
+
+void putint(int i);
+void putstring(char s[]);
+
+int main(void) {
+  char nl[2];
+  int i;
+  int a;
+  int b;
+  nl[0] = '\n';
+  nl[1] = 0;
+  i = 0;
+
+  while (i < 4) {
+    a = i / 2;
+    b = i - a * 2;
+    putint(a && (b ? a : b));
+    putint(i < 3 && (a ? b == 0 : 1));
+    i = i + 1;
+  }
+
+  putstring(nl);
+}
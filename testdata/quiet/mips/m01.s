@@ -0,0 +1,97 @@
+	.data
+	.align 2
+_b:
+	.word 0
+	.align 2
+_addi:
+	.byte 0
+	.align 2
+_la:
+	.word 0
+
+	.text
+	.globl main
+	jal main
+	move $a0, $v0
+	li $v0, 17
+	syscall
+
+_jal:
+	addiu $sp, $sp, -8
+	sw $ra, 4($sp)
+	sw $fp, 0($sp)
+	move $fp, $sp
+	addiu $sp, $sp, -24
+	lw $t0, _b
+	sw $t0, -4($fp)
+	lb $t0, _addi
+	sll $t0, $t0, 24
+	sra $t0, $t0, 24
+	sw $t0, -8($fp)
+	lw $t0, -8($fp)
+	sw $t0, -12($fp)
+	lw $t0, -4($fp)
+	lw $t1, -12($fp)
+	mul $t0, $t0, $t1
+	sw $t0, -16($fp)
+	lw $t0, _la
+	sw $t0, -20($fp)
+	lw $t0, -16($fp)
+	lw $t1, -20($fp)
+	addu $t0, $t0, $t1
+	sw $t0, -24($fp)
+	lw $t0, -24($fp)
+	sw $t0, _b
+	move $sp, $fp
+	lw $fp, 0($sp)
+	lw $ra, 4($sp)
+	addiu $sp, $sp, 8
+	jr $ra
+
+_mov:
+	addiu $sp, $sp, -8
+	sw $ra, 4($sp)
+	sw $fp, 0($sp)
+	move $fp, $sp
+	addiu $sp, $sp, -16
+	lw $t0, 8($fp)
+	sw $t0, -4($fp)
+	lw $t0, -4($fp)
+	sw $t0, -8($fp)
+	lw $t0, -8($fp)
+	sll $t0, $t0, 24
+	sra $t0, $t0, 24
+	sw $t0, -12($fp)
+	lw $t0, -12($fp)
+	sb $t0, _addi
+	li $v0, 0
+	move $sp, $fp
+	lw $fp, 0($sp)
+	lw $ra, 4($sp)
+	addiu $sp, $sp, 8
+	jr $ra
+
+main:
+	addiu $sp, $sp, -8
+	sw $ra, 4($sp)
+	sw $fp, 0($sp)
+	move $fp, $sp
+	addiu $sp, $sp, -8
+	li $t0, 8
+	sw $t0, _la
+	jal _jal
+	lw $t0, _la
+	sw $t0, -4($fp)
+	addiu $sp, $sp, -4
+	lw $t0, -4($fp)
+	sw $t0, 0($sp)
+	jal _mov
+	addiu $sp, $sp, 4
+	move $t0, $v0
+	sw $t0, -8($fp)
+	li $v0, 0
+	move $sp, $fp
+	lw $fp, 0($sp)
+	lw $ra, 4($sp)
+	addiu $sp, $sp, 8
+	jr $ra
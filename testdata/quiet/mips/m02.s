@@ -0,0 +1,76 @@
+	.text
+	.globl main
+	jal main
+	move $a0, $v0
+	li $v0, 17
+	syscall
+
+_f:
+	addiu $sp, $sp, -8
+	sw $ra, 4($sp)
+	sw $fp, 0($sp)
+	move $fp, $sp
+	addiu $sp, $sp, -32
+	lw $t0, 8($fp)
+	sw $t0, -4($fp)
+	lw $t0, -4($fp)
+	sw $t0, -8($fp)
+	lw $t0, -8($fp)
+	li $t1, 0
+	sgt $t0, $t0, $t1
+	andi $t0, $t0, 1
+	sw $t0, -12($fp)
+	lw $t0, -12($fp)
+	bnez $t0, _f.1
+	j _f.2
+_f.1:
+	lw $t0, -4($fp)
+	sw $t0, -16($fp)
+	lw $t0, -16($fp)
+	li $t1, 1
+	subu $t0, $t0, $t1
+	sw $t0, -20($fp)
+	addiu $sp, $sp, -4
+	lw $t0, -20($fp)
+	sw $t0, 0($sp)
+	jal _f
+	addiu $sp, $sp, 4
+	move $t0, $v0
+	sw $t0, -24($fp)
+	li $t0, 2
+	lw $t1, -24($fp)
+	addu $t0, $t0, $t1
+	sw $t0, -28($fp)
+	lw $v0, -28($fp)
+	move $sp, $fp
+	lw $fp, 0($sp)
+	lw $ra, 4($sp)
+	addiu $sp, $sp, 8
+	jr $ra
+_f.2:
+	li $v0, 112
+	move $sp, $fp
+	lw $fp, 0($sp)
+	lw $ra, 4($sp)
+	addiu $sp, $sp, 8
+	jr $ra
+
+main:
+	addiu $sp, $sp, -8
+	sw $ra, 4($sp)
+	sw $fp, 0($sp)
+	move $fp, $sp
+	addiu $sp, $sp, -8
+	addiu $sp, $sp, -4
+	li $t0, 8
+	sw $t0, 0($sp)
+	jal _f
+	addiu $sp, $sp, 4
+	move $t0, $v0
+	sw $t0, -4($fp)
+	li $v0, 0
+	move $sp, $fp
+	lw $fp, 0($sp)
+	lw $ra, 4($sp)
+	addiu $sp, $sp, 8
+	jr $ra
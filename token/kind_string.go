@@ -4,9 +4,9 @@ package token
 
 import "fmt"
 
-const _Kind_name = "EOFErrorCommentliteralStartIdentIntLitCharLitliteralEndoperatorStartAddSubMulDivAssignEqNeLtLeGtGeLandNotLparenRparenLbracketRbracketLbraceRbraceCommaSemicolonoperatorEndkeywordStartKwElseKwIfKwReturnKwWhilekeywordEnd"
+const _Kind_name = "EOFErrorCommentliteralStartIdentIntLitCharLitliteralEndoperatorStartAddSubMulDivAssignEqNeLtLeGtGeLandNotQuestionColonLparenRparenLbracketRbracketLbraceRbraceCommaSemicolonoperatorEndkeywordStartKwElseKwEnumKwIfKwReturnKwSizeofKwTypedefKwWhilekeywordEnd"
 
-var _Kind_index = [...]uint8{0, 3, 8, 15, 27, 32, 38, 45, 55, 68, 71, 74, 77, 80, 86, 88, 90, 92, 94, 96, 98, 102, 105, 111, 117, 125, 133, 139, 145, 150, 159, 170, 182, 188, 192, 200, 207, 217}
+var _Kind_index = [...]uint8{0, 3, 8, 15, 27, 32, 38, 45, 55, 68, 71, 74, 77, 80, 86, 88, 90, 92, 94, 96, 98, 102, 105, 113, 118, 124, 130, 138, 146, 152, 158, 163, 172, 183, 195, 201, 207, 211, 219, 227, 236, 243, 253}
 
 func (kind Kind) GoString() string {
 	if kind >= Kind(len(_Kind_index)-1) {
@@ -57,6 +57,8 @@ const (
 	Ge        // >=
 	Land      // &&
 	Not       // !
+	Question  // ?
+	Colon     // :
 	Lparen    // (
 	Rparen    // )
 	Lbracket  // [
@@ -72,8 +74,10 @@ const (
 
 	// Keywords.
 	KwElse    // else
+	KwEnum    // enum
 	KwIf      // if
 	KwReturn  // return
+	KwSizeof  // sizeof
 	KwTypedef // typedef
 	KwWhile   // while
 
@@ -101,6 +105,8 @@ func (kind Kind) String() string {
 		Ge:        ">=",
 		Land:      "&&",
 		Not:       "!",
+		Question:  "?",
+		Colon:     ":",
 		Lparen:    "(",
 		Rparen:    ")",
 		Lbracket:  "[",
@@ -110,8 +116,10 @@ func (kind Kind) String() string {
 		Comma:     ",",
 		Semicolon: ";",
 		KwElse:    "else",
+		KwEnum:    "enum",
 		KwIf:      "if",
 		KwReturn:  "return",
+		KwSizeof:  "sizeof",
 		KwTypedef: "typedef",
 		KwWhile:   "while",
 	}
@@ -136,8 +144,10 @@ func (kind Kind) IsOperator() bool {
 // Keywords is the set of valid keywords in the µC programming language.
 var Keywords = map[string]Kind{
 	"else":    KwElse,
+	"enum":    KwEnum,
 	"if":      KwIf,
 	"return":  KwReturn,
+	"sizeof":  KwSizeof,
 	"typedef": KwTypedef,
 	"while":   KwWhile,
 }
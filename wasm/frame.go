@@ -0,0 +1,66 @@
+package wasm
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// A frame represents the locals and stack frame layout of a function.
+type frame struct {
+	// Maps from values (parameters and instructions) to their local.
+	locals map[value.Value]string
+	// Maps from phi instructions to the local holding their incoming value.
+	//
+	// Predecessors set the incoming value of a phi instruction before
+	// branching to its basic block; the phi instruction then copies the
+	// incoming value to its own local, thus preventing incoming values from
+	// being overwritten before being used by other phi instructions.
+	incoming map[*ir.InstPhi]string
+	// Names of the locals which are not parameters, in order of declaration.
+	names []string
+	// Maps from local variables to their offset relative to $fp.
+	offsets map[*ir.InstAlloca]int64
+	// Size in bytes of local variables.
+	size int64
+}
+
+// newFrame returns the locals and stack frame layout of the given function.
+func newFrame(f *ir.Function) *frame {
+	fr := &frame{
+		locals:   make(map[value.Value]string),
+		incoming: make(map[*ir.InstPhi]string),
+		offsets:  make(map[*ir.InstAlloca]int64),
+	}
+	for i, param := range f.Params() {
+		fr.locals[param] = fmt.Sprintf("$p%d", i)
+	}
+	for _, block := range f.Blocks {
+		for _, inst := range block.Insts {
+			switch inst := inst.(type) {
+			case *ir.InstAlloca:
+				fr.offsets[inst] = fr.size
+				fr.size = align(fr.size+sizeof(inst.Elem), 4)
+			case *ir.InstPhi:
+				fr.incoming[inst] = fr.local()
+				fr.locals[inst] = fr.local()
+			case value.Value:
+				if !types.IsVoid(inst.Type()) {
+					fr.locals[inst] = fr.local()
+				}
+			}
+		}
+	}
+	// Keep the stack pointer double-word aligned.
+	fr.size = align(fr.size, 8)
+	return fr
+}
+
+// local allocates a new local, and returns its name.
+func (fr *frame) local() string {
+	name := fmt.Sprintf("$v%d", len(fr.names))
+	fr.names = append(fr.names, name)
+	return name
+}
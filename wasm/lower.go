@@ -0,0 +1,435 @@
+package wasm
+
+import (
+	"fmt"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// function emits the given function definition.
+func (g *generator) function(f *ir.Function) {
+	dbg.Printf("generate function: %v", f.Name)
+	g.frame = newFrame(f)
+	g.blockIndices = make(map[*ir.BasicBlock]int)
+	for i, block := range f.Blocks {
+		g.blockIndices[block] = i
+	}
+
+	// Function header and locals.
+	params := ""
+	for i := range f.Params() {
+		params += fmt.Sprintf(" (param $p%d i32)", i)
+	}
+	result := ""
+	if !types.IsVoid(f.Sig.Ret) {
+		result = " (result i32)"
+	}
+	g.emit(1, "(func %s%s%s", mangle(f.Name), params, result)
+	g.indent = 2
+	if g.frame.size > 0 {
+		g.emit(g.indent, "(local $fp i32)")
+	}
+	dispatch := hasBranches(f)
+	if dispatch {
+		g.emit(g.indent, "(local $bb i32)")
+	}
+	for _, name := range g.frame.names {
+		g.emit(g.indent, "(local %s i32)", name)
+	}
+
+	// Prologue.
+	if g.frame.size > 0 {
+		g.emit(g.indent, "global.get $sp")
+		g.emit(g.indent, "i32.const %d", g.frame.size)
+		g.emit(g.indent, "i32.sub")
+		g.emit(g.indent, "local.tee $fp")
+		g.emit(g.indent, "global.set $sp")
+	}
+
+	if !dispatch {
+		for _, block := range f.Blocks {
+			g.block(block)
+		}
+		g.emit(1, ")")
+		return
+	}
+
+	// Dispatch loop; breaking out of the block labelled $bk continues execution
+	// at the code of basic block k. The blocks are not indented, to keep the
+	// nesting depth of the output independent of the number of basic blocks.
+	g.emit(g.indent, "loop $dispatch")
+	g.indent++
+	for i := len(f.Blocks) - 1; i >= 0; i-- {
+		g.emit(g.indent, "block $b%d", i)
+	}
+	g.emit(g.indent, "local.get $bb")
+	labels := ""
+	for i := range f.Blocks {
+		labels += fmt.Sprintf(" $b%d", i)
+	}
+	g.emit(g.indent, "br_table%s", labels)
+	for i, block := range f.Blocks {
+		g.emit(g.indent, "end ;; $b%d", i)
+		g.block(block)
+	}
+	g.indent--
+	g.emit(g.indent, "end ;; $dispatch")
+	g.emit(g.indent, "unreachable")
+	g.emit(1, ")")
+}
+
+// hasBranches reports whether the given function contains branch terminators.
+func hasBranches(f *ir.Function) bool {
+	for _, block := range f.Blocks {
+		switch block.Term.(type) {
+		case *ir.TermBr, *ir.TermCondBr:
+			return true
+		}
+	}
+	return false
+}
+
+// block emits the instructions and terminator of the given basic block.
+func (g *generator) block(block *ir.BasicBlock) {
+	for _, inst := range block.Insts {
+		g.inst(inst)
+	}
+	g.term(block)
+}
+
+// inst emits the given instruction.
+func (g *generator) inst(inst ir.Instruction) {
+	switch inst := inst.(type) {
+	// Memory instructions.
+	case *ir.InstAlloca:
+		// Stack space of local variables is allocated by the prologue.
+		return
+	case *ir.InstLoad:
+		off := g.addr(inst.Src)
+		g.emit(g.indent, "%s%s", loadOp(inst.Type()), offset(off))
+	case *ir.InstStore:
+		off := g.addr(inst.Dst)
+		g.push(inst.Src)
+		g.emit(g.indent, "%s%s", storeOp(inst.Src.Type()), offset(off))
+		return
+	case *ir.InstGetElementPtr:
+		g.gep(inst.Src, inst.Elem, inst.Indices)
+
+	// Binary instructions.
+	case *ir.InstAdd:
+		g.binary("i32.add", inst.X, inst.Y)
+	case *ir.InstSub:
+		g.binary("i32.sub", inst.X, inst.Y)
+	case *ir.InstMul:
+		g.binary("i32.mul", inst.X, inst.Y)
+	case *ir.InstSDiv:
+		g.binary("i32.div_s", inst.X, inst.Y)
+	case *ir.InstSRem:
+		g.binary("i32.rem_s", inst.X, inst.Y)
+	case *ir.InstAnd:
+		g.binary("i32.and", inst.X, inst.Y)
+	case *ir.InstOr:
+		g.binary("i32.or", inst.X, inst.Y)
+	case *ir.InstXor:
+		g.binary("i32.xor", inst.X, inst.Y)
+	case *ir.InstShl:
+		g.binary("i32.shl", inst.X, inst.Y)
+	case *ir.InstAShr:
+		g.binary("i32.shr_s", inst.X, inst.Y)
+
+	// Comparison instructions.
+	case *ir.InstICmp:
+		g.push(inst.X)
+		g.push(inst.Y)
+		g.emit(g.indent, cmpOp(inst.Cond))
+
+	// Conversion instructions.
+	case *ir.InstTrunc:
+		g.push(inst.From)
+		g.normalize(inst.Type())
+	case *ir.InstZExt:
+		g.push(inst.From)
+		if sizeof(inst.From.Type()) == 1 && !types.IsBool(inst.From.Type()) {
+			g.emit(g.indent, "i32.const 0xFF")
+			g.emit(g.indent, "i32.and")
+		}
+	case *ir.InstSExt:
+		if types.IsBool(inst.From.Type()) {
+			g.emit(g.indent, "i32.const 0")
+			g.push(inst.From)
+			g.emit(g.indent, "i32.sub")
+		} else {
+			g.push(inst.From)
+		}
+
+	// Other instructions.
+	case *ir.InstPhi:
+		g.emit(g.indent, "local.get %s", g.frame.incoming[inst])
+	case *ir.InstSelect:
+		g.push(inst.X)
+		g.push(inst.Y)
+		g.push(inst.Cond)
+		g.emit(g.indent, "select")
+	case *ir.InstCall:
+		g.call(inst)
+		if types.IsVoid(inst.Type()) {
+			return
+		}
+	default:
+		panic(fmt.Sprintf("support for instruction %T not yet implemented", inst))
+	}
+
+	// Store the result of value producing instructions in their local.
+	v, ok := inst.(value.Value)
+	if !ok {
+		panic(fmt.Sprintf("invalid instruction type; expected value.Value, got %T", inst))
+	}
+	g.emit(g.indent, "local.set %s", g.frame.locals[v])
+}
+
+// binary emits the binary operation op on x and y, normalizing the result.
+func (g *generator) binary(op string, x, y value.Value) {
+	g.push(x)
+	g.push(y)
+	g.emit(g.indent, op)
+	g.normalize(x.Type())
+}
+
+// cmpOp returns the comparison instruction of the given integer comparison
+// predicate.
+func cmpOp(cond ir.IntPred) string {
+	switch cond {
+	case ir.IntEQ:
+		return "i32.eq"
+	case ir.IntNE:
+		return "i32.ne"
+	case ir.IntUGT:
+		return "i32.gt_u"
+	case ir.IntUGE:
+		return "i32.ge_u"
+	case ir.IntULT:
+		return "i32.lt_u"
+	case ir.IntULE:
+		return "i32.le_u"
+	case ir.IntSGT:
+		return "i32.gt_s"
+	case ir.IntSGE:
+		return "i32.ge_s"
+	case ir.IntSLT:
+		return "i32.lt_s"
+	case ir.IntSLE:
+		return "i32.le_s"
+	default:
+		panic(fmt.Sprintf("support for integer comparison predicate %v not yet implemented", cond))
+	}
+}
+
+// normalize sign-extends (or zero-extends for booleans) the value of the given
+// type on top of the stack, to the full width of an i32.
+func (g *generator) normalize(typ types.Type) {
+	switch {
+	case types.IsBool(typ):
+		g.emit(g.indent, "i32.const 1")
+		g.emit(g.indent, "i32.and")
+	case types.IsInt(typ) && sizeof(typ) == 1:
+		g.emit(g.indent, "i32.extend8_s")
+	}
+}
+
+// gep emits the address computation of a getelementptr instruction.
+func (g *generator) gep(src value.Value, elem types.Type, indices []value.Value) {
+	g.push(src)
+	for i, index := range indices {
+		if i > 0 {
+			elem = elemType(elem)
+		}
+		size := sizeof(elem)
+		if c, ok := index.(*constant.Int); ok {
+			if off := c.X.Int64() * size; off != 0 {
+				g.emit(g.indent, "i32.const %d", int32(off))
+				g.emit(g.indent, "i32.add")
+			}
+			continue
+		}
+		g.push(index)
+		if size != 1 {
+			g.emit(g.indent, "i32.const %d", size)
+			g.emit(g.indent, "i32.mul")
+		}
+		g.emit(g.indent, "i32.add")
+	}
+}
+
+// elemType returns the type indexed by a getelementptr index into the given
+// type.
+func elemType(typ types.Type) types.Type {
+	switch t := typ.(type) {
+	case *types.ArrayType:
+		return t.Elem
+	case *types.PointerType:
+		return t.Elem
+	default:
+		panic(fmt.Sprintf("support for getelementptr index into type %T not yet implemented", t))
+	}
+}
+
+// call emits the given call instruction, leaving the result on the stack.
+func (g *generator) call(inst *ir.InstCall) {
+	callee, ok := inst.Callee.(*ir.Function)
+	if !ok {
+		panic(fmt.Sprintf("support for indirect call of %T not yet implemented", inst.Callee))
+	}
+	for _, arg := range inst.Args {
+		g.push(arg)
+	}
+	g.emit(g.indent, "call %s", mangle(callee.Name))
+}
+
+// term emits the terminator of the given basic block.
+func (g *generator) term(block *ir.BasicBlock) {
+	switch term := block.Term.(type) {
+	case *ir.TermRet:
+		if term.X != nil {
+			g.push(term.X)
+		}
+		// Epilogue.
+		if g.frame.size > 0 {
+			g.emit(g.indent, "local.get $fp")
+			g.emit(g.indent, "i32.const %d", g.frame.size)
+			g.emit(g.indent, "i32.add")
+			g.emit(g.indent, "global.set $sp")
+		}
+		g.emit(g.indent, "return")
+	case *ir.TermBr:
+		g.jump(block, term.Target)
+	case *ir.TermCondBr:
+		g.push(term.Cond)
+		g.emit(g.indent, "if")
+		g.indent++
+		g.jump(block, term.TargetTrue)
+		g.indent--
+		g.emit(g.indent, "else")
+		g.indent++
+		g.jump(block, term.TargetFalse)
+		g.indent--
+		g.emit(g.indent, "end")
+	case *ir.TermUnreachable:
+		g.emit(g.indent, "unreachable")
+	default:
+		panic(fmt.Sprintf("support for terminator %T not yet implemented", term))
+	}
+}
+
+// jump emits a branch from the basic block pred to its successor succ, through
+// the dispatch loop.
+func (g *generator) jump(pred, succ *ir.BasicBlock) {
+	for _, inst := range succ.Insts {
+		phi, ok := inst.(*ir.InstPhi)
+		if !ok {
+			continue
+		}
+		for _, inc := range phi.Incs {
+			if inc.Pred == pred {
+				g.push(inc.X)
+				g.emit(g.indent, "local.set %s", g.frame.incoming[phi])
+			}
+		}
+	}
+	g.emit(g.indent, "i32.const %d", g.blockIndices[succ])
+	g.emit(g.indent, "local.set $bb")
+	g.emit(g.indent, "br $dispatch")
+}
+
+// push emits the instructions required to push the given value onto the stack.
+func (g *generator) push(v value.Value) {
+	switch v := v.(type) {
+	case *constant.Int:
+		x := v.X.Int64()
+		if types.IsBool(v.Typ) {
+			x &= 1
+		}
+		g.emit(g.indent, "i32.const %d", int32(x))
+	case *ir.Global, *constant.ExprGetElementPtr:
+		g.emit(g.indent, "i32.const %d", g.constAddr(v.(constant.Constant)))
+	case *ir.InstAlloca:
+		g.emit(g.indent, "local.get $fp")
+		if off := g.frame.offsets[v]; off != 0 {
+			g.emit(g.indent, "i32.const %d", off)
+			g.emit(g.indent, "i32.add")
+		}
+	default:
+		local, ok := g.frame.locals[v]
+		if !ok {
+			panic(fmt.Sprintf("support for value %T not yet implemented", v))
+		}
+		g.emit(g.indent, "local.get %s", local)
+	}
+}
+
+// addr pushes the base of the given address onto the stack, and returns the
+// static offset to add to it.
+func (g *generator) addr(v value.Value) int64 {
+	switch v := v.(type) {
+	case *ir.InstAlloca:
+		g.emit(g.indent, "local.get $fp")
+		return g.frame.offsets[v]
+	case *ir.Global, *constant.ExprGetElementPtr:
+		g.emit(g.indent, "i32.const 0")
+		return g.constAddr(v.(constant.Constant))
+	default:
+		g.push(v)
+		return 0
+	}
+}
+
+// constAddr returns the address in linear memory of the given constant
+// address.
+func (g *generator) constAddr(c constant.Constant) int64 {
+	switch c := c.(type) {
+	case *ir.Global:
+		return g.globals[c]
+	case *constant.ExprGetElementPtr:
+		addr := g.constAddr(c.Src)
+		elem := c.Elem
+		for i, index := range c.Indices {
+			if i > 0 {
+				elem = elemType(elem)
+			}
+			x, ok := index.(*constant.Int)
+			if !ok {
+				panic(fmt.Sprintf("support for getelementptr index %T not yet implemented", index))
+			}
+			addr += x.X.Int64() * sizeof(elem)
+		}
+		return addr
+	default:
+		panic(fmt.Sprintf("support for constant address %T not yet implemented", c))
+	}
+}
+
+// offset returns the offset immediate of memory instructions.
+func offset(off int64) string {
+	if off == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" offset=%d", off)
+}
+
+// loadOp returns the load instruction of values of the given type.
+func loadOp(typ types.Type) string {
+	if sizeof(typ) == 1 {
+		return "i32.load8_s"
+	}
+	return "i32.load"
+}
+
+// storeOp returns the store instruction of values of the given type.
+func storeOp(typ types.Type) string {
+	if sizeof(typ) == 1 {
+		return "i32.store8"
+	}
+	return "i32.store"
+}
@@ -0,0 +1,214 @@
+// Package wasm implements a WebAssembly text format generator for LLVM IR
+// modules produced by irgen.
+//
+// The generated module imports the runtime library functions (e.g. putint,
+// putstring and getint) from the "env" module, and exports its linear memory
+// as "memory" and the entry function as "main".
+//
+// Values
+//
+// Every integer and pointer value is represented as an i32; as on MIPS, 64-bit
+// integers are lowered to 32-bit words. Values of type i8 are kept sign
+// extended, and values of type i1 are either 0 or 1. Each value producing
+// instruction is assigned a local of its function.
+//
+// Memory layout
+//
+//    0 - 7        unused, to keep null pointers invalid
+//    8 - n        global variables
+//    n - top      stack, growing downwards from the top of memory
+//
+// The mutable global $sp holds the stack pointer. Each function allocates a
+// stack frame for its local variables (allocas) on entry, addressed through the
+// local $fp, and releases it before returning.
+//
+// Control flow
+//
+// Basic blocks are dispatched by a loop over a br_table, indexed by the local
+// $bb holding the index of the basic block to execute next. Branches store the
+// incoming values of the phi instructions of their target, update $bb and
+// continue the dispatch loop.
+package wasm
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/mewkiz/pkg/errutil"
+	"github.com/mewkiz/pkg/term"
+)
+
+// TODO: Remove debug output.
+
+// dbg is a logger which prefixes debug messages with "wasm:".
+var dbg = log.New(ioutil.Discard, term.WhiteBold("wasm:"), log.Lshortfile)
+
+const (
+	// Address of the first global variable.
+	dataStart = 8
+	// Size in bytes of the stack.
+	stackSize = 1 << 20
+	// Size in bytes of a WebAssembly page.
+	pageSize = 1 << 16
+)
+
+// Gen generates a WebAssembly text format module for the given LLVM IR module.
+func Gen(module *ir.Module) (string, error) {
+	g := newGenerator()
+	g.buf.WriteString("(module\n")
+
+	// Emit imports of the runtime library.
+	for _, f := range module.Funcs {
+		if len(f.Blocks) > 0 {
+			continue
+		}
+		g.emit(1, `(import "env" %q (func %s%s))`, f.Name, mangle(f.Name), signature(f.Sig))
+	}
+
+	// Lay out global variables.
+	end := int64(dataStart)
+	for _, global := range module.Globals {
+		if global.Init == nil {
+			return "", errutil.Newf("support for external global variable %q not yet implemented", global.Name)
+		}
+		end = align(end, 4)
+		g.globals[global] = end
+		end += sizeof(global.Content)
+	}
+	pages := (align(end, 8) + stackSize + pageSize - 1) / pageSize
+	g.emit(1, `(memory (export "memory") %d)`, pages)
+	g.emit(1, "(global $sp (mut i32) (i32.const %d))", pages*pageSize)
+
+	// Emit global variables.
+	for _, global := range module.Globals {
+		if err := g.global(global); err != nil {
+			return "", errutil.Err(err)
+		}
+	}
+
+	// Emit function definitions.
+	for _, f := range module.Funcs {
+		if len(f.Blocks) == 0 {
+			continue
+		}
+		g.function(f)
+		if f.Name == "main" {
+			g.emit(1, `(export "main" (func %s))`, mangle(f.Name))
+		}
+	}
+
+	g.buf.WriteString(")\n")
+	return g.buf.String(), nil
+}
+
+// A generator keeps track of the state required to generate WebAssembly.
+type generator struct {
+	// Output buffer of the generated module.
+	buf *bytes.Buffer
+	// Maps from global variables to their address in linear memory.
+	globals map[*ir.Global]int64
+	// Stack frame of the function being generated.
+	frame *frame
+	// Maps from basic blocks of the function being generated to their index.
+	blockIndices map[*ir.BasicBlock]int
+	// Indentation level of emitted instructions.
+	indent int
+}
+
+// newGenerator returns a new WebAssembly generator.
+func newGenerator() *generator {
+	return &generator{buf: &bytes.Buffer{}, globals: make(map[*ir.Global]int64)}
+}
+
+// emit emits the given instruction or module field at the given indentation
+// level.
+func (g *generator) emit(indent int, format string, a ...interface{}) {
+	g.buf.WriteString(strings.Repeat("\t", indent))
+	fmt.Fprintf(g.buf, format, a...)
+	g.buf.WriteString("\n")
+}
+
+// global emits the data segment of the given global variable definition.
+func (g *generator) global(global *ir.Global) error {
+	data := &bytes.Buffer{}
+	switch init := global.Init.(type) {
+	case *constant.Int:
+		writeInt(data, init)
+	case *constant.ZeroInitializer:
+		// Linear memory is zero initialized.
+		return nil
+	case *constant.Array:
+		for _, elem := range init.Elems {
+			c, ok := elem.(*constant.Int)
+			if !ok {
+				return errutil.Newf("support for array element constant %T not yet implemented", elem)
+			}
+			writeInt(data, c)
+		}
+	default:
+		return errutil.Newf("support for global variable initializer %T not yet implemented", init)
+	}
+	var s string
+	for _, b := range data.Bytes() {
+		s += fmt.Sprintf(`\%02x`, b)
+	}
+	g.emit(1, `(data (i32.const %d) "%s") ;; @%s`, g.globals[global], s, global.Name)
+	return nil
+}
+
+// writeInt writes the little-endian representation of the given integer
+// constant to buf.
+func writeInt(buf *bytes.Buffer, c *constant.Int) {
+	x := c.X.Int64()
+	for i := int64(0); i < sizeof(c.Typ); i++ {
+		buf.WriteByte(byte(x >> uint(8*i)))
+	}
+}
+
+// signature returns the parameter and result declarations of the given function
+// type.
+func signature(sig *types.FuncType) string {
+	s := ""
+	if len(sig.Params) > 0 {
+		s += " (param" + strings.Repeat(" i32", len(sig.Params)) + ")"
+	}
+	if !types.IsVoid(sig.Ret) {
+		s += " (result i32)"
+	}
+	return s
+}
+
+// mangle returns the WebAssembly identifier of the given LLVM IR global
+// identifier.
+func mangle(name string) string {
+	return "$" + name
+}
+
+// sizeof returns the size in bytes of the given type in linear memory.
+func sizeof(typ types.Type) int64 {
+	switch typ := typ.(type) {
+	case *types.IntType:
+		if typ.Size <= 8 {
+			return 1
+		}
+		// 64-bit integers are lowered to 32-bit words.
+		return 4
+	case *types.PointerType:
+		return 4
+	case *types.ArrayType:
+		return typ.Len * sizeof(typ.Elem)
+	default:
+		panic(fmt.Sprintf("support for type %T not yet implemented", typ))
+	}
+}
+
+// align rounds x up to the nearest multiple of n.
+func align(x, n int64) int64 {
+	return (x + n - 1) / n * n
+}
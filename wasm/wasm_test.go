@@ -0,0 +1,416 @@
+package wasm_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mewmew/uc/ast"
+	"github.com/mewmew/uc/gocc/parser"
+	"github.com/mewmew/uc/gocc/scanner"
+	"github.com/mewmew/uc/irgen"
+	"github.com/mewmew/uc/sem"
+	"github.com/mewmew/uc/wasm"
+)
+
+func TestGen(t *testing.T) {
+	paths, err := filepath.Glob("../testdata/noisy/*/*.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths, "../testdata/quiet/mips/m01.c", "../testdata/quiet/mips/m02.c", "../testdata/quiet/mips/m03.c")
+	for _, ssa := range []bool{false, true} {
+		for _, path := range paths {
+			// Lex input.
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Errorf("%q: %v", path, err)
+				continue
+			}
+			input := string(buf)
+			s := scanner.NewFromString(input)
+
+			// Parse input.
+			p := parser.NewParser()
+			f, err := p.Parse(s)
+			if err != nil {
+				t.Errorf("%q: parse error: %v", path, err)
+				continue
+			}
+			file := f.(*ast.File)
+
+			// Verify input.
+			info, err := sem.Check(file)
+			if err != nil {
+				t.Errorf("%q: semantic analysis error: %v", path, err)
+				continue
+			}
+
+			// Generate WebAssembly.
+			module := irgen.GenWith(file, info, irgen.Config{SSA: ssa})
+			wat, err := wasm.Gen(module)
+			if err != nil {
+				t.Errorf("%q: WebAssembly generation error: %v", path, err)
+				continue
+			}
+
+			// Validate the structure of the generated module.
+			if err := check(wat); err != nil {
+				t.Errorf("%q (SSA=%v): invalid WebAssembly module; %v", path, ssa, err)
+			}
+		}
+	}
+}
+
+// tokenRegexp matches the tokens of the WebAssembly text format, after removal
+// of line comments.
+var tokenRegexp = regexp.MustCompile(`\(|\)|"(?:[^"\\]|\\.)*"|[^\s()]+`)
+
+// A node is either a token or a list of nodes.
+type node struct {
+	tok  string
+	list []*node
+}
+
+// parse parses the s-expressions of the given WebAssembly text format module.
+func parse(wat string) (*node, error) {
+	var lines []string
+	for _, line := range strings.Split(wat, "\n") {
+		if i := strings.Index(line, ";;"); i != -1 {
+			line = line[:i]
+		}
+		lines = append(lines, line)
+	}
+	toks := tokenRegexp.FindAllString(strings.Join(lines, "\n"), -1)
+	stack := []*node{{}}
+	for _, tok := range toks {
+		top := stack[len(stack)-1]
+		switch tok {
+		case "(":
+			n := &node{}
+			top.list = append(top.list, n)
+			stack = append(stack, n)
+		case ")":
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("unbalanced parenthesis")
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			top.list = append(top.list, &node{tok: tok})
+		}
+	}
+	if len(stack) != 1 || len(stack[0].list) != 1 {
+		return nil, fmt.Errorf("expected a single s-expression")
+	}
+	return stack[0].list[0], nil
+}
+
+// head returns the first token of the given list.
+func (n *node) head() string {
+	if len(n.list) == 0 {
+		return ""
+	}
+	return n.list[0].tok
+}
+
+// sig represents the number of parameters and results of a function.
+type sig struct {
+	params, results int
+}
+
+// check validates the structure of the given WebAssembly text format module;
+// that identifiers are declared, that control instructions are properly nested
+// and that the operand stack is balanced. All values are assumed to be of type
+// i32.
+func check(wat string) error {
+	mod, err := parse(wat)
+	if err != nil {
+		return err
+	}
+	if mod.head() != "module" {
+		return fmt.Errorf("expected module, got %q", mod.head())
+	}
+	funcs := make(map[string]sig)
+	globals := make(map[string]bool)
+	var exports []string
+	for _, field := range mod.list[1:] {
+		switch field.head() {
+		case "import":
+			if len(field.list) != 4 || field.list[3].head() != "func" {
+				return fmt.Errorf("invalid import")
+			}
+			fn := field.list[3]
+			name := fn.list[1].tok
+			funcs[name] = funcSig(fn.list[2:])
+		case "func":
+			name := field.list[1].tok
+			if _, ok := funcs[name]; ok {
+				return fmt.Errorf("redefinition of function %s", name)
+			}
+			funcs[name] = funcSig(field.list[2:])
+		case "global":
+			globals[field.list[1].tok] = true
+		case "export":
+			exports = append(exports, field.list[2].list[1].tok)
+		case "memory", "data":
+		default:
+			return fmt.Errorf("unknown module field %q", field.head())
+		}
+	}
+	for _, name := range exports {
+		if _, ok := funcs[name]; !ok {
+			return fmt.Errorf("export of undeclared function %s", name)
+		}
+	}
+	for _, field := range mod.list[1:] {
+		if field.head() == "func" {
+			if err := checkFunc(field, funcs, globals); err != nil {
+				return fmt.Errorf("function %s: %v", field.list[1].tok, err)
+			}
+		}
+	}
+	return nil
+}
+
+// funcSig returns the signature of the given function fields.
+func funcSig(fields []*node) sig {
+	var s sig
+	for _, field := range fields {
+		switch field.head() {
+		case "param":
+			if strings.HasPrefix(field.list[1].tok, "$") {
+				s.params++
+			} else {
+				s.params += len(field.list) - 1
+			}
+		case "result":
+			s.results += len(field.list) - 1
+		}
+	}
+	return s
+}
+
+// A ctrl represents an enclosing control instruction during validation.
+type ctrl struct {
+	// Control instruction; block, loop, if or func.
+	kind string
+	// Label of the control instruction.
+	label string
+	// Height of the operand stack when entering the control instruction.
+	height int
+	// Number of results of the control instruction.
+	results int
+	// Specifies whether the remaining instructions are unreachable.
+	unreachable bool
+}
+
+// checkFunc validates the body of the given function definition.
+func checkFunc(fn *node, funcs map[string]sig, globals map[string]bool) error {
+	locals := make(map[string]bool)
+	var body []string
+	for _, field := range fn.list[2:] {
+		switch field.head() {
+		case "param", "local":
+			locals[field.list[1].tok] = true
+		case "result":
+		default:
+			if field.tok == "" {
+				return fmt.Errorf("unexpected folded expression %q", field.head())
+			}
+			body = append(body, field.tok)
+		}
+	}
+	height := 0
+	ctrls := []*ctrl{{kind: "func", results: funcs[fn.list[1].tok].results}}
+	// pop pops n operands from the stack.
+	pop := func(n int) error {
+		top := ctrls[len(ctrls)-1]
+		if height-n < top.height {
+			if top.unreachable {
+				height = top.height
+				return nil
+			}
+			return fmt.Errorf("operand stack underflow")
+		}
+		height -= n
+		return nil
+	}
+	// unreachable marks the remaining instructions of the current control
+	// instruction as unreachable.
+	unreachable := func() {
+		top := ctrls[len(ctrls)-1]
+		height = top.height
+		top.unreachable = true
+	}
+	// label reports whether the given label is of an enclosing control
+	// instruction.
+	label := func(name string) bool {
+		for _, c := range ctrls {
+			if c.label == name {
+				return true
+			}
+		}
+		return false
+	}
+	// end validates the operand stack at the end of the current control
+	// instruction.
+	end := func() error {
+		top := ctrls[len(ctrls)-1]
+		if !top.unreachable && height != top.height+top.results {
+			return fmt.Errorf("unbalanced operand stack at end of %s; expected %d operands, got %d", top.kind, top.results, height-top.height)
+		}
+		height = top.height + top.results
+		return nil
+	}
+	for i := 0; i < len(body); i++ {
+		inst := body[i]
+		// operand returns the immediate operand of the instruction.
+		operand := func() (string, error) {
+			if i+1 >= len(body) {
+				return "", fmt.Errorf("missing operand of %s", inst)
+			}
+			i++
+			return body[i], nil
+		}
+		switch {
+		case inst == "block" || inst == "loop" || inst == "if":
+			if inst == "if" {
+				if err := pop(1); err != nil {
+					return err
+				}
+			}
+			c := &ctrl{kind: inst, height: height}
+			if i+1 < len(body) && strings.HasPrefix(body[i+1], "$") {
+				c.label, _ = operand()
+			}
+			ctrls = append(ctrls, c)
+		case inst == "else":
+			top := ctrls[len(ctrls)-1]
+			if top.kind != "if" {
+				return fmt.Errorf("else outside of if")
+			}
+			if err := end(); err != nil {
+				return err
+			}
+			height = top.height
+			top.unreachable = false
+		case inst == "end":
+			if len(ctrls) == 1 {
+				return fmt.Errorf("unbalanced end")
+			}
+			if err := end(); err != nil {
+				return err
+			}
+			ctrls = ctrls[:len(ctrls)-1]
+		case inst == "br" || inst == "br_table":
+			if inst == "br_table" {
+				if err := pop(1); err != nil {
+					return err
+				}
+			}
+			n := 0
+			for i+1 < len(body) && strings.HasPrefix(body[i+1], "$") {
+				name, _ := operand()
+				if !label(name) {
+					return fmt.Errorf("branch to undeclared label %s", name)
+				}
+				n++
+			}
+			if n == 0 {
+				return fmt.Errorf("missing label of %s", inst)
+			}
+			unreachable()
+		case inst == "return":
+			if err := pop(ctrls[0].results); err != nil {
+				return err
+			}
+			unreachable()
+		case inst == "unreachable":
+			unreachable()
+		case inst == "local.get" || inst == "local.set" || inst == "local.tee" || inst == "global.get" || inst == "global.set":
+			name, err := operand()
+			if err != nil {
+				return err
+			}
+			if strings.HasPrefix(inst, "local.") && !locals[name] {
+				return fmt.Errorf("use of undeclared local %s", name)
+			}
+			if strings.HasPrefix(inst, "global.") && !globals[name] {
+				return fmt.Errorf("use of undeclared global %s", name)
+			}
+			if strings.HasSuffix(inst, ".get") {
+				height++
+			} else if err := pop(1); err != nil {
+				return err
+			} else if inst == "local.tee" {
+				height++
+			}
+		case inst == "call":
+			name, err := operand()
+			if err != nil {
+				return err
+			}
+			s, ok := funcs[name]
+			if !ok {
+				return fmt.Errorf("call to undeclared function %s", name)
+			}
+			if err := pop(s.params); err != nil {
+				return err
+			}
+			height += s.results
+		case inst == "i32.const":
+			x, err := operand()
+			if err != nil {
+				return err
+			}
+			if _, err := strconv.ParseInt(x, 0, 64); err != nil {
+				return fmt.Errorf("invalid integer constant %q", x)
+			}
+			height++
+		case strings.HasPrefix(inst, "i32.load") || inst == "i32.extend8_s":
+			if err := pop(1); err != nil {
+				return err
+			}
+			height++
+			if i+1 < len(body) && strings.HasPrefix(body[i+1], "offset=") {
+				i++
+			}
+		case strings.HasPrefix(inst, "i32.store"):
+			if err := pop(2); err != nil {
+				return err
+			}
+			if i+1 < len(body) && strings.HasPrefix(body[i+1], "offset=") {
+				i++
+			}
+		case inst == "select":
+			if err := pop(3); err != nil {
+				return err
+			}
+			height++
+		case binaryOps[inst]:
+			if err := pop(2); err != nil {
+				return err
+			}
+			height++
+		default:
+			return fmt.Errorf("unknown instruction %q", inst)
+		}
+	}
+	if len(ctrls) != 1 {
+		return fmt.Errorf("missing end of %s", ctrls[len(ctrls)-1].kind)
+	}
+	return end()
+}
+
+// binaryOps is the set of binary i32 instructions.
+var binaryOps = map[string]bool{
+	"i32.add": true, "i32.sub": true, "i32.mul": true, "i32.div_s": true,
+	"i32.rem_s": true, "i32.and": true, "i32.or": true, "i32.xor": true,
+	"i32.shl": true, "i32.shr_s": true, "i32.eq": true, "i32.ne": true,
+	"i32.lt_s": true, "i32.lt_u": true, "i32.gt_s": true, "i32.gt_u": true,
+	"i32.le_s": true, "i32.le_u": true, "i32.ge_s": true, "i32.ge_u": true,
+}